@@ -80,9 +80,16 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
+	apicastDashboards             []string                   = []string{"apicast", "apicast-services"}
+	apicastDefaultPrometheusRules defaultPrometheusRulesSpec = defaultPrometheusRulesSpec{
+		Enabled: pointer.BoolPtr(true),
+	}
 	apicastDefaultMarin3rSpec  defaultMarin3rSidecarSpec = defaultMarin3rSidecarSpec{}
 	apicastDefaultLogLevel     string                    = "warn"
 	apicastDefaultOIDCLogLevel string                    = "warn"
+	apicastDefaultMonitoring   defaultMonitoringSpec     = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // ApicastSpec defines the desired state of Apicast
@@ -97,6 +104,14 @@ type ApicastSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 }
 
 // ApicastEnvironmentSpec is the configuration for an Apicast environment
@@ -121,6 +136,10 @@ type ApicastEnvironmentSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -149,6 +168,19 @@ type ApicastEnvironmentSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the Apicast resource
@@ -157,6 +189,8 @@ func (a *Apicast) Default() {
 	a.Spec.Staging.Default()
 	a.Spec.Production.Default()
 	a.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(a.Spec.GrafanaDashboard, apicastDefaultGrafanaDashboard)
+	a.Spec.PrometheusRules = InitializePrometheusRulesSpec(a.Spec.PrometheusRules, apicastDefaultPrometheusRules)
+	a.Spec.Monitoring = InitializeMonitoringSpec(a.Spec.Monitoring, apicastDefaultMonitoring)
 
 }
 
@@ -174,6 +208,7 @@ func (spec *ApicastEnvironmentSpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, apicastDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, apicastDefaultResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, apicastDefaultLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, apicastDefaultReadinessProbe)
 	spec.LoadBalancer = InitializeLoadBalancerSpec(spec.LoadBalancer, apicastDefaultLoadBalancer)
@@ -209,10 +244,15 @@ func (cfg *ApicastConfig) Default() {
 }
 
 // ApicastStatus defines the observed state of Apicast
-type ApicastStatus struct{}
+type ApicastStatus struct {
+	ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Apicast is the Schema for the apicasts API
 type Apicast struct {
@@ -223,6 +263,11 @@ type Apicast struct {
 	Status ApicastStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the Apicast ComponentStatus
+func (a *Apicast) GetComponentStatus() *ComponentStatus {
+	return &a.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // ApicastList contains a list of Apicast
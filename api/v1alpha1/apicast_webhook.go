@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var apicastlog = logf.Log.WithName("apicast-resource")
+
+// SetupWebhookWithManager registers the webhooks of the Apicast type in the manager
+func (r *Apicast) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-apicast,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=apicasts,verbs=create;update,versions=v1alpha1,name=mapicast.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-apicast,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=apicasts,verbs=create;update,versions=v1alpha1,name=vapicast.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Apicast{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Apicast) ValidateCreate() error {
+	apicastlog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Apicast) ValidateUpdate(old runtime.Object) error {
+	apicastlog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Apicast) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the Apicast. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *Apicast) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("staging", "config", "logLevel"), instance.Spec.Staging.Config.LogLevel, nginxLogLevels)...)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("staging", "config", "oidcLogLevel"), instance.Spec.Staging.Config.OIDCLogLevel, nginxLogLevels)...)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("production", "config", "logLevel"), instance.Spec.Production.Config.LogLevel, nginxLogLevels)...)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("production", "config", "oidcLogLevel"), instance.Spec.Production.Config.OIDCLogLevel, nginxLogLevels)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("staging", "vpa"), instance.Spec.Staging.VPA, instance.Spec.Staging.HPA, nil)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("production", "vpa"), instance.Spec.Production.VPA, instance.Spec.Production.HPA, nil)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, apicastDashboards)...)
+
+	return invalid("Apicast", r.Name, allErrs)
+}
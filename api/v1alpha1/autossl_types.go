@@ -74,9 +74,13 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
-	autosslDefaultACMEStaging bool   = false
-	autosslDefaultRedisPort   int32  = 6379
-	autosslDefaultLogLevel    string = "warn"
+	autosslDashboards         []string              = []string{"autossl"}
+	autosslDefaultACMEStaging bool                  = false
+	autosslDefaultRedisPort   int32                 = 6379
+	autosslDefaultLogLevel    string                = "warn"
+	autosslDefaultMonitoring  defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // AutoSSLSpec defines the desired state of AutoSSL
@@ -101,6 +105,10 @@ type AutoSSLSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -117,6 +125,10 @@ type AutoSSLSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 	// Application specific configuration options for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Config AutoSSLConfig `json:"config"`
@@ -129,6 +141,19 @@ type AutoSSLSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the AutoSSL resource
@@ -145,10 +170,12 @@ func (a *AutoSSL) Default() {
 
 	a.Spec.PDB = InitializePodDisruptionBudgetSpec(a.Spec.PDB, autosslDefaultPDB)
 	a.Spec.Resources = InitializeResourceRequirementsSpec(a.Spec.Resources, autosslDefaultResources)
+	a.Spec.VPA = InitializeVerticalPodAutoscalerSpec(a.Spec.VPA, defaultVPA)
 	a.Spec.LivenessProbe = InitializeProbeSpec(a.Spec.LivenessProbe, autosslDefaultProbe)
 	a.Spec.ReadinessProbe = InitializeProbeSpec(a.Spec.ReadinessProbe, autosslDefaultProbe)
 	a.Spec.LoadBalancer = InitializeLoadBalancerSpec(a.Spec.LoadBalancer, autosslDefaultLoadBalancer)
 	a.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(a.Spec.GrafanaDashboard, autosslDefaultGrafanaDashboard)
+	a.Spec.Monitoring = InitializeMonitoringSpec(a.Spec.Monitoring, autosslDefaultMonitoring)
 	a.Spec.Config.Default()
 }
 
@@ -156,6 +183,7 @@ func (a *AutoSSL) Default() {
 type AutoSSLConfig struct {
 	// Sets the nginx log level
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
 	// Enables/disables the Let's Encrypt staging ACME endpoint
@@ -204,12 +232,14 @@ func (cfg *AutoSSLConfig) Default() {
 
 // AutoSSLStatus defines the observed state of AutoSSL
 type AutoSSLStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	ComponentStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AutoSSL is the Schema for the autossls API
 type AutoSSL struct {
@@ -220,6 +250,11 @@ type AutoSSL struct {
 	Status AutoSSLStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the AutoSSL ComponentStatus
+func (a *AutoSSL) GetComponentStatus() *ComponentStatus {
+	return &a.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // AutoSSLList contains a list of AutoSSL
@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var autossllog = logf.Log.WithName("autossl-resource")
+
+// SetupWebhookWithManager registers the webhooks of the AutoSSL type in the manager
+func (r *AutoSSL) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-autossl,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=autossls,verbs=create;update,versions=v1alpha1,name=mautossl.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-autossl,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=autossls,verbs=create;update,versions=v1alpha1,name=vautossl.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &AutoSSL{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AutoSSL) ValidateCreate() error {
+	autossllog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AutoSSL) ValidateUpdate(old runtime.Object) error {
+	autossllog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AutoSSL) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the AutoSSL. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *AutoSSL) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("config", "logLevel"), instance.Spec.Config.LogLevel, nginxLogLevels)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("vpa"), instance.Spec.VPA, instance.Spec.HPA, nil)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, autosslDashboards)...)
+
+	return invalid("AutoSSL", r.Name, allErrs)
+}
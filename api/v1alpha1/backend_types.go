@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/3scale/saas-operator/pkg/util"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,10 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
+	backendDashboards             []string                   = []string{"backend"}
+	backendDefaultPrometheusRules defaultPrometheusRulesSpec = defaultPrometheusRulesSpec{
+		Enabled: pointer.BoolPtr(true),
+	}
 	backendDefaultConfigRackEnv         string                             = "dev"
 	backendDefaultConfigMasterServiceID int32                              = 6
 	backendDefaultListenerHPA           defaultHorizontalPodAutoscalerSpec = defaultHorizontalPodAutoscalerSpec{
@@ -87,6 +92,12 @@ var (
 		ResourceUtilization: pointer.Int32Ptr(90),
 		ResourceName:        pointer.StringPtr("cpu"),
 	}
+	backendDefaultWorkerScaledObject defaultScaledObjectSpec = defaultScaledObjectSpec{
+		MinReplicas: pointer.Int32Ptr(2),
+		MaxReplicas: pointer.Int32Ptr(4),
+		QueueLength: pointer.Int32Ptr(100),
+		Queues:      []string{"priority", "main", "stats"},
+	}
 	backendDefaultWorkerPDB defaultPodDisruptionBudgetSpec = defaultPodDisruptionBudgetSpec{
 		MaxUnavailable: util.IntStrPtr(intstr.FromInt(1)),
 	}
@@ -118,6 +129,7 @@ var (
 	backendDefaultWorkerConfigLogFormat  string                          = "json"
 	backendDefaultWorkerConfigRedisAsync bool                            = false
 	backendDefaultCronReplicas           int32                           = 1
+	backendDefaultCronMode               BackendCronMode                 = BackendCronDeploymentMode
 	backendDefaultCronResources          defaultResourceRequirementsSpec = defaultResourceRequirementsSpec{
 		Requests: corev1.ResourceList{
 			corev1.ResourceCPU:    resource.MustParse("50m"),
@@ -128,6 +140,21 @@ var (
 			corev1.ResourceMemory: resource.MustParse("150Mi"),
 		},
 	}
+	backendDefaultCronJobs []BackendCronJobSpec = []BackendCronJobSpec{
+		{
+			Name:     "reschedule-failed-jobs",
+			Schedule: "*/5 * * * *",
+			Args:     []string{"bundle", "exec", "rake", "reschedule_failed_jobs"},
+		},
+	}
+	backendDefaultCronJob defaultBackendCronJobSpec = defaultBackendCronJobSpec{
+		ConcurrencyPolicy:          batchv1beta1.ForbidConcurrent,
+		SuccessfulJobsHistoryLimit: pointer.Int32Ptr(3),
+		FailedJobsHistoryLimit:     pointer.Int32Ptr(1),
+	}
+	backendDefaultMonitoring defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // BackendSpec defines the desired state of Backend
@@ -143,6 +170,14 @@ type BackendSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 	// Configures the backend listener
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Listener ListenerSpec `json:"listener"`
@@ -154,6 +189,12 @@ type BackendSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Cron *CronSpec `json:"cron,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *SecretsProviderSpec `json:"secretsProvider,omitempty"`
 }
 
 // Default implements defaulting for the Backend resource
@@ -171,6 +212,8 @@ func (b *Backend) Default() {
 	}
 	b.Spec.Cron.Default()
 	b.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(b.Spec.GrafanaDashboard, backendDefaultGrafanaDashboard)
+	b.Spec.PrometheusRules = InitializePrometheusRulesSpec(b.Spec.PrometheusRules, backendDefaultPrometheusRules)
+	b.Spec.Monitoring = InitializeMonitoringSpec(b.Spec.Monitoring, backendDefaultMonitoring)
 }
 
 // ListenerSpec is the configuration for Backend Listener
@@ -195,6 +238,10 @@ type ListenerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -220,6 +267,19 @@ type ListenerSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the each backend listener
@@ -235,6 +295,7 @@ func (spec *ListenerSpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, backendDefaultListenerPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, backendDefaultListenerResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, backendDefaultListenerLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, backendDefaultListenerReadinessProbe)
 	spec.LoadBalancer = InitializeNLBLoadBalancerSpec(spec.LoadBalancer, backendDefaultListenerNLBLoadBalancer)
@@ -259,6 +320,11 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject that autoscales the component on the length of
+	// its queues. The hpa is ignored when the ScaledObject is configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScaledObject *ScaledObjectSpec `json:"scaledObject,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -267,6 +333,10 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -281,14 +351,28 @@ type WorkerSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the each backend worker
 func (spec *WorkerSpec) Default() {
 
 	spec.HPA = InitializeHorizontalPodAutoscalerSpec(spec.HPA, backendDefaultWorkerHPA)
+	spec.ScaledObject = InitializeScaledObjectSpec(spec.ScaledObject, backendDefaultWorkerScaledObject)
 
-	if spec.HPA.IsDeactivated() {
+	if spec.HPA.IsDeactivated() && spec.ScaledObject.IsDeactivated() {
 		spec.Replicas = intOrDefault(spec.Replicas, &backendDefaultWorkerReplicas)
 	} else {
 		spec.Replicas = nil
@@ -296,6 +380,7 @@ func (spec *WorkerSpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, backendDefaultWorkerPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, backendDefaultWorkerResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, backendDefaultWorkerLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, backendDefaultWorkerReadinessProbe)
 	if spec.Config == nil {
@@ -304,8 +389,78 @@ func (spec *WorkerSpec) Default() {
 	spec.Config.Default()
 }
 
+// BackendCronMode is the kind of workload that runs the periodic backend tasks
+// +kubebuilder:validation:Enum=deployment;cronjob
+type BackendCronMode string
+
+const (
+	// BackendCronDeploymentMode runs the backend-cron process in an always-on Deployment
+	BackendCronDeploymentMode BackendCronMode = "deployment"
+	// BackendCronCronJobMode runs each of the periodic backend tasks in its own CronJob.
+	// It requires Kubernetes 1.21 or newer, which serves the batch/v1 CronJobs.
+	BackendCronCronJobMode BackendCronMode = "cronjob"
+)
+
+// BackendCronJobSpec configures one of the periodic backend tasks
+// when the cron runs in "cronjob" mode
+type BackendCronJobSpec struct {
+	// The name of the task. It is appended to the name of the CronJob.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=39
+	Name string `json:"name"`
+	// The schedule of the task, in Cron format
+	Schedule string `json:"schedule"`
+	// The arguments passed to the backend container to run the task
+	Args []string `json:"args"`
+	// Specifies how to treat concurrent executions of the task. Defaults to "Forbid".
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	// +optional
+	ConcurrencyPolicy *batchv1beta1.ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// Deadline in seconds for starting the task if it misses its scheduled
+	// time for any reason. Missed executions are counted as failed ones.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+	// Duration in seconds relative to the start time that a run of the
+	// task may be active before it is terminated
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// The number of successful finished runs to retain. Defaults to 3.
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+	// The number of failed finished runs to retain. Defaults to 1.
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+}
+
+type defaultBackendCronJobSpec struct {
+	ConcurrencyPolicy                                  batchv1beta1.ConcurrencyPolicy
+	SuccessfulJobsHistoryLimit, FailedJobsHistoryLimit *int32
+}
+
+// Default sets default values for any value not specifically set in the BackendCronJobSpec struct
+func (spec *BackendCronJobSpec) Default(def defaultBackendCronJobSpec) {
+	if spec.ConcurrencyPolicy == nil {
+		policy := def.ConcurrencyPolicy
+		spec.ConcurrencyPolicy = &policy
+	}
+	spec.SuccessfulJobsHistoryLimit = intOrDefault(spec.SuccessfulJobsHistoryLimit, def.SuccessfulJobsHistoryLimit)
+	spec.FailedJobsHistoryLimit = intOrDefault(spec.FailedJobsHistoryLimit, def.FailedJobsHistoryLimit)
+}
+
 // CronSpec is the configuration for Backend Cron
 type CronSpec struct {
+	// The kind of workload that runs the periodic backend tasks: an always-on
+	// "deployment" running backend-cron or one "cronjob" per task. The "cronjob"
+	// mode requires Kubernetes 1.21 or newer, as it creates batch/v1 CronJobs.
+	// Defaults to "deployment".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Mode *BackendCronMode `json:"mode,omitempty"`
+	// The periodic backend tasks run as CronJobs in "cronjob" mode.
+	// Defaults to the reschedule of the failed jobs every 5 minutes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Jobs []BackendCronJobSpec `json:"jobs,omitempty"`
 	// Number of replicas for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -314,19 +469,56 @@ type CronSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Describes node affinity scheduling rules for the pod.
 	// +optional
 	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the each backend cron
 func (spec *CronSpec) Default() {
 
+	if spec.Mode == nil {
+		mode := backendDefaultCronMode
+		spec.Mode = &mode
+	}
+	if spec.Jobs == nil {
+		spec.Jobs = make([]BackendCronJobSpec, 0, len(backendDefaultCronJobs))
+		for _, job := range backendDefaultCronJobs {
+			job.Args = append([]string{}, job.Args...)
+			spec.Jobs = append(spec.Jobs, job)
+		}
+	}
+	for idx := range spec.Jobs {
+		spec.Jobs[idx].Default(backendDefaultCronJob)
+	}
 	spec.Replicas = intOrDefault(spec.Replicas, &backendDefaultCronReplicas)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, backendDefaultCronResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
+}
+
+// IsMode returns true if the backend cron runs in the given mode
+func (spec *CronSpec) IsMode(mode BackendCronMode) bool {
+	return spec.Mode != nil && *spec.Mode == mode
 }
 
 // BackendConfig configures app behavior for Backend
@@ -422,10 +614,15 @@ func (cfg *WorkerConfig) Default() {
 }
 
 // BackendStatus defines the observed state of Backend
-type BackendStatus struct{}
+type BackendStatus struct {
+	ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Backend is the Schema for the backends API
 type Backend struct {
@@ -436,6 +633,11 @@ type Backend struct {
 	Status BackendStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the Backend ComponentStatus
+func (b *Backend) GetComponentStatus() *ComponentStatus {
+	return &b.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // BackendList contains a list of Backend
@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var backendlog = logf.Log.WithName("backend-resource")
+
+// SetupWebhookWithManager registers the webhooks of the Backend type in the manager
+func (r *Backend) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-backend,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=backends,verbs=create;update,versions=v1alpha1,name=mbackend.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-backend,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=backends,verbs=create;update,versions=v1alpha1,name=vbackend.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Backend{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Backend) ValidateCreate() error {
+	backendlog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Backend) ValidateUpdate(old runtime.Object) error {
+	backendlog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Backend) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the Backend. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *Backend) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateRedisDSN(spec.Child("config", "redisStorageDSN"), instance.Spec.Config.RedisStorageDSN)...)
+	allErrs = append(allErrs, validateRedisDSN(spec.Child("config", "redisQueuesDSN"), instance.Spec.Config.RedisQueuesDSN)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("listener", "vpa"), instance.Spec.Listener.VPA, instance.Spec.Listener.HPA, nil)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("worker", "vpa"), instance.Spec.Worker.VPA, instance.Spec.Worker.HPA, instance.Spec.Worker.ScaledObject)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, backendDashboards)...)
+
+	return invalid("Backend", r.Name, allErrs)
+}
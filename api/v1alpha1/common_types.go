@@ -19,8 +19,12 @@ package v1alpha1
 import (
 	"reflect"
 
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 )
 
 const (
@@ -29,8 +33,169 @@ const (
 	// AnnotationsDomain is a common prefix for all "rollout triggering"
 	// annotation keys
 	AnnotationsDomain string = "saas.3scale.net"
+	// PausedAnnotation suspends the reconciliation of the owned resources of
+	// an instance. The value "true" pauses all of them, otherwise it is a comma
+	// separated list of resources, either "<Kind>/<name>" or "<name>". An entry
+	// without kind only pauses the workload (Deployment, StatefulSet, CronJob or
+	// Job) with that name, use "<Kind>/<name>" to pause any other resource
+	PausedAnnotation string = AnnotationsDomain + "/paused"
+	// DryRunAnnotation, when set to "true", stops the operator from modifying the
+	// owned resources of an instance. The changes that would be applied are
+	// reported in the status instead, so they can be reviewed before a rollout
+	DryRunAnnotation string = AnnotationsDomain + "/dry-run"
+	// PersistDefaultsAnnotation, when set to "true", makes the mutating admission
+	// webhook store the defaults of the spec in the API, so the stored object
+	// holds the exact configuration the operator uses
+	PersistDefaultsAnnotation string = AnnotationsDomain + "/persist-defaults"
+	// WaitForSecretsAnnotation, when set to "true", holds back the creation and the
+	// updates of the workloads of an instance until all the Secrets they depend on
+	// are ready. "false" disables it when enabled by default in the operator
+	WaitForSecretsAnnotation string = AnnotationsDomain + "/wait-for-secrets"
+
+	// ReadyCondition is true when all the workloads of the component
+	// are ready and the last reconcile succeeded
+	ReadyCondition string = "Ready"
+	// ProgressingCondition is true while any of the workloads of the
+	// component is rolling out a new version
+	ProgressingCondition string = "Progressing"
+	// DegradedCondition is true when the last reconcile of the component failed
+	DegradedCondition string = "Degraded"
+	// SecretsSyncedCondition is true when all the Secrets the component
+	// depends on have been synced from the secrets engine
+	SecretsSyncedCondition string = "SecretsSynced"
+	// SecretsReadyCondition is true when all the Secrets the component depends
+	// on exist and hold all the keys the workloads read from them
+	SecretsReadyCondition string = "SecretsReady"
+	// PausedCondition is true when the reconciliation of some or all of
+	// the owned resources has been paused with the PausedAnnotation
+	PausedCondition string = "Paused"
+	// MigratedCondition is true when the database migration Job of the current
+	// version of the component has succeeded. The workloads of the component are
+	// not rolled out while it is false.
+	MigratedCondition string = "Migrated"
 )
 
+// ComponentStatus is the observed state shared by all the
+// custom resources managed by the operator
+type ComponentStatus struct {
+	// The generation of the resource observed by the operator
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Represents the latest available observations of the resource's state
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// The readiness of each of the workloads (Deployments/StatefulSets)
+	// owned by the resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Workloads []WorkloadStatus `json:"workloads,omitempty"`
+	// The changes that would be applied to the owned resources. Only
+	// reported when the resource has the dry-run annotation
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	PendingChanges []PendingChange `json:"pendingChanges,omitempty"`
+	// The spec of the resource with all the defaults applied, as used by the
+	// operator in the last reconcile. Only reported for the resources with
+	// the persist-defaults annotation.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	EffectiveSpec *runtime.RawExtension `json:"effectiveSpec,omitempty"`
+	// The last successful migration of the database of the component.
+	// Only reported when the migration is enabled in the spec.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Migration *MigrationStatus `json:"migration,omitempty"`
+}
+
+// MigrationStatus describes the last successful migration
+// of the database of the component
+type MigrationStatus struct {
+	// The name of the Job that migrated the database
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	JobName string `json:"jobName"`
+	// The image the database was migrated with
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Image string `json:"image"`
+	// The version of the component the database was migrated
+	// to, as given by the tag of the image
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Version string `json:"version,omitempty"`
+	// The time the migration completed
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// WorkloadStatus reports the readiness of a Deployment or
+// StatefulSet owned by the resource
+type WorkloadStatus struct {
+	// The kind of the workload (Deployment/StatefulSet)
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Kind string `json:"kind"`
+	// The name of the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Number of desired replicas
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// Number of replicas with Ready condition
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// Number of replicas running the latest version of the pod template
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// The resources recommended for each container by the
+	// VerticalPodAutoscaler of the workload, if any
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Recommendations []ContainerRecommendation `json:"recommendations,omitempty"`
+}
+
+// ContainerRecommendation holds the resources recommended
+// by a VerticalPodAutoscaler for a container
+type ContainerRecommendation struct {
+	// The name of the container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	ContainerName string `json:"containerName"`
+	// The recommended resources
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Target corev1.ResourceList `json:"target"`
+	// The minimum recommended resources
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// The maximum recommended resources
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+}
+
+// PendingChange describes a change that the operator would apply
+// to an owned resource if the dry-run annotation was removed
+type PendingChange struct {
+	// The kind of the owned resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Kind string `json:"kind"`
+	// The name of the owned resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// The action that would be performed (Create/Update/Delete)
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Action string `json:"action"`
+	// The paths of the fields that would change, for updates
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+}
+
 // ImageSpec defines the image for the component
 type ImageSpec struct {
 	// Docker repository of the image
@@ -273,6 +438,37 @@ type GrafanaDashboardSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	SelectorValue *string `json:"selectorValue,omitempty"`
+	// Name of the Prometheus datasource selected by default in the dashboards
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Datasource *string `json:"datasource,omitempty"`
+	// Extra template variables added to the dashboards, like the cluster or the environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Variables map[string]string `json:"variables,omitempty"`
+	// Grafana folder where the dashboards are created
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Folder *string `json:"folder,omitempty"`
+	// Names of the dashboards bundled with the operator for the component that are not created
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Disabled []string `json:"disabled,omitempty"`
+	// Additional dashboards provided by the user in ConfigMaps
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FromConfigMaps []ConfigMapDashboardSpec `json:"fromConfigMaps,omitempty"`
+}
+
+// ConfigMapDashboardSpec is a Grafana dashboard read from a ConfigMap
+type ConfigMapDashboardSpec struct {
+	// Name of the dashboard, unique within the component. The GrafanaDashboard
+	// resource is named "<component>-custom-<name>".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The key of the ConfigMap that holds the JSON model of the dashboard
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ConfigMapRef corev1.ConfigMapKeySelector `json:"configMapRef"`
 }
 
 type defaultGrafanaDashboardSpec struct {
@@ -293,6 +489,20 @@ func (spec *GrafanaDashboardSpec) IsDeactivated() bool {
 	return false
 }
 
+// IsDashboardEnabled true unless the dashboards are deactivated or the bundled
+// dashboard with the given name is in the list of disabled ones
+func (spec *GrafanaDashboardSpec) IsDashboardEnabled(name string) bool {
+	if spec.IsDeactivated() {
+		return false
+	}
+	for _, disabled := range spec.Disabled {
+		if disabled == name {
+			return false
+		}
+	}
+	return true
+}
+
 // InitializeGrafanaDashboardSpec initializes a GrafanaDashboardSpec struct
 func InitializeGrafanaDashboardSpec(spec *GrafanaDashboardSpec, def defaultGrafanaDashboardSpec) *GrafanaDashboardSpec {
 	if spec == nil {
@@ -308,6 +518,221 @@ func InitializeGrafanaDashboardSpec(spec *GrafanaDashboardSpec, def defaultGrafa
 	return spec
 }
 
+// PrometheusRulesSpec configures the Prometheus alerting rules for the component
+type PrometheusRulesSpec struct {
+	// Enables or disables the generation of the PrometheusRule of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Labels added to all the alerts of the component, like the ones used by
+	// Alertmanager to route the alerts. They take precedence over the default
+	// labels of the alerts, like their severity.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Overrides for the default alerting rules of the component, by alert name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Rules map[string]PrometheusAlertSpec `json:"rules,omitempty"`
+}
+
+// PrometheusAlertSpec overrides the settings of one of the default alerting rules
+type PrometheusAlertSpec struct {
+	// Disables the alert
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+	// Threshold that fires the alert, replacing the default one
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+	// Time the threshold needs to be exceeded before the alert fires, as a Prometheus duration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	For *string `json:"for,omitempty"`
+	// Labels added to the alert. They take precedence over the ones of the alert
+	// and the ones set for all the alerts of the component.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type defaultPrometheusRulesSpec struct {
+	Enabled *bool
+}
+
+// Default sets default values for any value not specifically set in the PrometheusRulesSpec struct
+func (spec *PrometheusRulesSpec) Default(def defaultPrometheusRulesSpec) {
+	spec.Enabled = boolOrDefault(spec.Enabled, def.Enabled)
+}
+
+// IsDeactivated true if the generation of the alerting rules has been disabled
+func (spec *PrometheusRulesSpec) IsDeactivated() bool {
+	return spec.Enabled != nil && !*spec.Enabled
+}
+
+// InitializePrometheusRulesSpec initializes a PrometheusRulesSpec struct
+func InitializePrometheusRulesSpec(spec *PrometheusRulesSpec, def defaultPrometheusRulesSpec) *PrometheusRulesSpec {
+	if spec == nil {
+		new := &PrometheusRulesSpec{}
+		new.Default(def)
+		return new
+	}
+	copy := spec.DeepCopy()
+	copy.Default(def)
+	return copy
+}
+
+// MonitoringMode is the way Prometheus discovers the metrics endpoints of the component
+// +kubebuilder:validation:Enum=podMonitor;serviceMonitor;annotations;none
+type MonitoringMode string
+
+const (
+	// PodMonitorMode generates a PodMonitor for each workload of the component
+	PodMonitorMode MonitoringMode = "podMonitor"
+	// ServiceMonitorMode generates a metrics Service and a ServiceMonitor for
+	// each workload of the component
+	ServiceMonitorMode MonitoringMode = "serviceMonitor"
+	// AnnotationsMode adds the "prometheus.io" scrape annotations to the Pods
+	// of each workload of the component
+	AnnotationsMode MonitoringMode = "annotations"
+	// NoMonitoringMode disables the monitoring of the component
+	NoMonitoringMode MonitoringMode = "none"
+)
+
+// MonitoringSpec configures how Prometheus scrapes the metrics of the component
+type MonitoringSpec struct {
+	// The way Prometheus discovers the metrics endpoints of the component. The
+	// "annotations" mode can only annotate one endpoint per Pod, so the metrics
+	// of the Envoy sidecar are not scraped for the components that have their own
+	// metrics endpoint.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Mode *MonitoringMode `json:"mode,omitempty"`
+	// Scrape interval for all the metrics endpoints of the component, as a
+	// Prometheus duration. Defaults to 30s for the component and 60s for
+	// the Envoy sidecar.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Interval *string `json:"interval,omitempty"`
+	// Relabelings applied to the targets before scraping. Unused in
+	// the "annotations" mode.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Relabelings []RelabelConfig `json:"relabelings,omitempty"`
+	// Relabelings applied to the samples before ingestion. Unused in
+	// the "annotations" mode.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MetricRelabelings []RelabelConfig `json:"metricRelabelings,omitempty"`
+}
+
+// RelabelConfig is a Prometheus relabeling step
+type RelabelConfig struct {
+	// The source labels select values from existing labels
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	// Separator placed between concatenated source label values. Defaults to ';'.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Separator string `json:"separator,omitempty"`
+	// Label to which the resulting value is written in a replace action
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+	// Regular expression against which the extracted value is matched. Defaults to '(.*)'.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Regex string `json:"regex,omitempty"`
+	// Modulus to take of the hash of the source label values
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Modulus uint64 `json:"modulus,omitempty"`
+	// Replacement value against which a regex replace is performed if the
+	// regular expression matches. Defaults to '$1'.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+	// Action to perform based on regex matching. Defaults to 'replace'.
+	// +kubebuilder:validation:Enum=replace;keep;drop;hashmod;labelmap;labeldrop;labelkeep
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+type defaultMonitoringSpec struct {
+	Mode *MonitoringMode
+}
+
+// Default sets default values for any value not specifically set in the MonitoringSpec struct
+func (spec *MonitoringSpec) Default(def defaultMonitoringSpec) {
+	if spec.Mode == nil {
+		spec.Mode = def.Mode
+	}
+}
+
+// IsDeactivated true if the monitoring of the component has been disabled
+func (spec *MonitoringSpec) IsDeactivated() bool {
+	return spec.Mode != nil && *spec.Mode == NoMonitoringMode
+}
+
+// IsMode returns true if the component is monitored in the given mode
+func (spec *MonitoringSpec) IsMode(mode MonitoringMode) bool {
+	return spec.Mode != nil && *spec.Mode == mode
+}
+
+// InitializeMonitoringSpec initializes a MonitoringSpec struct
+func InitializeMonitoringSpec(spec *MonitoringSpec, def defaultMonitoringSpec) *MonitoringSpec {
+	if spec == nil {
+		new := &MonitoringSpec{}
+		new.Default(def)
+		return new
+	}
+	copy := spec.DeepCopy()
+	copy.Default(def)
+	return copy
+}
+
+// PodAntiAffinityType is the way the Pods of a workload repel each other
+// +kubebuilder:validation:Enum=preferred;required;none
+type PodAntiAffinityType string
+
+const (
+	// PreferredPodAntiAffinity spreads the Pods of the workload across
+	// nodes and zones whenever possible
+	PreferredPodAntiAffinity PodAntiAffinityType = "preferred"
+	// RequiredPodAntiAffinity never schedules two Pods of the workload
+	// in the same topology domain
+	RequiredPodAntiAffinity PodAntiAffinityType = "required"
+	// NoPodAntiAffinity disables the anti-affinity between the Pods of the workload
+	NoPodAntiAffinity PodAntiAffinityType = "none"
+)
+
+// PodAntiAffinitySpec configures the anti-affinity between the Pods of a workload
+type PodAntiAffinitySpec struct {
+	// The "preferred" anti-affinity spreads the Pods across nodes and zones whenever
+	// possible, the "required" one never schedules two Pods in the same topology
+	// domain and "none" disables it. Defaults to "preferred".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Type *PodAntiAffinityType `json:"type,omitempty"`
+	// The topology domain of the "required" anti-affinity. Defaults
+	// to "kubernetes.io/hostname".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TopologyKey *string `json:"topologyKey,omitempty"`
+}
+
+// IsType returns true if the anti-affinity is of the given type. An empty
+// PodAntiAffinitySpec is of the "preferred" type.
+func (spec *PodAntiAffinitySpec) IsType(t PodAntiAffinityType) bool {
+	if spec == nil || spec.Type == nil {
+		return t == PreferredPodAntiAffinity
+	}
+	return *spec.Type == t
+}
+
 // Endpoint sets the external endpoint for the component
 type Endpoint struct {
 	// The list of dns records that will point to the component
@@ -397,6 +822,18 @@ type HorizontalPodAutoscalerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	ResourceUtilization *int32 `json:"resourceUtilization,omitempty"`
+	// Metrics used to autoscale in addition to the resource metric. Any
+	// of the resource, pods, object and external metric sources can be used,
+	// like the custom metrics served by the Prometheus adapter. When set, the
+	// resource metric is only used if resourceName is explicitly configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Metrics []autoscalingv2beta2.MetricSpec `json:"metrics,omitempty"`
+	// Behavior configures the scaling policies and the stabilization windows
+	// in the scale up and scale down directions
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Behavior *autoscalingv2beta2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
 }
 
 type defaultHorizontalPodAutoscalerSpec struct {
@@ -408,8 +845,12 @@ type defaultHorizontalPodAutoscalerSpec struct {
 func (spec *HorizontalPodAutoscalerSpec) Default(def defaultHorizontalPodAutoscalerSpec) {
 	spec.MinReplicas = intOrDefault(spec.MinReplicas, def.MinReplicas)
 	spec.MaxReplicas = intOrDefault(spec.MaxReplicas, def.MaxReplicas)
-	spec.ResourceName = stringOrDefault(spec.ResourceName, def.ResourceName)
-	spec.ResourceUtilization = intOrDefault(spec.ResourceUtilization, def.ResourceUtilization)
+	if len(spec.Metrics) == 0 {
+		spec.ResourceName = stringOrDefault(spec.ResourceName, def.ResourceName)
+	}
+	if spec.ResourceName != nil {
+		spec.ResourceUtilization = intOrDefault(spec.ResourceUtilization, def.ResourceUtilization)
+	}
 }
 
 // IsDeactivated true if the field is set with the deactivated value (empty struct)
@@ -435,6 +876,237 @@ func InitializeHorizontalPodAutoscalerSpec(spec *HorizontalPodAutoscalerSpec, de
 	return spec
 }
 
+// ScaledObjectSpec configures a KEDA ScaledObject that autoscales a queue worker
+// on the length of its queues. The HorizontalPodAutoscaler of the workload is
+// not created when the ScaledObject is configured.
+type ScaledObjectSpec struct {
+	// Lower limit for the number of replicas. Set it to 0 to
+	// scale the workload to zero when the queues are empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// Interval, in seconds, to check each trigger on
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// Period, in seconds, to wait after the last trigger reported
+	// active before scaling the workload back to 0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// The queues whose length is used to autoscale. Defaults
+	// to all the queues consumed by the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Queues []string `json:"queues,omitempty"`
+	// Target number of pending jobs per replica
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	QueueLength *int32 `json:"queueLength,omitempty"`
+	// The name of the TriggerAuthentication with the credentials of the server of
+	// the queues, set in the triggers generated for them. The credentials in the
+	// Redis DSNs are not passed to KEDA.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AuthenticationRef *string `json:"authenticationRef,omitempty"`
+	// Additional KEDA triggers, like the "prometheus" one
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Triggers []ScaleTriggerSpec `json:"triggers,omitempty"`
+	// Behavior configures the scaling policies and the stabilization windows
+	// in the scale up and scale down directions
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Behavior *autoscalingv2beta2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// ScaleTriggerSpec defines a KEDA trigger
+type ScaleTriggerSpec struct {
+	// The type of the KEDA scaler, like "prometheus"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Type string `json:"type"`
+	// The configuration of the scaler
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Metadata map[string]string `json:"metadata"`
+	// The name of the TriggerAuthentication with the credentials of the scaler
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AuthenticationRef *string `json:"authenticationRef,omitempty"`
+}
+
+type defaultScaledObjectSpec struct {
+	MinReplicas, MaxReplicas, QueueLength *int32
+	Queues                                []string
+}
+
+// Default sets default values for any value not specifically set in the ScaledObjectSpec struct
+func (spec *ScaledObjectSpec) Default(def defaultScaledObjectSpec) {
+	spec.MinReplicas = intOrDefault(spec.MinReplicas, def.MinReplicas)
+	spec.MaxReplicas = intOrDefault(spec.MaxReplicas, def.MaxReplicas)
+	spec.QueueLength = intOrDefault(spec.QueueLength, def.QueueLength)
+	if spec.Queues == nil {
+		spec.Queues = append([]string(nil), def.Queues...)
+	}
+}
+
+// IsDeactivated true if the ScaledObject is not configured. Unlike
+// other fields, an empty struct configures a ScaledObject with the
+// default values.
+func (spec *ScaledObjectSpec) IsDeactivated() bool {
+	return spec == nil
+}
+
+// InitializeScaledObjectSpec initializes a ScaledObjectSpec struct
+func InitializeScaledObjectSpec(spec *ScaledObjectSpec, def defaultScaledObjectSpec) *ScaledObjectSpec {
+	if spec.IsDeactivated() {
+		return nil
+	}
+	copy := spec.DeepCopy()
+	copy.Default(def)
+	return copy
+}
+
+// VerticalPodAutoscalerUpdateMode is the way the VerticalPodAutoscaler
+// applies its recommendations to the Pods of the workload
+// +kubebuilder:validation:Enum=Off;Initial;Auto
+type VerticalPodAutoscalerUpdateMode string
+
+const (
+	// VPAUpdateModeOff only computes the recommendations, which are
+	// reported in the status of the custom resource
+	VPAUpdateModeOff VerticalPodAutoscalerUpdateMode = "Off"
+	// VPAUpdateModeInitial applies the recommendations when the Pods are created
+	VPAUpdateModeInitial VerticalPodAutoscalerUpdateMode = "Initial"
+	// VPAUpdateModeAuto applies the recommendations when the Pods are created
+	// and evicts the running Pods whose resources drift from them
+	VPAUpdateModeAuto VerticalPodAutoscalerUpdateMode = "Auto"
+)
+
+// VerticalPodAutoscalerSpec configures a VerticalPodAutoscaler that
+// recommends the resources of the containers of a workload
+type VerticalPodAutoscalerSpec struct {
+	// The way the recommendations are applied to the Pods (Off/Initial/Auto).
+	// Defaults to "Off", which only reports them in the status.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UpdateMode *VerticalPodAutoscalerUpdateMode `json:"updateMode,omitempty"`
+	// The minimum resources that can be recommended for each container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// The maximum resources that can be recommended for each container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+	// The resources the recommendations are computed for. Defaults to cpu and memory.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ControlledResources []corev1.ResourceName `json:"controlledResources,omitempty"`
+}
+
+type defaultVerticalPodAutoscalerSpec struct {
+	UpdateMode          *VerticalPodAutoscalerUpdateMode
+	ControlledResources []corev1.ResourceName
+}
+
+var (
+	// defaultVPA holds the defaults of the VerticalPodAutoscaler, which are the same for all the workloads
+	defaultVPA defaultVerticalPodAutoscalerSpec = defaultVerticalPodAutoscalerSpec{
+		UpdateMode:          (*VerticalPodAutoscalerUpdateMode)(pointer.StringPtr(string(VPAUpdateModeOff))),
+		ControlledResources: []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory},
+	}
+)
+
+// Default sets default values for any value not specifically set in the VerticalPodAutoscalerSpec struct
+func (spec *VerticalPodAutoscalerSpec) Default(def defaultVerticalPodAutoscalerSpec) {
+	if spec.UpdateMode == nil {
+		spec.UpdateMode = def.UpdateMode
+	}
+	if spec.ControlledResources == nil {
+		spec.ControlledResources = append([]corev1.ResourceName(nil), def.ControlledResources...)
+	}
+}
+
+// IsDeactivated true if the VerticalPodAutoscaler is not configured. Unlike
+// other fields, an empty struct configures a VerticalPodAutoscaler with the
+// default values.
+func (spec *VerticalPodAutoscalerSpec) IsDeactivated() bool {
+	return spec == nil
+}
+
+// IsUpdating returns true if the VerticalPodAutoscaler is configured
+// and applies its recommendations to the Pods
+func (spec *VerticalPodAutoscalerSpec) IsUpdating() bool {
+	return !spec.IsDeactivated() && spec.UpdateMode != nil && *spec.UpdateMode != VPAUpdateModeOff
+}
+
+// InitializeVerticalPodAutoscalerSpec initializes a VerticalPodAutoscalerSpec struct
+func InitializeVerticalPodAutoscalerSpec(spec *VerticalPodAutoscalerSpec, def defaultVerticalPodAutoscalerSpec) *VerticalPodAutoscalerSpec {
+	if spec.IsDeactivated() {
+		return nil
+	}
+	copy := spec.DeepCopy()
+	copy.Default(def)
+	return copy
+}
+
+// MigrationSpec configures the Job that migrates the database of the component
+// before its workloads are rolled out with a new version
+type MigrationSpec struct {
+	// Number of retries before the migration is considered failed. Defaults to 2.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// Duration in seconds the migration may run before it is terminated
+	// and considered failed. Defaults to 600.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+type defaultMigrationSpec struct {
+	BackoffLimit          *int32
+	ActiveDeadlineSeconds *int64
+}
+
+var (
+	// defaultMigration holds the defaults of the database migrations, which are the same for all the components
+	defaultMigration defaultMigrationSpec = defaultMigrationSpec{
+		BackoffLimit:          pointer.Int32Ptr(2),
+		ActiveDeadlineSeconds: pointer.Int64Ptr(600),
+	}
+)
+
+// Default sets default values for any value not specifically set in the MigrationSpec struct
+func (spec *MigrationSpec) Default(def defaultMigrationSpec) {
+	spec.BackoffLimit = intOrDefault(spec.BackoffLimit, def.BackoffLimit)
+	if spec.ActiveDeadlineSeconds == nil {
+		spec.ActiveDeadlineSeconds = def.ActiveDeadlineSeconds
+	}
+}
+
+// IsDeactivated true if the migration of the database is not configured. Unlike
+// other fields, an empty struct enables the migration with the default values.
+func (spec *MigrationSpec) IsDeactivated() bool {
+	return spec == nil
+}
+
+// InitializeMigrationSpec initializes a MigrationSpec struct
+func InitializeMigrationSpec(spec *MigrationSpec, def defaultMigrationSpec) *MigrationSpec {
+	if spec.IsDeactivated() {
+		return nil
+	}
+	copy := spec.DeepCopy()
+	copy.Default(def)
+	return copy
+}
+
 // ResourceRequirementsSpec defines the resource requirements for the component
 type ResourceRequirementsSpec struct {
 	// Limits describes the maximum amount of compute resources allowed.
@@ -573,6 +1245,11 @@ type SecretReference struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Override *string `json:"override,omitempty"`
+	// FromSecret is a reference to a key of an existing Kubernetes Secret
+	// in the same namespace
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FromSecret *KubernetesSecretReference `json:"fromSecret,omitempty"`
 }
 
 // VaultSecretReference is a reference to a secret stored in
@@ -586,6 +1263,62 @@ type VaultSecretReference struct {
 	Key string `json:"key"`
 }
 
+// KubernetesSecretReference is a reference to a key of
+// a Kubernetes Secret
+type KubernetesSecretReference struct {
+	// The name of the Secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The key of the Secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Key string `json:"key"`
+}
+
+// SecretsProviderType is the kind of resources used to populate
+// the Secrets from the secrets stored in Vault
+// +kubebuilder:validation:Enum=SecretsManager;ExternalSecrets
+type SecretsProviderType string
+
+const (
+	// SecretsManagerProvider populates the Secrets using secrets-manager.tuenti.io
+	// SecretDefinitions
+	SecretsManagerProvider SecretsProviderType = "SecretsManager"
+	// ExternalSecretsProvider populates the Secrets using external-secrets.io
+	// ExternalSecrets
+	ExternalSecretsProvider SecretsProviderType = "ExternalSecrets"
+)
+
+// SecretStoreReference is a reference to an external-secrets.io SecretStore
+type SecretStoreReference struct {
+	// The name of the SecretStore
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The kind of the SecretStore, either SecretStore or ClusterSecretStore.
+	// Defaults to SecretStore.
+	// +kubebuilder:validation:Enum=SecretStore;ClusterSecretStore
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+}
+
+// SecretsProviderSpec configures the provider used to populate
+// the Secrets from the secrets stored in Vault
+type SecretsProviderSpec struct {
+	// The type of the provider
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Type *SecretsProviderType `json:"type,omitempty"`
+	// The SecretStore used by the ExternalSecrets provider
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretStoreRef *SecretStoreReference `json:"secretStoreRef,omitempty"`
+	// The interval at which the ExternalSecrets provider refreshes the
+	// Secrets from the SecretStore
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
 // BugsnagSpec has configuration for Bugsnag integration
 type BugsnagSpec struct {
 	// API key
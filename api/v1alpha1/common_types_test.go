@@ -657,6 +657,25 @@ func TestGrafanaDashboardSpec_IsDeactivated(t *testing.T) {
 	}
 }
 
+func TestGrafanaDashboardSpec_IsDashboardEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *GrafanaDashboardSpec
+		want bool
+	}{
+		{"Wants false if deactivated", &GrafanaDashboardSpec{}, false},
+		{"Wants false if disabled", &GrafanaDashboardSpec{SelectorKey: pointer.StringPtr("key"), Disabled: []string{"other", "dashboard"}}, false},
+		{"Wants true if not disabled", &GrafanaDashboardSpec{SelectorKey: pointer.StringPtr("key"), Disabled: []string{"other"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.IsDashboardEnabled("dashboard"); got != tt.want {
+				t.Errorf("GrafanaDashboardSpec_IsDashboardEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestInitializeGrafanaDashboardSpec(t *testing.T) {
 	type args struct {
 		spec *GrafanaDashboardSpec
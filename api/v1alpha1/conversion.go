@@ -0,0 +1,45 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the hub of the conversions between the versions of the
+// API: all the other versions convert from and to v1alpha1. It is also
+// the version the controllers and the admission webhooks work with.
+
+// Hub marks this type as a conversion hub.
+func (*Apicast) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*AutoSSL) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Backend) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*CORSProxy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*EchoAPI) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*MappingService) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*System) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Zync) Hub() {}
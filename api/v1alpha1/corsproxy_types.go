@@ -64,6 +64,10 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
+	corsproxyDashboards        []string              = []string{"cors-proxy"}
+	corsproxyDefaultMonitoring defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // CORSProxySpec defines the desired state of CORSProxy
@@ -88,6 +92,10 @@ type CORSProxySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -100,6 +108,10 @@ type CORSProxySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 	// Application specific configuration options for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Config CORSProxyConfig `json:"config"`
@@ -109,6 +121,25 @@ type CORSProxySpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *SecretsProviderSpec `json:"secretsProvider,omitempty"`
 }
 
 // Default implements defaulting for the CORSProxy resource
@@ -125,9 +156,11 @@ func (a *CORSProxy) Default() {
 
 	a.Spec.PDB = InitializePodDisruptionBudgetSpec(a.Spec.PDB, corsproxyDefaultPDB)
 	a.Spec.Resources = InitializeResourceRequirementsSpec(a.Spec.Resources, corsproxyDefaultResources)
+	a.Spec.VPA = InitializeVerticalPodAutoscalerSpec(a.Spec.VPA, defaultVPA)
 	a.Spec.LivenessProbe = InitializeProbeSpec(a.Spec.LivenessProbe, corsproxyDefaultProbe)
 	a.Spec.ReadinessProbe = InitializeProbeSpec(a.Spec.ReadinessProbe, corsproxyDefaultProbe)
 	a.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(a.Spec.GrafanaDashboard, corsproxyDefaultGrafanaDashboard)
+	a.Spec.Monitoring = InitializeMonitoringSpec(a.Spec.Monitoring, corsproxyDefaultMonitoring)
 	a.Spec.Config.Default()
 }
 
@@ -142,10 +175,15 @@ type CORSProxyConfig struct {
 func (cfg *CORSProxyConfig) Default() {}
 
 // CORSProxyStatus defines the observed state of CORSProxy
-type CORSProxyStatus struct{}
+type CORSProxyStatus struct {
+	ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // CORSProxy is the Schema for the corsproxies API
 type CORSProxy struct {
@@ -156,6 +194,11 @@ type CORSProxy struct {
 	Status CORSProxyStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the CORSProxy ComponentStatus
+func (cp *CORSProxy) GetComponentStatus() *ComponentStatus {
+	return &cp.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // CORSProxyList contains a list of CORSProxy
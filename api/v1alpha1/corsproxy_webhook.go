@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var corsproxylog = logf.Log.WithName("corsproxy-resource")
+
+// SetupWebhookWithManager registers the webhooks of the CORSProxy type in the manager
+func (r *CORSProxy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-corsproxy,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=corsproxies,verbs=create;update,versions=v1alpha1,name=mcorsproxy.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-corsproxy,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=corsproxies,verbs=create;update,versions=v1alpha1,name=vcorsproxy.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &CORSProxy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *CORSProxy) ValidateCreate() error {
+	corsproxylog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *CORSProxy) ValidateUpdate(old runtime.Object) error {
+	corsproxylog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *CORSProxy) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the CORSProxy. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *CORSProxy) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateSecretDSN(spec.Child("config", "systemDatabaseDSN"), instance.Spec.Config.SystemDatabaseDSN, databaseSchemes)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("vpa"), instance.Spec.VPA, instance.Spec.HPA, nil)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, corsproxyDashboards)...)
+
+	return invalid("CORSProxy", r.Name, allErrs)
+}
@@ -0,0 +1,81 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// defaultable is a custom resource that can apply defaults to its own spec
+type defaultable interface {
+	client.Object
+	Default()
+}
+
+// persistDefaultsHandler is an admission.Handler that stores the defaults of
+// the spec in the API, but only for the resources that have the PersistDefaultsAnnotation.
+// Resources without the annotation are admitted unchanged and get their defaults
+// applied in memory by the controllers.
+type persistDefaultsHandler struct {
+	apiType defaultable
+}
+
+var _ admission.Handler = &persistDefaultsHandler{}
+
+// Handle implements admission.Handler
+func (h *persistDefaultsHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := h.apiType.DeepCopyObject().(defaultable)
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if strings.TrimSpace(obj.GetAnnotations()[PersistDefaultsAnnotation]) != "true" {
+		return admission.Allowed("defaults not persisted")
+	}
+
+	obj.Default()
+	marshalled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled)
+}
+
+// registerDefaultingWebhook registers the mutating webhook of the given type in the
+// path the webhook builder would use. As the path is already handled, the builder does
+// not register its own defaulting webhook, which would always persist the defaults.
+func registerDefaultingWebhook(mgr manager.Manager, apiType defaultable) error {
+	gvk, err := apiutil.GVKForObject(apiType, mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/mutate-%s-%s-%s", strings.ReplaceAll(gvk.Group, ".", "-"), gvk.Version, strings.ToLower(gvk.Kind))
+	mgr.GetWebhookServer().Register(path, &admission.Webhook{Handler: &persistDefaultsHandler{apiType: apiType}})
+	return nil
+}
+
+// EffectiveSpec returns the spec of the given object in the format used
+// for the EffectiveSpec field of the ComponentStatus
+func EffectiveSpec(obj runtime.Object) (*runtime.RawExtension, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := u["spec"]
+	if !ok {
+		return nil, nil
+	}
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestPersistDefaultsHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantPatches bool
+	}{
+		{
+			name:        "Does not persist defaults without the annotation",
+			annotations: nil,
+			wantPatches: false,
+		},
+		{
+			name:        "Persists defaults with the annotation",
+			annotations: map[string]string{PersistDefaultsAnnotation: "true"},
+			wantPatches: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(&EchoAPI{
+				TypeMeta:   metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: "EchoAPI"},
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: tt.annotations},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &persistDefaultsHandler{apiType: &EchoAPI{}}
+			got := h.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{Object: runtime.RawExtension{Raw: raw}},
+			})
+			if !got.Allowed {
+				t.Errorf("Handle() not allowed: %v", got.Result)
+			}
+			if (len(got.Patches) > 0) != tt.wantPatches {
+				t.Errorf("Handle() patches = %v, wantPatches %v", got.Patches, tt.wantPatches)
+			}
+		})
+	}
+}
+
+func TestEffectiveSpec(t *testing.T) {
+	instance := &EchoAPI{}
+	instance.Default()
+	got, err := EffectiveSpec(instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := EchoAPISpec{}
+	if err := json.Unmarshal(got.Raw, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.Image == nil || spec.Image.Tag == nil || *spec.Image.Tag != *instance.Spec.Image.Tag {
+		t.Errorf("EffectiveSpec() = %s, does not contain the default image", string(got.Raw))
+	}
+}
@@ -71,6 +71,9 @@ var (
 		ProxyProtocol:                 pointer.BoolPtr(true),
 		CrossZoneLoadBalancingEnabled: pointer.BoolPtr(true),
 	}
+	echoapiDefaultMonitoring defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // EchoAPISpec defines the desired state of echoapi
@@ -95,6 +98,10 @@ type EchoAPISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -107,6 +114,10 @@ type EchoAPISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Marin3r *Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 	// Configures the AWS Network load balancer for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -120,6 +131,19 @@ type EchoAPISpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the EchoAPI resource
@@ -136,17 +160,24 @@ func (e *EchoAPI) Default() {
 
 	e.Spec.PDB = InitializePodDisruptionBudgetSpec(e.Spec.PDB, echoapiDefaultPDB)
 	e.Spec.Resources = InitializeResourceRequirementsSpec(e.Spec.Resources, echoapiDefaultResources)
+	e.Spec.VPA = InitializeVerticalPodAutoscalerSpec(e.Spec.VPA, defaultVPA)
 	e.Spec.LivenessProbe = InitializeProbeSpec(e.Spec.LivenessProbe, echoapiDefaultLivenessProbe)
 	e.Spec.ReadinessProbe = InitializeProbeSpec(e.Spec.ReadinessProbe, echoapiDefaultReadinessProbe)
 	e.Spec.Marin3r = InitializeMarin3rSidecarSpec(e.Spec.Marin3r, echoapiDefaultMarin3rSpec)
+	e.Spec.Monitoring = InitializeMonitoringSpec(e.Spec.Monitoring, echoapiDefaultMonitoring)
 	e.Spec.LoadBalancer = InitializeNLBLoadBalancerSpec(e.Spec.LoadBalancer, echoapiDefaultNLBLoadBalancer)
 }
 
 // EchoAPIStatus defines the observed state of EchoAPI
-type EchoAPIStatus struct{}
+type EchoAPIStatus struct {
+	ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // EchoAPI is the Schema for the echoapis API
 type EchoAPI struct {
@@ -157,6 +188,11 @@ type EchoAPI struct {
 	Status EchoAPIStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the EchoAPI ComponentStatus
+func (ea *EchoAPI) GetComponentStatus() *ComponentStatus {
+	return &ea.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // EchoAPIList contains a list of echoapi
@@ -0,0 +1,59 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var echoapilog = logf.Log.WithName("echoapi-resource")
+
+// SetupWebhookWithManager registers the webhooks of the EchoAPI type in the manager
+func (r *EchoAPI) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-echoapi,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=echoapis,verbs=create;update,versions=v1alpha1,name=mechoapi.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-echoapi,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=echoapis,verbs=create;update,versions=v1alpha1,name=vechoapi.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &EchoAPI{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *EchoAPI) ValidateCreate() error {
+	echoapilog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *EchoAPI) ValidateUpdate(old runtime.Object) error {
+	echoapilog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *EchoAPI) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the EchoAPI. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *EchoAPI) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateVPA(spec.Child("vpa"), instance.Spec.VPA, instance.Spec.HPA, nil)...)
+
+	return invalid("EchoAPI", r.Name, allErrs)
+}
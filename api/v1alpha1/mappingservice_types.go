@@ -71,7 +71,11 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
-	mappingserviceDefaultLogLevel string = "warn"
+	mappingserviceDashboards        []string              = []string{"mapping-service"}
+	mappingserviceDefaultLogLevel   string                = "warn"
+	mappingserviceDefaultMonitoring defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // MappingServiceSpec defines the desired state of MappingService
@@ -96,6 +100,10 @@ type MappingServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -108,6 +116,10 @@ type MappingServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 	// Application specific configuration options for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Config MappingServiceConfig `json:"config"`
@@ -117,6 +129,25 @@ type MappingServiceSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *SecretsProviderSpec `json:"secretsProvider,omitempty"`
 }
 
 // Default implements defaulting for the MappingService resource
@@ -133,9 +164,11 @@ func (ms *MappingService) Default() {
 
 	ms.Spec.PDB = InitializePodDisruptionBudgetSpec(ms.Spec.PDB, mappingserviceDefaultPDB)
 	ms.Spec.Resources = InitializeResourceRequirementsSpec(ms.Spec.Resources, mappingserviceDefaultResources)
+	ms.Spec.VPA = InitializeVerticalPodAutoscalerSpec(ms.Spec.VPA, defaultVPA)
 	ms.Spec.LivenessProbe = InitializeProbeSpec(ms.Spec.LivenessProbe, mappingserviceLivenessDefaultProbe)
 	ms.Spec.ReadinessProbe = InitializeProbeSpec(ms.Spec.ReadinessProbe, mappingserviceReadinessDefaultProbe)
 	ms.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(ms.Spec.GrafanaDashboard, mappingserviceDefaultGrafanaDashboard)
+	ms.Spec.Monitoring = InitializeMonitoringSpec(ms.Spec.Monitoring, mappingserviceDefaultMonitoring)
 	ms.Spec.Config.Default()
 }
 
@@ -150,7 +183,7 @@ type MappingServiceConfig struct {
 	PreviewBaseDomain *string `json:"previewBaseDomain,omitempty"`
 	// Openresty log level
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuiler:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+	// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
 	// A reference to the secret holding the system admin token
@@ -166,12 +199,14 @@ func (cfg *MappingServiceConfig) Default() {
 
 // MappingServiceStatus defines the observed state of MappingService
 type MappingServiceStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	ComponentStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // MappingService is the Schema for the mappingservices API
 type MappingService struct {
@@ -182,6 +217,11 @@ type MappingService struct {
 	Status MappingServiceStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the MappingService ComponentStatus
+func (ms *MappingService) GetComponentStatus() *ComponentStatus {
+	return &ms.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // MappingServiceList contains a list of MappingService
@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var mappingservicelog = logf.Log.WithName("mappingservice-resource")
+
+// SetupWebhookWithManager registers the webhooks of the MappingService type in the manager
+func (r *MappingService) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-mappingservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=mappingservices,verbs=create;update,versions=v1alpha1,name=mmappingservice.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-mappingservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=mappingservices,verbs=create;update,versions=v1alpha1,name=vmappingservice.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &MappingService{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *MappingService) ValidateCreate() error {
+	mappingservicelog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *MappingService) ValidateUpdate(old runtime.Object) error {
+	mappingservicelog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *MappingService) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the MappingService. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *MappingService) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("config", "logLevel"), instance.Spec.Config.LogLevel, nginxLogLevels)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("vpa"), instance.Spec.VPA, instance.Spec.HPA, nil)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, mappingserviceDashboards)...)
+
+	return invalid("MappingService", r.Name, allErrs)
+}
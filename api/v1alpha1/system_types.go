@@ -50,6 +50,10 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
+	systemDashboards             []string                   = []string{"system"}
+	systemDefaultPrometheusRules defaultPrometheusRulesSpec = defaultPrometheusRulesSpec{
+		Enabled: pointer.BoolPtr(true),
+	}
 
 	// App
 	systemDefaultAppReplicas     int32                   = 2
@@ -116,6 +120,13 @@ var (
 		ResourceUtilization: pointer.Int32Ptr(90),
 		ResourceName:        pointer.StringPtr("cpu"),
 	}
+	systemDefaultSidekiqScaledObject defaultScaledObjectSpec = defaultScaledObjectSpec{
+		MinReplicas: pointer.Int32Ptr(2),
+		MaxReplicas: pointer.Int32Ptr(4),
+		QueueLength: pointer.Int32Ptr(100),
+		Queues: []string{"critical", "backend_sync", "events", "zync", "priority",
+			"default", "web_hooks", "mailers", "deletion", "low", "bulk_indexing"},
+	}
 	systemDefaultSidekiqLivenessProbe defaultProbeSpec = defaultProbeSpec{
 		InitialDelaySeconds: pointer.Int32Ptr(10),
 		TimeoutSeconds:      pointer.Int32Ptr(3),
@@ -168,6 +179,9 @@ var (
 		SuccessThreshold:    pointer.Int32Ptr(1),
 		FailureThreshold:    pointer.Int32Ptr(5),
 	}
+	systemDefaultMonitoring defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // SystemSpec defines the desired state of System
@@ -191,10 +205,29 @@ type SystemSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Sphinx *SystemSphinxSpec `json:"sphinx,omitempty"`
+	// Runs the database migrations in a Job before rolling out a new
+	// version of the workloads. Disabled when not set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Migration *SystemMigrationSpec `json:"migration,omitempty"`
 	// Configures the Grafana Dashboard for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *SecretsProviderSpec `json:"secretsProvider,omitempty"`
 }
 
 // Default implements defaulting for the System resource
@@ -203,6 +236,8 @@ func (s *System) Default() {
 	s.Spec.Config.Default()
 	s.Spec.Image = InitializeImageSpec(s.Spec.Image, systemDefaultImage)
 	s.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(s.Spec.GrafanaDashboard, systemDefaultGrafanaDashboard)
+	s.Spec.PrometheusRules = InitializePrometheusRulesSpec(s.Spec.PrometheusRules, systemDefaultPrometheusRules)
+	s.Spec.Monitoring = InitializeMonitoringSpec(s.Spec.Monitoring, systemDefaultMonitoring)
 	if s.Spec.App == nil {
 		s.Spec.App = &SystemAppSpec{}
 	}
@@ -217,6 +252,7 @@ func (s *System) Default() {
 		s.Spec.Sphinx = &SystemSphinxSpec{}
 	}
 	s.Spec.Sphinx.Default(s.Spec.Image)
+	s.Spec.Migration = InitializeSystemMigrationSpec(s.Spec.Migration)
 }
 
 // SystemConfig holds configuration for SystemApp component
@@ -383,6 +419,38 @@ type SystemSeedSpec struct {
 	TenantName string `json:"tenantName"`
 }
 
+// SystemMigrationSpec configures the Job that migrates the database of System
+type SystemMigrationSpec struct {
+	// Also runs db:seed after db:migrate, which creates the master and the
+	// tenant accounts of config.seed in an empty database. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Seed *bool `json:"seed,omitempty"`
+	// Settings of the migration Job
+	MigrationSpec `json:",inline"`
+}
+
+// Default sets default values for any value not specifically set in the SystemMigrationSpec struct
+func (spec *SystemMigrationSpec) Default() {
+	spec.Seed = boolOrDefault(spec.Seed, pointer.BoolPtr(false))
+	spec.MigrationSpec.Default(defaultMigration)
+}
+
+// IsDeactivated true if the migration of the database is not configured
+func (spec *SystemMigrationSpec) IsDeactivated() bool {
+	return spec == nil
+}
+
+// InitializeSystemMigrationSpec initializes a SystemMigrationSpec struct
+func InitializeSystemMigrationSpec(spec *SystemMigrationSpec) *SystemMigrationSpec {
+	if spec.IsDeactivated() {
+		return nil
+	}
+	copy := spec.DeepCopy()
+	copy.Default()
+	return copy
+}
+
 // SystemRecaptchaSpec holds recaptcha configurations
 type SystemRecaptchaSpec struct {
 	// Public key
@@ -551,6 +619,10 @@ type SystemAppSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -569,6 +641,19 @@ type SystemAppSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the system App component
@@ -583,6 +668,7 @@ func (spec *SystemAppSpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, systemDefaultAppPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultAppResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, systemDefaultAppLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, systemDefaultAppReadinessProbe)
 	// spec.LoadBalancer = InitializeLoadBalancerSpec(spec.LoadBalancer, systemDefaultAppLoadBalancer)
@@ -599,6 +685,11 @@ type SystemSidekiqSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject that autoscales the component on the length of
+	// its queues. The hpa is ignored when the ScaledObject is configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScaledObject *ScaledObjectSpec `json:"scaledObject,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -607,6 +698,10 @@ type SystemSidekiqSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -621,13 +716,27 @@ type SystemSidekiqSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the system App component
 func (spec *SystemSidekiqSpec) Default() {
 	spec.HPA = InitializeHorizontalPodAutoscalerSpec(spec.HPA, systemDefaultSidekiqHPA)
+	spec.ScaledObject = InitializeScaledObjectSpec(spec.ScaledObject, systemDefaultSidekiqScaledObject)
 
-	if spec.HPA.IsDeactivated() {
+	if spec.HPA.IsDeactivated() && spec.ScaledObject.IsDeactivated() {
 		spec.Replicas = intOrDefault(spec.Replicas, &systemDefaultSidekiqReplicas)
 	} else {
 		spec.Replicas = nil
@@ -635,6 +744,7 @@ func (spec *SystemSidekiqSpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, systemDefaultSidekiqPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultSidekiqResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, systemDefaultSidekiqLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, systemDefaultSidekiqReadinessProbe)
 }
@@ -654,6 +764,10 @@ type SystemSphinxSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -668,6 +782,19 @@ type SystemSphinxSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the system sphinx component
@@ -675,6 +802,7 @@ func (spec *SystemSphinxSpec) Default(systemDefaultImage *ImageSpec) {
 
 	spec.Image = InitializeImageSpec(spec.Image, defaultImageSpec(*systemDefaultImage))
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultSphinxResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, systemDefaultSphinxLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, systemDefaultSphinxReadinessProbe)
 	if spec.Config == nil {
@@ -756,10 +884,15 @@ func (tc *ThinkingSpec) Default() {
 }
 
 // SystemStatus defines the observed state of System
-type SystemStatus struct{}
+type SystemStatus struct {
+	ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // System is the Schema for the systems API
 type System struct {
@@ -770,6 +903,11 @@ type System struct {
 	Status SystemStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the System ComponentStatus
+func (s *System) GetComponentStatus() *ComponentStatus {
+	return &s.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // SystemList contains a list of System
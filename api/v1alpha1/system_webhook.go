@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var systemlog = logf.Log.WithName("system-resource")
+
+// SetupWebhookWithManager registers the webhooks of the System type in the manager
+func (r *System) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-system,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=systems,verbs=create;update,versions=v1alpha1,name=msystem.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-system,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=systems,verbs=create;update,versions=v1alpha1,name=vsystem.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &System{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *System) ValidateCreate() error {
+	systemlog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *System) ValidateUpdate(old runtime.Object) error {
+	systemlog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *System) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the System. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *System) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateSecretDSN(spec.Child("config", "databaseDSN"), instance.Spec.Config.DatabaseDSN, databaseSchemes)...)
+	allErrs = append(allErrs, validateRedisDSN(spec.Child("config", "redis", "queuesDSN"), instance.Spec.Config.Redis.QueuesDSN)...)
+	allErrs = append(allErrs, validateRedisDSN(spec.Child("config", "redis", "messageBusDSN"), instance.Spec.Config.Redis.MessageBusDSN)...)
+	allErrs = append(allErrs, validateRedisDSN(spec.Child("config", "backend", "redisDSN"), instance.Spec.Config.Backend.RedisDSN)...)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("config", "rails", "logLevel"), instance.Spec.Config.Rails.LogLevel, railsLogLevels)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("app", "vpa"), instance.Spec.App.VPA, instance.Spec.App.HPA, nil)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("sidekiq", "vpa"), instance.Spec.Sidekiq.VPA, instance.Spec.Sidekiq.HPA, instance.Spec.Sidekiq.ScaledObject)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, systemDashboards)...)
+
+	return invalid("System", r.Name, allErrs)
+}
@@ -0,0 +1,546 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	// nginxLogLevels are the log levels accepted by openresty/nginx
+	nginxLogLevels []string = []string{"debug", "info", "notice", "warn", "error", "crit", "alert", "emerg"}
+	// railsLogLevels are the log levels accepted by rails
+	railsLogLevels []string = []string{"debug", "info", "warn", "error", "fatal", "unknown"}
+	// redisSchemes are the schemes accepted in redis DSNs
+	redisSchemes []string = []string{"redis", "rediss"}
+	// databaseSchemes are the schemes accepted in database DSNs
+	databaseSchemes []string = []string{"mysql", "mysql2", "postgres", "postgresql", "oracle-enhanced"}
+	// prometheusDuration matches the durations accepted by Prometheus, like "5m" or "1h30m"
+	prometheusDuration *regexp.Regexp = regexp.MustCompile(`^([0-9]+(ms|s|m|h|d|w|y))+$`)
+)
+
+// specValidator is implemented by the types that can validate themselves
+// wherever they appear within the spec of a custom resource
+type specValidator interface {
+	validate(fldPath *field.Path) field.ErrorList
+}
+
+// validate checks that exactly one of the sources of the SecretReference is set
+func (sr *SecretReference) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	sources := 0
+	for _, set := range []bool{sr.FromVault != nil, sr.Override != nil, sr.FromSecret != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "one of 'fromVault', 'fromSecret' or 'override' must be set"))
+	}
+	if sources > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of 'fromVault', 'fromSecret' or 'override' can be set"))
+	}
+	if sr.FromVault != nil {
+		if sr.FromVault.Path == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("fromVault", "path"), ""))
+		}
+		if sr.FromVault.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("fromVault", "key"), ""))
+		}
+	}
+	if sr.FromSecret != nil {
+		if sr.FromSecret.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("fromSecret", "name"), ""))
+		}
+		if sr.FromSecret.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("fromSecret", "key"), ""))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that the replicas boundaries of the HorizontalPodAutoscalerSpec are consistent
+func (spec *HorizontalPodAutoscalerSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.IsDeactivated() {
+		return allErrs
+	}
+	if spec.MinReplicas != nil && *spec.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *spec.MinReplicas, "must be greater than or equal to 1"))
+	}
+	if spec.MaxReplicas != nil && *spec.MaxReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *spec.MaxReplicas, "must be greater than or equal to 1"))
+	}
+	if spec.MinReplicas != nil && spec.MaxReplicas != nil && *spec.MinReplicas > *spec.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *spec.MaxReplicas,
+			fmt.Sprintf("must be greater than or equal to minReplicas (%d)", *spec.MinReplicas)))
+	}
+	if spec.ResourceUtilization != nil && *spec.ResourceUtilization < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceUtilization"), *spec.ResourceUtilization, "must be greater than 0"))
+	}
+	for idx, metric := range spec.Metrics {
+		if !hasMetricSource(metric) {
+			allErrs = append(allErrs, field.Required(fldPath.Child("metrics").Index(idx),
+				fmt.Sprintf("the source of the %q metric type must be set", metric.Type)))
+		}
+	}
+	return allErrs
+}
+
+func (spec *ScaledObjectSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.MinReplicas != nil && *spec.MinReplicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *spec.MinReplicas, "must be greater than or equal to 0"))
+	}
+	if spec.MaxReplicas != nil && *spec.MaxReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *spec.MaxReplicas, "must be greater than or equal to 1"))
+	}
+	if spec.MinReplicas != nil && spec.MaxReplicas != nil && *spec.MinReplicas > *spec.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *spec.MaxReplicas,
+			fmt.Sprintf("must be greater than or equal to minReplicas (%d)", *spec.MinReplicas)))
+	}
+	if spec.QueueLength != nil && *spec.QueueLength < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("queueLength"), *spec.QueueLength, "must be greater than 0"))
+	}
+	for idx, trigger := range spec.Triggers {
+		if trigger.Type == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("triggers").Index(idx).Child("type"), ""))
+		}
+	}
+	return allErrs
+}
+
+// hasMetricSource returns true if the source that
+// corresponds to the type of the metric is set
+func hasMetricSource(metric autoscalingv2beta2.MetricSpec) bool {
+	switch metric.Type {
+	case autoscalingv2beta2.ResourceMetricSourceType:
+		return metric.Resource != nil
+	case autoscalingv2beta2.PodsMetricSourceType:
+		return metric.Pods != nil
+	case autoscalingv2beta2.ObjectMetricSourceType:
+		return metric.Object != nil
+	case autoscalingv2beta2.ExternalMetricSourceType:
+		return metric.External != nil
+	case autoscalingv2beta2.ContainerResourceMetricSourceType:
+		return metric.ContainerResource != nil
+	}
+	return false
+}
+
+// validate checks that the VerticalPodAutoscalerSpec only controls cpu and memory
+// and that the minimum allowed resources do not exceed the maximum ones
+func (spec *VerticalPodAutoscalerSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for idx, name := range spec.ControlledResources {
+		if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("controlledResources").Index(idx), name,
+				[]string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+		}
+	}
+	for name, min := range spec.MinAllowed {
+		if max, ok := spec.MaxAllowed[name]; ok && min.Cmp(max) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAllowed").Key(string(name)), max.String(),
+				fmt.Sprintf("must be greater than or equal to minAllowed (%s)", min.String())))
+		}
+	}
+	return allErrs
+}
+
+// validateVPA checks that a VerticalPodAutoscaler that updates the Pods of a workload does
+// not control any of the resources its HorizontalPodAutoscaler scales on, as both autoscalers
+// would fight over them. The HorizontalPodAutoscaler is not created, and so not checked, when
+// the workload is autoscaled by a ScaledObject.
+func validateVPA(fldPath *field.Path, vpa *VerticalPodAutoscalerSpec, hpa *HorizontalPodAutoscalerSpec,
+	scaledObject *ScaledObjectSpec) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !vpa.IsUpdating() || hpa == nil || hpa.IsDeactivated() || !scaledObject.IsDeactivated() {
+		return allErrs
+	}
+	scaled := map[corev1.ResourceName]bool{}
+	if hpa.ResourceName != nil {
+		scaled[corev1.ResourceName(*hpa.ResourceName)] = true
+	}
+	for _, metric := range hpa.Metrics {
+		switch {
+		case metric.Type == autoscalingv2beta2.ResourceMetricSourceType && metric.Resource != nil:
+			scaled[metric.Resource.Name] = true
+		case metric.Type == autoscalingv2beta2.ContainerResourceMetricSourceType && metric.ContainerResource != nil:
+			scaled[metric.ContainerResource.Name] = true
+		}
+	}
+	for idx, name := range vpa.ControlledResources {
+		if scaled[name] {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("controlledResources").Index(idx),
+				fmt.Sprintf("the HorizontalPodAutoscaler scales on %s, which can only be controlled in the %q updateMode",
+					name, VPAUpdateModeOff)))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that only one of the settings of the PodDisruptionBudgetSpec is used
+// and that its value is either a positive integer or a percentage
+func (spec *PodDisruptionBudgetSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.IsDeactivated() {
+		return allErrs
+	}
+	if spec.MinAvailable != nil && spec.MaxUnavailable != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "'minAvailable' and 'maxUnavailable' are mutually exclusive"))
+	}
+	if spec.MinAvailable != nil {
+		allErrs = append(allErrs, validateIntOrPercent(fldPath.Child("minAvailable"), spec.MinAvailable)...)
+	}
+	if spec.MaxUnavailable != nil {
+		allErrs = append(allErrs, validateIntOrPercent(fldPath.Child("maxUnavailable"), spec.MaxUnavailable)...)
+	}
+	return allErrs
+}
+
+// validate checks that the Endpoint has valid and unique DNS names
+func (e *Endpoint) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := map[string]bool{}
+	for idx, dns := range e.DNS {
+		path := fldPath.Child("dns").Index(idx)
+		if seen[dns] {
+			allErrs = append(allErrs, field.Duplicate(path, dns))
+			continue
+		}
+		seen[dns] = true
+		name := strings.TrimSuffix(dns, ".")
+		if strings.HasPrefix(name, "*.") {
+			name = strings.TrimPrefix(name, "*.")
+		}
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(path, dns, msg))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that the dashboards provided in ConfigMaps have unique and valid names
+// and a reference to a ConfigMap key
+func (spec *GrafanaDashboardSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := map[string]bool{}
+	for idx, dashboard := range spec.FromConfigMaps {
+		path := fldPath.Child("fromConfigMaps").Index(idx)
+		if seen[dashboard.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), dashboard.Name))
+		}
+		seen[dashboard.Name] = true
+		for _, msg := range validation.IsDNS1123Label(dashboard.Name) {
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), dashboard.Name, msg))
+		}
+		if dashboard.ConfigMapRef.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("configMapRef", "name"), ""))
+		}
+		if dashboard.ConfigMapRef.Key == "" {
+			allErrs = append(allErrs, field.Required(path.Child("configMapRef", "key"), ""))
+		}
+	}
+	return allErrs
+}
+
+// validateDisabledDashboards checks that the disabled dashboards are among the ones bundled for the component
+func validateDisabledDashboards(fldPath *field.Path, spec *GrafanaDashboardSpec, bundled []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec == nil {
+		return allErrs
+	}
+	for idx, name := range spec.Disabled {
+		found := false
+		for _, b := range bundled {
+			found = found || name == b
+		}
+		if !found {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("disabled").Index(idx), name, bundled))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that the overrides of the alerting rules have a numeric threshold and a valid duration
+func (spec *PrometheusRulesSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := make([]string, 0, len(spec.Rules))
+	for name := range spec.Rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rule := spec.Rules[name]
+		path := fldPath.Child("rules").Key(name)
+		if rule.Threshold != nil {
+			if _, err := strconv.ParseFloat(*rule.Threshold, 64); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("threshold"), *rule.Threshold, "must be a number"))
+			}
+		}
+		if rule.For != nil && !prometheusDuration.MatchString(*rule.For) {
+			allErrs = append(allErrs, field.Invalid(path.Child("for"), *rule.For, "must be a duration like '5m' or '1h30m'"))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that the scrape interval is a valid duration and that
+// the regular expressions of the relabelings compile
+func (spec *MonitoringSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.Interval != nil && !prometheusDuration.MatchString(*spec.Interval) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), *spec.Interval, "must be a duration like '30s' or '1m'"))
+	}
+	allErrs = append(allErrs, validateRelabelings(fldPath.Child("relabelings"), spec.Relabelings)...)
+	allErrs = append(allErrs, validateRelabelings(fldPath.Child("metricRelabelings"), spec.MetricRelabelings)...)
+	return allErrs
+}
+
+func validateRelabelings(fldPath *field.Path, relabelings []RelabelConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for idx, relabeling := range relabelings {
+		if _, err := regexp.Compile(relabeling.Regex); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(idx).Child("regex"), relabeling.Regex, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that the topology key is only set for the "required" anti-affinity
+func (spec *PodAntiAffinitySpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.TopologyKey != nil && !spec.IsType(RequiredPodAntiAffinity) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("topologyKey"), "only allowed for the 'required' anti-affinity"))
+	}
+	return allErrs
+}
+
+// validate checks that the backend cron jobs have unique names and that
+// the options of the Deployment mode are not used in "cronjob" mode
+func (spec *CronSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := map[string]bool{}
+	for idx, job := range spec.Jobs {
+		if seen[job.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("jobs").Index(idx).Child("name"), job.Name))
+		}
+		seen[job.Name] = true
+	}
+	if spec.IsMode(BackendCronCronJobMode) {
+		if len(spec.Jobs) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("jobs"), "at least one job is required in 'cronjob' mode"))
+		}
+		if !spec.VPA.IsDeactivated() {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vpa"), "not supported in 'cronjob' mode"))
+		}
+	}
+	return allErrs
+}
+
+// validate checks that the name of the backend cron job is a valid
+// DNS label and that the schedule has the five fields of the Cron format
+func (spec *BackendCronJobSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Label(spec.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), spec.Name, msg))
+	}
+	if !strings.HasPrefix(spec.Schedule, "@") && len(strings.Fields(spec.Schedule)) != 5 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), spec.Schedule,
+			"must have the five fields of the Cron format, like '*/5 * * * *'"))
+	}
+	if len(spec.Args) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("args"), ""))
+	}
+	return allErrs
+}
+
+// validate checks that the SecretStore reference has a name and that the settings
+// of the ExternalSecrets provider are not used with other providers. Whether a
+// SecretStore is set for the ExternalSecrets provider can only be checked when
+// reconciling, as it can also be configured through the flags of the operator.
+func (spec *SecretsProviderSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.SecretStoreRef != nil && spec.SecretStoreRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretStoreRef", "name"), ""))
+	}
+	if spec.RefreshInterval != nil && spec.RefreshInterval.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("refreshInterval"), spec.RefreshInterval.Duration.String(),
+			"must be greater than or equal to 0"))
+	}
+	if spec.Type != nil && *spec.Type != ExternalSecretsProvider {
+		if spec.SecretStoreRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretStoreRef"),
+				fmt.Sprintf("only used by the %q provider", ExternalSecretsProvider)))
+		}
+		if spec.RefreshInterval != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("refreshInterval"),
+				fmt.Sprintf("only used by the %q provider", ExternalSecretsProvider)))
+		}
+	}
+	return allErrs
+}
+
+// validateSpec walks the given spec and validates all the fields whose
+// type implements specValidator, using the json names of the fields to
+// build the field paths of the errors
+func validateSpec(fldPath *field.Path, spec interface{}) field.ErrorList {
+	return validateValue(fldPath, reflect.ValueOf(spec))
+}
+
+func validateValue(fldPath *field.Path, v reflect.Value) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return allErrs
+		}
+		allErrs = append(allErrs, validateValue(fldPath, v.Elem())...)
+
+	case reflect.Struct:
+		// Use a pointer so methods with pointer receivers are found
+		if v.CanAddr() {
+			if sv, ok := v.Addr().Interface().(specValidator); ok {
+				allErrs = append(allErrs, sv.validate(fldPath)...)
+			}
+		} else {
+			p := reflect.New(v.Type())
+			p.Elem().Set(v)
+			return validateValue(fldPath, p)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			path := fldPath
+			if name != "" && name != "-" {
+				path = fldPath.Child(name)
+			}
+			allErrs = append(allErrs, validateValue(path, v.Field(i))...)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			allErrs = append(allErrs, validateValue(fldPath.Index(i), v.Index(i))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateIntOrPercent checks that the value is a non-negative
+// integer or a percentage between 0% and 100%
+func validateIntOrPercent(fldPath *field.Path, value *intstr.IntOrString) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch value.Type {
+	case intstr.Int:
+		if value.IntVal < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.IntVal, "must be greater than or equal to 0"))
+		}
+	case intstr.String:
+		s := strings.TrimSuffix(value.StrVal, "%")
+		v, err := strconv.Atoi(s)
+		if err != nil || s == value.StrVal || v < 0 || v > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "must be an integer or a percentage between 0% and 100%"))
+		}
+	}
+	return allErrs
+}
+
+// validateLogLevel checks that the log level, if set, is one of the allowed ones
+func validateLogLevel(fldPath *field.Path, level *string, allowed []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if level == nil {
+		return allErrs
+	}
+	for _, l := range allowed {
+		if *level == l {
+			return allErrs
+		}
+	}
+	return append(allErrs, field.NotSupported(fldPath, *level, allowed))
+}
+
+// validateRedisDSN checks that the DSN is either a "host[:port]" address
+// or an URL with one of the redis schemes
+func validateRedisDSN(fldPath *field.Path, dsn string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if dsn == "" {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+	if strings.Contains(dsn, "://") {
+		return validateDSN(fldPath, dsn, redisSchemes)
+	}
+	host := dsn
+	if h, port, err := net.SplitHostPort(dsn); err == nil {
+		host = h
+		if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+			allErrs = append(allErrs, field.Invalid(fldPath, dsn, "invalid port"))
+		}
+	}
+	if host == "" || strings.ContainsAny(host, " /@") {
+		allErrs = append(allErrs, field.Invalid(fldPath, dsn, "must be a 'host[:port]' address or a redis URL"))
+	}
+	return allErrs
+}
+
+// validateDSN checks that the DSN is an URL with one of the given schemes and a host
+func validateDSN(fldPath *field.Path, dsn string, schemes []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	u, err := url.Parse(dsn)
+	if err != nil {
+		// Do not include the DSN in the error as it might contain credentials
+		return append(allErrs, field.Invalid(fldPath, "<redacted>", fmt.Sprintf("unable to parse DSN: %s", redactURLError(err))))
+	}
+	if u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, "<redacted>", "the DSN has no host"))
+	}
+	for _, s := range schemes {
+		if u.Scheme == s {
+			return allErrs
+		}
+	}
+	return append(allErrs, field.NotSupported(fldPath.Child("scheme"), u.Scheme, schemes))
+}
+
+// validateSecretDSN validates the DSN of a SecretReference, which is only known
+// when its value is set with 'override'
+func validateSecretDSN(fldPath *field.Path, sr SecretReference, schemes []string) field.ErrorList {
+	if sr.Override == nil || sr.FromVault != nil || sr.FromSecret != nil {
+		return field.ErrorList{}
+	}
+	return validateDSN(fldPath.Child("override"), *sr.Override, schemes)
+}
+
+// redactURLError returns the error message of an url.Error without the URL
+func redactURLError(err error) string {
+	if uerr, ok := err.(*url.Error); ok {
+		return uerr.Err.Error()
+	}
+	return err.Error()
+}
+
+// invalid returns an Invalid API error for the given kind and name if the
+// list of errors is not empty
+func invalid(kind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind(kind).GroupKind(), name, allErrs)
+}
@@ -36,6 +36,10 @@ var (
 		SelectorKey:   pointer.StringPtr("monitoring-key"),
 		SelectorValue: pointer.StringPtr("middleware"),
 	}
+	zyncDashboards             []string                   = []string{"zync"}
+	zyncDefaultPrometheusRules defaultPrometheusRulesSpec = defaultPrometheusRulesSpec{
+		Enabled: pointer.BoolPtr(true),
+	}
 	zyncDefaultConfigRailsEnvironment string                             = "development"
 	zyncDefaultConfigRailsLogLevel    string                             = "info"
 	zyncDefaultConfigRailsMaxThreads  int32                              = 10
@@ -80,6 +84,11 @@ var (
 		ResourceUtilization: pointer.Int32Ptr(90),
 		ResourceName:        pointer.StringPtr("cpu"),
 	}
+	zyncDefaultQueScaledObject defaultScaledObjectSpec = defaultScaledObjectSpec{
+		MinReplicas: pointer.Int32Ptr(2),
+		MaxReplicas: pointer.Int32Ptr(4),
+		QueueLength: pointer.Int32Ptr(100),
+	}
 	zyncDefaultQuePDB defaultPodDisruptionBudgetSpec = defaultPodDisruptionBudgetSpec{
 		MaxUnavailable: util.IntStrPtr(intstr.FromInt(1)),
 	}
@@ -108,6 +117,9 @@ var (
 		SuccessThreshold:    pointer.Int32Ptr(1),
 		FailureThreshold:    pointer.Int32Ptr(3),
 	}
+	zyncDefaultMonitoring defaultMonitoringSpec = defaultMonitoringSpec{
+		Mode: (*MonitoringMode)(pointer.StringPtr(string(PodMonitorMode))),
+	}
 )
 
 // ZyncSpec defines the desired state of Zync
@@ -123,6 +135,14 @@ type ZyncSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
 	// Configures the main zync api component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -131,6 +151,17 @@ type ZyncSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Que *QueSpec `json:"que,omitempty"`
+	// Runs the database migrations in a Job before rolling out a new
+	// version of the workloads. Disabled when not set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Migration *MigrationSpec `json:"migration,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *SecretsProviderSpec `json:"secretsProvider,omitempty"`
 }
 
 // Default implements defaulting for the Zync resource
@@ -147,6 +178,9 @@ func (z *Zync) Default() {
 	}
 	z.Spec.Que.Default()
 	z.Spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(z.Spec.GrafanaDashboard, zyncDefaultGrafanaDashboard)
+	z.Spec.PrometheusRules = InitializePrometheusRulesSpec(z.Spec.PrometheusRules, zyncDefaultPrometheusRules)
+	z.Spec.Monitoring = InitializeMonitoringSpec(z.Spec.Monitoring, zyncDefaultMonitoring)
+	z.Spec.Migration = InitializeMigrationSpec(z.Spec.Migration, defaultMigration)
 }
 
 // APISpec is the configuration for main Zync api component
@@ -167,6 +201,10 @@ type APISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -181,6 +219,19 @@ type APISpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the each main zync api component
@@ -196,6 +247,7 @@ func (spec *APISpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, zyncDefaultAPIPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, zyncDefaultAPIResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, zyncDefaultAPILivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, zyncDefaultAPIReadinessProbe)
 }
@@ -210,6 +262,11 @@ type QueSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject that autoscales the component on the length of
+	// its queues. The hpa is ignored when the ScaledObject is configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScaledObject *ScaledObjectSpec `json:"scaledObject,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -218,6 +275,10 @@ type QueSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler that recommends the resources of the containers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -232,14 +293,28 @@ type QueSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +optional
+	PodAntiAffinity *PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // Default implements defaulting for the each zync que
 func (spec *QueSpec) Default() {
 
 	spec.HPA = InitializeHorizontalPodAutoscalerSpec(spec.HPA, zyncDefaultQueHPA)
+	spec.ScaledObject = InitializeScaledObjectSpec(spec.ScaledObject, zyncDefaultQueScaledObject)
 
-	if spec.HPA.IsDeactivated() {
+	if spec.HPA.IsDeactivated() && spec.ScaledObject.IsDeactivated() {
 		spec.Replicas = intOrDefault(spec.Replicas, &zyncDefaultQueReplicas)
 	} else {
 		spec.Replicas = nil
@@ -247,6 +322,7 @@ func (spec *QueSpec) Default() {
 
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, zyncDefaultQuePDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, zyncDefaultQueResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, defaultVPA)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, zyncDefaultQueLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, zyncDefaultQueReadinessProbe)
 }
@@ -308,10 +384,15 @@ func (zrs *ZyncRailsSpec) Default() {
 }
 
 // ZyncStatus defines the observed state of Zync
-type ZyncStatus struct{}
+type ZyncStatus struct {
+	ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Zync is the Schema for the zyncs API
 type Zync struct {
@@ -322,6 +403,11 @@ type Zync struct {
 	Status ZyncStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the Zync ComponentStatus
+func (z *Zync) GetComponentStatus() *ComponentStatus {
+	return &z.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // ZyncList contains a list of Zync
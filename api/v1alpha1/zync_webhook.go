@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var zynclog = logf.Log.WithName("zync-resource")
+
+// SetupWebhookWithManager registers the webhooks of the Zync type in the manager
+func (r *Zync) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := registerDefaultingWebhook(mgr, r); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-zync,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=zyncs,verbs=create;update,versions=v1alpha1,name=mzync.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-zync,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=zyncs,verbs=create;update,versions=v1alpha1,name=vzync.saas.3scale.net,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Zync{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Zync) ValidateCreate() error {
+	zynclog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Zync) ValidateUpdate(old runtime.Object) error {
+	zynclog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Zync) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the spec of the Zync. Defaults are applied to a copy of the
+// resource first, so values set by the user are validated along the defaults
+// they will be combined with.
+func (r *Zync) validate() error {
+	instance := r.DeepCopy()
+	instance.Default()
+
+	spec := field.NewPath("spec")
+	allErrs := validateSpec(spec, instance.Spec)
+	allErrs = append(allErrs, validateSecretDSN(spec.Child("config", "databaseDSN"), instance.Spec.Config.DatabaseDSN, databaseSchemes)...)
+	allErrs = append(allErrs, validateLogLevel(spec.Child("config", "rails", "logLevel"), instance.Spec.Config.Rails.LogLevel, railsLogLevels)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("api", "vpa"), instance.Spec.API.VPA, instance.Spec.API.HPA, nil)...)
+	allErrs = append(allErrs, validateVPA(spec.Child("que", "vpa"), instance.Spec.Que.VPA, instance.Spec.Que.HPA, instance.Spec.Que.ScaledObject)...)
+	allErrs = append(allErrs, validateDisabledDashboards(spec.Child("grafanaDashboard"), instance.Spec.GrafanaDashboard, zyncDashboards)...)
+
+	return invalid("Zync", r.Name, allErrs)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Apicast to the Hub version (v1alpha1)
+func (src *Apicast) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*saasv1alpha1.Apicast)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = src.Spec.convertTo()
+
+	spec := ApicastSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return spec.convertTo() })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *Apicast) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*saasv1alpha1.Apicast)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = apicastSpecFrom(src.Spec)
+
+	spec := saasv1alpha1.ApicastSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return apicastSpecFrom(spec) })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+func (spec ApicastSpec) convertTo() saasv1alpha1.ApicastSpec {
+	return saasv1alpha1.ApicastSpec{
+		Staging:          spec.Staging.convertTo(),
+		Production:       spec.Production.convertTo(),
+		GrafanaDashboard: spec.GrafanaDashboard,
+		PrometheusRules:  spec.PrometheusRules,
+		Monitoring:       spec.Monitoring,
+	}
+}
+
+func apicastSpecFrom(in saasv1alpha1.ApicastSpec) ApicastSpec {
+	return ApicastSpec{
+		Staging:          apicastEnvironmentSpecFrom(in.Staging),
+		Production:       apicastEnvironmentSpecFrom(in.Production),
+		GrafanaDashboard: in.GrafanaDashboard,
+		PrometheusRules:  in.PrometheusRules,
+		Monitoring:       in.Monitoring,
+	}
+}
+
+func (spec ApicastEnvironmentSpec) convertTo() saasv1alpha1.ApicastEnvironmentSpec {
+	return saasv1alpha1.ApicastEnvironmentSpec{
+		Image:                     spec.Image,
+		PDB:                       spec.PDB,
+		HPA:                       spec.HPA,
+		Replicas:                  spec.Replicas,
+		Resources:                 spec.Resources,
+		VPA:                       spec.VPA,
+		LivenessProbe:             spec.LivenessProbe,
+		ReadinessProbe:            spec.ReadinessProbe,
+		Config:                    spec.Config,
+		Endpoint:                  spec.Endpoint.convertTo(),
+		Marin3r:                   spec.Marin3r,
+		LoadBalancer:              spec.LoadBalancer,
+		NodeAffinity:              spec.NodeAffinity,
+		Tolerations:               spec.Tolerations,
+		TopologySpreadConstraints: spec.TopologySpreadConstraints,
+		PodAntiAffinity:           spec.PodAntiAffinity,
+		PodAffinity:               spec.PodAffinity,
+		PriorityClassName:         spec.PriorityClassName,
+	}
+}
+
+func apicastEnvironmentSpecFrom(in saasv1alpha1.ApicastEnvironmentSpec) ApicastEnvironmentSpec {
+	return ApicastEnvironmentSpec{
+		Image: in.Image,
+		WorkloadSpec: WorkloadSpec{
+			Replicas:       in.Replicas,
+			HPA:            in.HPA,
+			PDB:            in.PDB,
+			Resources:      in.Resources,
+			VPA:            in.VPA,
+			LivenessProbe:  in.LivenessProbe,
+			ReadinessProbe: in.ReadinessProbe,
+			SchedulingSpec: SchedulingSpec{
+				NodeAffinity:              in.NodeAffinity,
+				Tolerations:               in.Tolerations,
+				TopologySpreadConstraints: in.TopologySpreadConstraints,
+				PodAntiAffinity:           in.PodAntiAffinity,
+				PodAffinity:               in.PodAffinity,
+				PriorityClassName:         in.PriorityClassName,
+			},
+		},
+		Config:       in.Config,
+		Endpoint:     endpointFrom(in.Endpoint),
+		Marin3r:      in.Marin3r,
+		LoadBalancer: in.LoadBalancer,
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApicastSpec defines the desired state of Apicast
+type ApicastSpec struct {
+	// Configures the staging Apicast environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Staging ApicastEnvironmentSpec `json:"staging"`
+	// Configures the production Apicast environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Production ApicastEnvironmentSpec `json:"production"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *saasv1alpha1.GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *saasv1alpha1.PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *saasv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+}
+
+// ApicastEnvironmentSpec is the configuration for an Apicast environment
+type ApicastEnvironmentSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	// Workload settings for the component
+	WorkloadSpec `json:",inline"`
+	// Application specific configuration options for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config saasv1alpha1.ApicastConfig `json:"config"`
+	// The external endpoint/s for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Endpoint Endpoint `json:"endpoint"`
+	// Marin3r configures the Marin3r sidecars for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Marin3r *saasv1alpha1.Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// Configures the AWS load balancer for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancer *saasv1alpha1.LoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// ApicastStatus defines the observed state of Apicast
+type ApicastStatus struct {
+	saasv1alpha1.ComponentStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Apicast is the Schema for the apicasts API
+type Apicast struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApicastSpec   `json:"spec,omitempty"`
+	Status ApicastStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApicastList contains a list of Apicast
+type ApicastList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Apicast `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Apicast{}, &ApicastList{})
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Backend to the Hub version (v1alpha1)
+func (src *Backend) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*saasv1alpha1.Backend)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = src.Spec.convertTo()
+
+	spec := BackendSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return spec.convertTo() })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *Backend) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*saasv1alpha1.Backend)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = backendSpecFrom(src.Spec)
+
+	spec := saasv1alpha1.BackendSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return backendSpecFrom(spec) })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+func (spec BackendSpec) convertTo() saasv1alpha1.BackendSpec {
+	out := saasv1alpha1.BackendSpec{
+		Image:            spec.Image,
+		Config:           spec.Config,
+		GrafanaDashboard: spec.GrafanaDashboard,
+		PrometheusRules:  spec.PrometheusRules,
+		Monitoring:       spec.Monitoring,
+		SecretsProvider:  spec.SecretsProvider,
+		Listener: saasv1alpha1.ListenerSpec{
+			Config:                    spec.Listener.Config,
+			PDB:                       spec.Listener.PDB,
+			HPA:                       spec.Listener.HPA,
+			Replicas:                  spec.Listener.Replicas,
+			Resources:                 spec.Listener.Resources,
+			VPA:                       spec.Listener.VPA,
+			LivenessProbe:             spec.Listener.LivenessProbe,
+			ReadinessProbe:            spec.Listener.ReadinessProbe,
+			Endpoint:                  spec.Listener.Endpoint.convertTo(),
+			Marin3r:                   spec.Listener.Marin3r,
+			LoadBalancer:              spec.Listener.LoadBalancer,
+			NodeAffinity:              spec.Listener.NodeAffinity,
+			Tolerations:               spec.Listener.Tolerations,
+			TopologySpreadConstraints: spec.Listener.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.Listener.PodAntiAffinity,
+			PodAffinity:               spec.Listener.PodAffinity,
+			PriorityClassName:         spec.Listener.PriorityClassName,
+		},
+	}
+	if spec.Worker != nil {
+		out.Worker = &saasv1alpha1.WorkerSpec{
+			Config:                    spec.Worker.Config,
+			PDB:                       spec.Worker.PDB,
+			HPA:                       spec.Worker.HPA,
+			ScaledObject:              spec.Worker.ScaledObject,
+			Replicas:                  spec.Worker.Replicas,
+			Resources:                 spec.Worker.Resources,
+			VPA:                       spec.Worker.VPA,
+			LivenessProbe:             spec.Worker.LivenessProbe,
+			ReadinessProbe:            spec.Worker.ReadinessProbe,
+			NodeAffinity:              spec.Worker.NodeAffinity,
+			Tolerations:               spec.Worker.Tolerations,
+			TopologySpreadConstraints: spec.Worker.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.Worker.PodAntiAffinity,
+			PodAffinity:               spec.Worker.PodAffinity,
+			PriorityClassName:         spec.Worker.PriorityClassName,
+		}
+	}
+	if spec.Cron != nil {
+		out.Cron = &saasv1alpha1.CronSpec{
+			Mode:                      spec.Cron.Mode,
+			Jobs:                      spec.Cron.Jobs,
+			Replicas:                  spec.Cron.Replicas,
+			Resources:                 spec.Cron.Resources,
+			VPA:                       spec.Cron.VPA,
+			NodeAffinity:              spec.Cron.NodeAffinity,
+			Tolerations:               spec.Cron.Tolerations,
+			TopologySpreadConstraints: spec.Cron.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.Cron.PodAntiAffinity,
+			PodAffinity:               spec.Cron.PodAffinity,
+			PriorityClassName:         spec.Cron.PriorityClassName,
+		}
+	}
+	return out
+}
+
+func backendSpecFrom(in saasv1alpha1.BackendSpec) BackendSpec {
+	out := BackendSpec{
+		Image:            in.Image,
+		Config:           in.Config,
+		GrafanaDashboard: in.GrafanaDashboard,
+		PrometheusRules:  in.PrometheusRules,
+		Monitoring:       in.Monitoring,
+		SecretsProvider:  in.SecretsProvider,
+		Listener: ListenerSpec{
+			Config: in.Listener.Config,
+			WorkloadSpec: WorkloadSpec{
+				Replicas:       in.Listener.Replicas,
+				HPA:            in.Listener.HPA,
+				PDB:            in.Listener.PDB,
+				Resources:      in.Listener.Resources,
+				VPA:            in.Listener.VPA,
+				LivenessProbe:  in.Listener.LivenessProbe,
+				ReadinessProbe: in.Listener.ReadinessProbe,
+				SchedulingSpec: SchedulingSpec{
+					NodeAffinity:              in.Listener.NodeAffinity,
+					Tolerations:               in.Listener.Tolerations,
+					TopologySpreadConstraints: in.Listener.TopologySpreadConstraints,
+					PodAntiAffinity:           in.Listener.PodAntiAffinity,
+					PodAffinity:               in.Listener.PodAffinity,
+					PriorityClassName:         in.Listener.PriorityClassName,
+				},
+			},
+			Endpoint:     endpointFrom(in.Listener.Endpoint),
+			Marin3r:      in.Listener.Marin3r,
+			LoadBalancer: in.Listener.LoadBalancer,
+		},
+	}
+	if in.Worker != nil {
+		out.Worker = &WorkerSpec{
+			Config:       in.Worker.Config,
+			ScaledObject: in.Worker.ScaledObject,
+			WorkloadSpec: WorkloadSpec{
+				Replicas:       in.Worker.Replicas,
+				HPA:            in.Worker.HPA,
+				PDB:            in.Worker.PDB,
+				Resources:      in.Worker.Resources,
+				VPA:            in.Worker.VPA,
+				LivenessProbe:  in.Worker.LivenessProbe,
+				ReadinessProbe: in.Worker.ReadinessProbe,
+				SchedulingSpec: SchedulingSpec{
+					NodeAffinity:              in.Worker.NodeAffinity,
+					Tolerations:               in.Worker.Tolerations,
+					TopologySpreadConstraints: in.Worker.TopologySpreadConstraints,
+					PodAntiAffinity:           in.Worker.PodAntiAffinity,
+					PodAffinity:               in.Worker.PodAffinity,
+					PriorityClassName:         in.Worker.PriorityClassName,
+				},
+			},
+		}
+	}
+	if in.Cron != nil {
+		out.Cron = &CronSpec{
+			Mode:      in.Cron.Mode,
+			Jobs:      in.Cron.Jobs,
+			Replicas:  in.Cron.Replicas,
+			Resources: in.Cron.Resources,
+			VPA:       in.Cron.VPA,
+			SchedulingSpec: SchedulingSpec{
+				NodeAffinity:              in.Cron.NodeAffinity,
+				Tolerations:               in.Cron.Tolerations,
+				TopologySpreadConstraints: in.Cron.TopologySpreadConstraints,
+				PodAntiAffinity:           in.Cron.PodAntiAffinity,
+				PodAffinity:               in.Cron.PodAffinity,
+				PriorityClassName:         in.Cron.PriorityClassName,
+			},
+		}
+	}
+	return out
+}
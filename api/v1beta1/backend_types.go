@@ -0,0 +1,159 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackendSpec defines the desired state of Backend
+type BackendSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	// Application specific configuration options for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config saasv1alpha1.BackendConfig `json:"config"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *saasv1alpha1.GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *saasv1alpha1.PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *saasv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+	// Configures the backend listener
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Listener ListenerSpec `json:"listener"`
+	// Configures the backend worker
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Worker *WorkerSpec `json:"worker,omitempty"`
+	// Configures the backend cron
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cron *CronSpec `json:"cron,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *saasv1alpha1.SecretsProviderSpec `json:"secretsProvider,omitempty"`
+}
+
+// ListenerSpec is the configuration for Backend Listener
+type ListenerSpec struct {
+	// Listener specific configuration options for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *saasv1alpha1.ListenerConfig `json:"config,omitempty"`
+	// Workload settings for the component element
+	WorkloadSpec `json:",inline"`
+	// The external endpoint/s for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Endpoint Endpoint `json:"endpoint"`
+	// Marin3r configures the Marin3r sidecars for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Marin3r *saasv1alpha1.Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// Configures the AWS Network load balancer for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancer *saasv1alpha1.NLBLoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// WorkerSpec is the configuration for Backend Worker
+type WorkerSpec struct {
+	// Worker specific configuration options for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *saasv1alpha1.WorkerConfig `json:"config,omitempty"`
+	// KEDA ScaledObject that autoscales the workload on the length of
+	// its queues. The hpa is ignored when the ScaledObject is configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScaledObject *saasv1alpha1.ScaledObjectSpec `json:"scaledObject,omitempty"`
+	// Workload settings for the component element
+	WorkloadSpec `json:",inline"`
+}
+
+// CronSpec is the configuration for Backend Cron
+type CronSpec struct {
+	// The kind of workload that runs the periodic backend tasks: "deployment" or "cronjob".
+	// The "cronjob" mode requires Kubernetes 1.21 or newer, as it creates batch/v1 CronJobs.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Mode *saasv1alpha1.BackendCronMode `json:"mode,omitempty"`
+	// The periodic backend tasks run as CronJobs in "cronjob" mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Jobs []saasv1alpha1.BackendCronJobSpec `json:"jobs,omitempty"`
+	// Number of replicas for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *saasv1alpha1.ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *saasv1alpha1.VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Pod scheduling constraints for the component element
+	SchedulingSpec `json:",inline"`
+}
+
+// BackendStatus defines the observed state of Backend
+type BackendStatus struct {
+	saasv1alpha1.ComponentStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Backend is the Schema for the backends API
+type Backend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackendSpec   `json:"spec,omitempty"`
+	Status BackendStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendList contains a list of Backend
+type BackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backend `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backend{}, &BackendList{})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SchedulingSpec defines the pod scheduling constraints of a workload
+type SchedulingSpec struct {
+	// Describes node affinity scheduling rules for the pod.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+	// If specified, the pod's tolerations.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Describes how the Pods of the workload are spread across topology domains,
+	// like zones. Constraints without a labelSelector select the Pods of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Configures the anti-affinity between the Pods of the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PodAntiAffinity *saasv1alpha1.PodAntiAffinitySpec `json:"podAntiAffinity,omitempty"`
+	// Describes pod affinity scheduling rules for the pod.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// If specified, indicates the pod's priority. The PriorityClass must exist.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
+
+// WorkloadSpec defines the settings shared by all the workloads
+// (Deployments/StatefulSets) of the components
+type WorkloadSpec struct {
+	// Number of replicas (ignored if hpa is enabled) for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Horizontal Pod Autoscaler for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *saasv1alpha1.HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Pod Disruption Budget for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *saasv1alpha1.PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Resource requirements for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *saasv1alpha1.ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *saasv1alpha1.VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *saasv1alpha1.ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *saasv1alpha1.ProbeSpec `json:"readinessProbe,omitempty"`
+	// Pod scheduling constraints for the workload
+	SchedulingSpec `json:",inline"`
+}
+
+// QueueWorkerSpec defines the settings of the workloads
+// that consume jobs from queues
+type QueueWorkerSpec struct {
+	// KEDA ScaledObject that autoscales the workload on the length of
+	// its queues. The hpa is ignored when the ScaledObject is configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ScaledObject *saasv1alpha1.ScaledObjectSpec `json:"scaledObject,omitempty"`
+	// Workload settings for the component element
+	WorkloadSpec `json:",inline"`
+}
+
+// Endpoint sets the external endpoint for the component
+type Endpoint struct {
+	// The list of hosts whose dns records will point to the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +listType=map
+	// +listMapKey=name
+	Hosts []EndpointHost `json:"hosts"`
+}
+
+// EndpointHost is a host whose dns record points to the component
+type EndpointHost struct {
+	// The fully qualified domain name of the host. Wildcards
+	// are allowed in the first label (eg. "*.example.com").
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// All the types of this version implement conversion.Convertible, converting
+// from and to the hub version (v1alpha1). Conversions are lossless in both
+// directions, so objects can be read and written in either version.
+
+// convertTo converts the Endpoint to its v1alpha1 equivalent
+func (e Endpoint) convertTo() saasv1alpha1.Endpoint {
+	out := saasv1alpha1.Endpoint{}
+	if e.Hosts != nil {
+		out.DNS = make([]string, 0, len(e.Hosts))
+		for _, host := range e.Hosts {
+			out.DNS = append(out.DNS, host.Name)
+		}
+	}
+	return out
+}
+
+// endpointFrom converts a v1alpha1 Endpoint to an Endpoint
+func endpointFrom(in saasv1alpha1.Endpoint) Endpoint {
+	out := Endpoint{}
+	if in.DNS != nil {
+		out.Hosts = make([]EndpointHost, 0, len(in.DNS))
+		for _, dns := range in.DNS {
+			out.Hosts = append(out.Hosts, EndpointHost{Name: dns})
+		}
+	}
+	return out
+}
+
+// convertStatus copies the ComponentStatus, converting the effective spec it
+// holds with the given function. The effective spec is decoded into 'in',
+// which must be a pointer to the spec type of the source version, and
+// 'convert' returns it converted to the spec type of the destination version.
+// Effective specs that cannot be strictly decoded are copied unmodified.
+func convertStatus(src saasv1alpha1.ComponentStatus, in interface{}, convert func() interface{}) (saasv1alpha1.ComponentStatus, error) {
+	dst := src
+	if src.EffectiveSpec == nil || src.EffectiveSpec.Raw == nil {
+		return dst, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(src.EffectiveSpec.Raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(in); err != nil {
+		return dst, nil
+	}
+
+	raw, err := json.Marshal(convert())
+	if err != nil {
+		return dst, err
+	}
+	dst.EffectiveSpec = &runtime.RawExtension{Raw: raw}
+	return dst, nil
+}
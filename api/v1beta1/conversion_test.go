@@ -0,0 +1,145 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+const (
+	// fuzzIterations is the number of random objects used to
+	// test the round trip conversions of each kind
+	fuzzIterations = 200
+	// fuzzSeed is the seed of the fuzzer, fixed so failures are reproducible
+	fuzzSeed int64 = 1
+)
+
+func TestConversion_RoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := saasv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(fuzzSeed), serializer.NewCodecFactory(scheme))
+
+	tests := []struct {
+		name  string
+		hub   func() conversion.Hub
+		spoke func() conversion.Convertible
+	}{
+		{"Apicast", func() conversion.Hub { return &saasv1alpha1.Apicast{} }, func() conversion.Convertible { return &Apicast{} }},
+		{"AutoSSL", func() conversion.Hub { return &saasv1alpha1.AutoSSL{} }, func() conversion.Convertible { return &AutoSSL{} }},
+		{"Backend", func() conversion.Hub { return &saasv1alpha1.Backend{} }, func() conversion.Convertible { return &Backend{} }},
+		{"CORSProxy", func() conversion.Hub { return &saasv1alpha1.CORSProxy{} }, func() conversion.Convertible { return &CORSProxy{} }},
+		{"EchoAPI", func() conversion.Hub { return &saasv1alpha1.EchoAPI{} }, func() conversion.Convertible { return &EchoAPI{} }},
+		{"MappingService", func() conversion.Hub { return &saasv1alpha1.MappingService{} }, func() conversion.Convertible { return &MappingService{} }},
+		{"System", func() conversion.Hub { return &saasv1alpha1.System{} }, func() conversion.Convertible { return &System{} }},
+		{"Zync", func() conversion.Hub { return &saasv1alpha1.Zync{} }, func() conversion.Convertible { return &Zync{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"/hub-spoke-hub", func(t *testing.T) {
+			for i := 0; i < fuzzIterations; i++ {
+				want := tt.hub()
+				f.Fuzz(want)
+				spoke := tt.spoke()
+				if err := spoke.ConvertFrom(want); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				got := tt.hub()
+				if err := spoke.ConvertTo(got); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(got, want) {
+					t.Fatalf("round trip conversion is lossy:\n%s", diff.ObjectReflectDiff(want, got))
+				}
+			}
+		})
+		t.Run(tt.name+"/spoke-hub-spoke", func(t *testing.T) {
+			for i := 0; i < fuzzIterations; i++ {
+				want := tt.spoke()
+				f.Fuzz(want)
+				hub := tt.hub()
+				if err := want.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				got := tt.spoke()
+				if err := got.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(got, want) {
+					t.Fatalf("round trip conversion is lossy:\n%s", diff.ObjectReflectDiff(want, got))
+				}
+			}
+		})
+	}
+}
+
+func TestConversion_EffectiveSpec(t *testing.T) {
+	hub := &saasv1alpha1.Backend{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+		Spec: saasv1alpha1.BackendSpec{
+			Listener: saasv1alpha1.ListenerSpec{
+				Endpoint: saasv1alpha1.Endpoint{DNS: []string{"backend.example.com"}},
+			},
+		},
+	}
+	hub.Default()
+	raw, err := saasv1alpha1.EffectiveSpec(hub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hub.Status.EffectiveSpec = raw
+
+	spoke := &Backend{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	spec := BackendSpec{}
+	if err := json.Unmarshal(spoke.Status.EffectiveSpec.Raw, &spec); err != nil {
+		t.Fatalf("effective spec is not a v1beta1 spec: %v", err)
+	}
+	if !reflect.DeepEqual(spec.Listener.Endpoint, Endpoint{Hosts: []EndpointHost{{Name: "backend.example.com"}}}) {
+		t.Errorf("effective spec endpoint = %v", spec.Listener.Endpoint)
+	}
+
+	got := &saasv1alpha1.Backend{}
+	if err := spoke.ConvertTo(got); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	var a, b interface{}
+	if err := json.Unmarshal(raw.Raw, &a); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(got.Status.EffectiveSpec.Raw, &b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("round trip conversion of the effective spec is lossy:\n%s", diff.ObjectReflectDiff(a, b))
+	}
+}
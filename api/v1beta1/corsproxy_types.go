@@ -0,0 +1,83 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CORSProxySpec defines the desired state of CORSProxy
+type CORSProxySpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	// Workload settings for the component
+	WorkloadSpec `json:",inline"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *saasv1alpha1.GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *saasv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+	// Application specific configuration options for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config saasv1alpha1.CORSProxyConfig `json:"config"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *saasv1alpha1.SecretsProviderSpec `json:"secretsProvider,omitempty"`
+}
+
+// CORSProxyStatus defines the observed state of CORSProxy
+type CORSProxyStatus struct {
+	saasv1alpha1.ComponentStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CORSProxy is the Schema for the corsproxies API
+type CORSProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CORSProxySpec   `json:"spec,omitempty"`
+	Status CORSProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CORSProxyList contains a list of CORSProxy
+type CORSProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CORSProxy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CORSProxy{}, &CORSProxyList{})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EchoAPISpec defines the desired state of EchoAPI
+type EchoAPISpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	// Workload settings for the component
+	WorkloadSpec `json:",inline"`
+	// Marin3r configures the Marin3r sidecars for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Marin3r *saasv1alpha1.Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *saasv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+	// Configures the AWS Network load balancer for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancer *saasv1alpha1.NLBLoadBalancerSpec `json:"loadBalancer,omitempty"`
+	// The external endpoint/s for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Endpoint Endpoint `json:"endpoint"`
+}
+
+// EchoAPIStatus defines the observed state of EchoAPI
+type EchoAPIStatus struct {
+	saasv1alpha1.ComponentStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// EchoAPI is the Schema for the echoapis API
+type EchoAPI struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EchoAPISpec   `json:"spec,omitempty"`
+	Status EchoAPIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EchoAPIList contains a list of EchoAPI
+type EchoAPIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EchoAPI `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EchoAPI{}, &EchoAPIList{})
+}
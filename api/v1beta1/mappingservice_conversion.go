@@ -0,0 +1,96 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this MappingService to the Hub version (v1alpha1)
+func (src *MappingService) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*saasv1alpha1.MappingService)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = src.Spec.convertTo()
+
+	spec := MappingServiceSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return spec.convertTo() })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *MappingService) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*saasv1alpha1.MappingService)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = mappingserviceSpecFrom(src.Spec)
+
+	spec := saasv1alpha1.MappingServiceSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return mappingserviceSpecFrom(spec) })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+func (spec MappingServiceSpec) convertTo() saasv1alpha1.MappingServiceSpec {
+	return saasv1alpha1.MappingServiceSpec{
+		Image:                     spec.Image,
+		PDB:                       spec.PDB,
+		HPA:                       spec.HPA,
+		Replicas:                  spec.Replicas,
+		Resources:                 spec.Resources,
+		VPA:                       spec.VPA,
+		LivenessProbe:             spec.LivenessProbe,
+		ReadinessProbe:            spec.ReadinessProbe,
+		GrafanaDashboard:          spec.GrafanaDashboard,
+		Monitoring:                spec.Monitoring,
+		SecretsProvider:           spec.SecretsProvider,
+		Config:                    spec.Config,
+		NodeAffinity:              spec.NodeAffinity,
+		Tolerations:               spec.Tolerations,
+		TopologySpreadConstraints: spec.TopologySpreadConstraints,
+		PodAntiAffinity:           spec.PodAntiAffinity,
+		PodAffinity:               spec.PodAffinity,
+		PriorityClassName:         spec.PriorityClassName,
+	}
+}
+
+func mappingserviceSpecFrom(in saasv1alpha1.MappingServiceSpec) MappingServiceSpec {
+	return MappingServiceSpec{
+		Image: in.Image,
+		WorkloadSpec: WorkloadSpec{
+			Replicas:       in.Replicas,
+			HPA:            in.HPA,
+			PDB:            in.PDB,
+			Resources:      in.Resources,
+			VPA:            in.VPA,
+			LivenessProbe:  in.LivenessProbe,
+			ReadinessProbe: in.ReadinessProbe,
+			SchedulingSpec: SchedulingSpec{
+				NodeAffinity:              in.NodeAffinity,
+				Tolerations:               in.Tolerations,
+				TopologySpreadConstraints: in.TopologySpreadConstraints,
+				PodAntiAffinity:           in.PodAntiAffinity,
+				PodAffinity:               in.PodAffinity,
+				PriorityClassName:         in.PriorityClassName,
+			},
+		},
+		GrafanaDashboard: in.GrafanaDashboard,
+		Monitoring:       in.Monitoring,
+		SecretsProvider:  in.SecretsProvider,
+		Config:           in.Config,
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this System to the Hub version (v1alpha1)
+func (src *System) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*saasv1alpha1.System)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = src.Spec.convertTo()
+
+	spec := SystemSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return spec.convertTo() })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *System) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*saasv1alpha1.System)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = systemSpecFrom(src.Spec)
+
+	spec := saasv1alpha1.SystemSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return systemSpecFrom(spec) })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+func (spec SystemSpec) convertTo() saasv1alpha1.SystemSpec {
+	out := saasv1alpha1.SystemSpec{
+		Config:           spec.Config.convertTo(),
+		Image:            spec.Image,
+		GrafanaDashboard: spec.GrafanaDashboard,
+		PrometheusRules:  spec.PrometheusRules,
+		Monitoring:       spec.Monitoring,
+		SecretsProvider:  spec.SecretsProvider,
+		Migration:        spec.Migration,
+	}
+	if spec.App != nil {
+		out.App = &saasv1alpha1.SystemAppSpec{
+			PDB:                       spec.App.PDB,
+			HPA:                       spec.App.HPA,
+			Replicas:                  spec.App.Replicas,
+			Resources:                 spec.App.Resources,
+			VPA:                       spec.App.VPA,
+			LivenessProbe:             spec.App.LivenessProbe,
+			ReadinessProbe:            spec.App.ReadinessProbe,
+			Marin3r:                   spec.App.Marin3r,
+			NodeAffinity:              spec.App.NodeAffinity,
+			Tolerations:               spec.App.Tolerations,
+			TopologySpreadConstraints: spec.App.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.App.PodAntiAffinity,
+			PodAffinity:               spec.App.PodAffinity,
+			PriorityClassName:         spec.App.PriorityClassName,
+		}
+	}
+	if spec.Sidekiq != nil {
+		out.Sidekiq = &saasv1alpha1.SystemSidekiqSpec{
+			PDB:                       spec.Sidekiq.PDB,
+			HPA:                       spec.Sidekiq.HPA,
+			ScaledObject:              spec.Sidekiq.ScaledObject,
+			Replicas:                  spec.Sidekiq.Replicas,
+			Resources:                 spec.Sidekiq.Resources,
+			VPA:                       spec.Sidekiq.VPA,
+			LivenessProbe:             spec.Sidekiq.LivenessProbe,
+			ReadinessProbe:            spec.Sidekiq.ReadinessProbe,
+			NodeAffinity:              spec.Sidekiq.NodeAffinity,
+			Tolerations:               spec.Sidekiq.Tolerations,
+			TopologySpreadConstraints: spec.Sidekiq.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.Sidekiq.PodAntiAffinity,
+			PodAffinity:               spec.Sidekiq.PodAffinity,
+			PriorityClassName:         spec.Sidekiq.PriorityClassName,
+		}
+	}
+	if spec.Sphinx != nil {
+		out.Sphinx = &saasv1alpha1.SystemSphinxSpec{
+			Image:                     spec.Sphinx.Image,
+			Config:                    spec.Sphinx.Config,
+			Resources:                 spec.Sphinx.Resources,
+			VPA:                       spec.Sphinx.VPA,
+			LivenessProbe:             spec.Sphinx.LivenessProbe,
+			ReadinessProbe:            spec.Sphinx.ReadinessProbe,
+			NodeAffinity:              spec.Sphinx.NodeAffinity,
+			Tolerations:               spec.Sphinx.Tolerations,
+			TopologySpreadConstraints: spec.Sphinx.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.Sphinx.PodAntiAffinity,
+			PodAffinity:               spec.Sphinx.PodAffinity,
+			PriorityClassName:         spec.Sphinx.PriorityClassName,
+		}
+	}
+	return out
+}
+
+func systemSpecFrom(in saasv1alpha1.SystemSpec) SystemSpec {
+	out := SystemSpec{
+		Config:           systemConfigFrom(in.Config),
+		Image:            in.Image,
+		GrafanaDashboard: in.GrafanaDashboard,
+		PrometheusRules:  in.PrometheusRules,
+		Monitoring:       in.Monitoring,
+		SecretsProvider:  in.SecretsProvider,
+		Migration:        in.Migration,
+	}
+	if in.App != nil {
+		out.App = &SystemAppSpec{
+			WorkloadSpec: WorkloadSpec{
+				Replicas:       in.App.Replicas,
+				HPA:            in.App.HPA,
+				PDB:            in.App.PDB,
+				Resources:      in.App.Resources,
+				VPA:            in.App.VPA,
+				LivenessProbe:  in.App.LivenessProbe,
+				ReadinessProbe: in.App.ReadinessProbe,
+				SchedulingSpec: SchedulingSpec{
+					NodeAffinity:              in.App.NodeAffinity,
+					Tolerations:               in.App.Tolerations,
+					TopologySpreadConstraints: in.App.TopologySpreadConstraints,
+					PodAntiAffinity:           in.App.PodAntiAffinity,
+					PodAffinity:               in.App.PodAffinity,
+					PriorityClassName:         in.App.PriorityClassName,
+				},
+			},
+			Marin3r: in.App.Marin3r,
+		}
+	}
+	if in.Sidekiq != nil {
+		out.Sidekiq = &QueueWorkerSpec{
+			ScaledObject: in.Sidekiq.ScaledObject,
+			WorkloadSpec: WorkloadSpec{
+				Replicas:       in.Sidekiq.Replicas,
+				HPA:            in.Sidekiq.HPA,
+				PDB:            in.Sidekiq.PDB,
+				Resources:      in.Sidekiq.Resources,
+				VPA:            in.Sidekiq.VPA,
+				LivenessProbe:  in.Sidekiq.LivenessProbe,
+				ReadinessProbe: in.Sidekiq.ReadinessProbe,
+				SchedulingSpec: SchedulingSpec{
+					NodeAffinity:              in.Sidekiq.NodeAffinity,
+					Tolerations:               in.Sidekiq.Tolerations,
+					TopologySpreadConstraints: in.Sidekiq.TopologySpreadConstraints,
+					PodAntiAffinity:           in.Sidekiq.PodAntiAffinity,
+					PodAffinity:               in.Sidekiq.PodAffinity,
+					PriorityClassName:         in.Sidekiq.PriorityClassName,
+				},
+			},
+		}
+	}
+	if in.Sphinx != nil {
+		out.Sphinx = &SystemSphinxSpec{
+			Image:          in.Sphinx.Image,
+			Config:         in.Sphinx.Config,
+			Resources:      in.Sphinx.Resources,
+			VPA:            in.Sphinx.VPA,
+			LivenessProbe:  in.Sphinx.LivenessProbe,
+			ReadinessProbe: in.Sphinx.ReadinessProbe,
+			SchedulingSpec: SchedulingSpec{
+				NodeAffinity:              in.Sphinx.NodeAffinity,
+				Tolerations:               in.Sphinx.Tolerations,
+				TopologySpreadConstraints: in.Sphinx.TopologySpreadConstraints,
+				PodAntiAffinity:           in.Sphinx.PodAntiAffinity,
+				PodAffinity:               in.Sphinx.PodAffinity,
+				PriorityClassName:         in.Sphinx.PriorityClassName,
+			},
+		}
+	}
+	return out
+}
+
+func (cfg SystemConfig) convertTo() saasv1alpha1.SystemConfig {
+	return saasv1alpha1.SystemConfig{
+		AMPRelease:                    cfg.AMPRelease,
+		Rails:                         cfg.Rails,
+		SandboxProxyOpensslVerifyMode: cfg.SSL.SandboxProxyOpensslVerifyMode,
+		ForceSSL:                      cfg.SSL.Force,
+		SSLCertsDir:                   cfg.SSL.CertsDir,
+		ThreescaleProviderPlan:        cfg.Threescale.ProviderPlan,
+		ThreescaleSuperdomain:         cfg.Threescale.Superdomain,
+		ConfigFiles:                   cfg.ConfigFiles,
+		Seed:                          cfg.Seed,
+		DatabaseDSN:                   cfg.Database.DSN,
+		EventsSharedSecret:            cfg.Secrets.EventsSharedSecret,
+		Recaptcha:                     cfg.Integrations.Recaptcha,
+		SecretKeyBase:                 cfg.Secrets.SecretKeyBase,
+		AccessCode:                    cfg.Secrets.AccessCode,
+		Segment:                       cfg.Integrations.Segment,
+		Github:                        cfg.Integrations.Github,
+		Metrics:                       cfg.Metrics,
+		RedHatCustomerPortal:          cfg.Integrations.RedHatCustomerPortal,
+		Bugsnag:                       cfg.Integrations.Bugsnag,
+		DatabaseSecret:                cfg.Database.Secret,
+		MemcachedServers:              cfg.Memcached.Servers,
+		Redis:                         cfg.Redis,
+		SMTP:                          cfg.SMTP,
+		MappingServiceAccessToken:     cfg.AccessTokens.MappingService,
+		ZyncAuthToken:                 cfg.AccessTokens.Zync,
+		Backend:                       cfg.Backend,
+		Assets:                        cfg.Assets,
+	}
+}
+
+func systemConfigFrom(in saasv1alpha1.SystemConfig) SystemConfig {
+	return SystemConfig{
+		AMPRelease: in.AMPRelease,
+		Rails:      in.Rails,
+		SSL: SystemSSLSpec{
+			Force:                         in.ForceSSL,
+			CertsDir:                      in.SSLCertsDir,
+			SandboxProxyOpensslVerifyMode: in.SandboxProxyOpensslVerifyMode,
+		},
+		Threescale: SystemThreescaleSpec{
+			ProviderPlan: in.ThreescaleProviderPlan,
+			Superdomain:  in.ThreescaleSuperdomain,
+		},
+		ConfigFiles: in.ConfigFiles,
+		Seed:        in.Seed,
+		Database: SystemDatabaseSpec{
+			DSN:    in.DatabaseDSN,
+			Secret: in.DatabaseSecret,
+		},
+		Secrets: SystemSecretsSpec{
+			SecretKeyBase:      in.SecretKeyBase,
+			AccessCode:         in.AccessCode,
+			EventsSharedSecret: in.EventsSharedSecret,
+		},
+		Integrations: SystemIntegrationsSpec{
+			Recaptcha:            in.Recaptcha,
+			Segment:              in.Segment,
+			Github:               in.Github,
+			RedHatCustomerPortal: in.RedHatCustomerPortal,
+			Bugsnag:              in.Bugsnag,
+		},
+		Metrics:   in.Metrics,
+		Memcached: SystemMemcachedSpec{Servers: in.MemcachedServers},
+		Redis:     in.Redis,
+		SMTP:      in.SMTP,
+		AccessTokens: SystemAccessTokensSpec{
+			MappingService: in.MappingServiceAccessToken,
+			Zync:           in.ZyncAuthToken,
+		},
+		Backend: in.Backend,
+		Assets:  in.Assets,
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SystemSpec defines the desired state of System
+type SystemSpec struct {
+	// Application specific configuration options for System components
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config SystemConfig `json:"config"`
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	// Application specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	App *SystemAppSpec `json:"app,omitempty"`
+	// Sidekiq specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Sidekiq *QueueWorkerSpec `json:"sidekiq,omitempty"`
+	// Sphinx specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Sphinx *SystemSphinxSpec `json:"sphinx,omitempty"`
+	// Runs the database migrations in a Job before rolling out a new
+	// version of the workloads. Disabled when not set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Migration *saasv1alpha1.SystemMigrationSpec `json:"migration,omitempty"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *saasv1alpha1.GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the Prometheus alerting rules for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PrometheusRules *saasv1alpha1.PrometheusRulesSpec `json:"prometheusRules,omitempty"`
+	// Configures how Prometheus scrapes the metrics of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Monitoring *saasv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+	// Configures the provider used to populate the Secrets of the component
+	// from the SecretReferences of the spec. Fields not set default to the
+	// ones configured in the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretsProvider *saasv1alpha1.SecretsProviderSpec `json:"secretsProvider,omitempty"`
+}
+
+// SystemConfig holds configuration for System components
+type SystemConfig struct {
+	// AMP release number
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AMPRelease *string `json:"ampRelease,omitempty"`
+	// Rails configuration options for system components
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Rails *saasv1alpha1.SystemRailsSpec `json:"rails,omitempty"`
+	// SSL configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SSL SystemSSLSpec `json:"ssl,omitempty"`
+	// 3scale tenancy configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Threescale SystemThreescaleSpec `json:"threescale,omitempty"`
+	// Extra configuration files to be mounted in the pods
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConfigFiles *saasv1alpha1.ConfigFilesSpec `json:"configFiles,omitempty"`
+	// System seed
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Seed saasv1alpha1.SystemSeedSpec `json:"seed"`
+	// Main database configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Database SystemDatabaseSpec `json:"database"`
+	// Secrets used internally by system
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Secrets SystemSecretsSpec `json:"secrets"`
+	// Options for the integrations with third party services
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Integrations SystemIntegrationsSpec `json:"integrations"`
+	// Options for configuring metrics publication
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Metrics saasv1alpha1.MetricsSpec `json:"metrics"`
+	// Memcached configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Memcached SystemMemcachedSpec `json:"memcached"`
+	// Redis configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Redis saasv1alpha1.RedisSpec `json:"redis"`
+	// SMTP configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SMTP saasv1alpha1.SMTPSpec `json:"smtp"`
+	// Tokens used to authenticate against other 3scale components
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AccessTokens SystemAccessTokensSpec `json:"accessTokens"`
+	// Backend has configuration options for system to contact backend
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Backend saasv1alpha1.SystemBackendSpec `json:"backend"`
+	// Assets has configuration to access assets in AWS s3
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Assets saasv1alpha1.AssetsSpec `json:"assets"`
+}
+
+// SystemSSLSpec holds the SSL configuration options of System
+type SystemSSLSpec struct {
+	// Enable (true) or disable (false) enforcing SSL
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Force *bool `json:"force,omitempty"`
+	// SSL certificates path
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CertsDir *string `json:"certsDir,omitempty"`
+	// OpenSSL verification mode for sandbox proxy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SandboxProxyOpensslVerifyMode *string `json:"sandboxProxyOpensslVerifyMode,omitempty"`
+}
+
+// SystemThreescaleSpec holds the 3scale tenancy configuration options of System
+type SystemThreescaleSpec struct {
+	// 3scale provider plan
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ProviderPlan *string `json:"providerPlan,omitempty"`
+	// 3scale superdomain
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Superdomain *string `json:"superdomain,omitempty"`
+}
+
+// SystemDatabaseSpec holds the configuration of System's main database
+type SystemDatabaseSpec struct {
+	// DSN of system's main database
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DSN saasv1alpha1.SecretReference `json:"dsn"`
+	// Database secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Secret saasv1alpha1.SecretReference `json:"secret"`
+}
+
+// SystemSecretsSpec holds the secrets used internally by System
+type SystemSecretsSpec struct {
+	// SecretKeyBase
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SecretKeyBase saasv1alpha1.SecretReference `json:"secretKeyBase"`
+	// AccessCode to protect admin urls
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AccessCode saasv1alpha1.SecretReference `json:"accessCode"`
+	// EventsSharedSecret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	EventsSharedSecret saasv1alpha1.SecretReference `json:"eventsSharedSecret"`
+}
+
+// SystemIntegrationsSpec holds the configuration options for
+// the integrations of System with third party services
+type SystemIntegrationsSpec struct {
+	// Holds recaptcha configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Recaptcha saasv1alpha1.SystemRecaptchaSpec `json:"recaptcha"`
+	// Options for Segment integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Segment saasv1alpha1.SegmentSpec `json:"segment"`
+	// Options for Github integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Github saasv1alpha1.GithubSpec `json:"github"`
+	// Options for configuring RH Customer Portal integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RedHatCustomerPortal saasv1alpha1.RedHatCustomerPortalSpec `json:"redhatCustomerPortal"`
+	// Options for configuring Bugsnag integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Bugsnag *saasv1alpha1.BugsnagSpec `json:"bugsnag,omitempty"`
+}
+
+// SystemMemcachedSpec holds the memcached configuration options of System
+type SystemMemcachedSpec struct {
+	// Memcached servers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Servers string `json:"servers"`
+}
+
+// SystemAccessTokensSpec holds the tokens System uses to
+// authenticate against other 3scale components
+type SystemAccessTokensSpec struct {
+	// Mapping Service access token
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	MappingService saasv1alpha1.SecretReference `json:"mappingService"`
+	// Zync authentication token
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Zync saasv1alpha1.SecretReference `json:"zync"`
+}
+
+// SystemAppSpec configures the App component of System
+type SystemAppSpec struct {
+	// Workload settings for the component
+	WorkloadSpec `json:",inline"`
+	// Marin3r configures the Marin3r sidecars for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Marin3r *saasv1alpha1.Marin3rSidecarSpec `json:"marin3r,omitempty"`
+}
+
+// SystemSphinxSpec configures the Sphinx component of System
+type SystemSphinxSpec struct {
+	// Image specification for the Sphinx component.
+	// Defaults to system image if not defined.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *saasv1alpha1.ImageSpec `json:"image,omitempty"`
+	// Configuration options for System's sphinx
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *saasv1alpha1.SphinxConfig `json:"config,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *saasv1alpha1.ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *saasv1alpha1.VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *saasv1alpha1.ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *saasv1alpha1.ProbeSpec `json:"readinessProbe,omitempty"`
+	// Pod scheduling constraints for the component
+	SchedulingSpec `json:",inline"`
+}
+
+// SystemStatus defines the observed state of System
+type SystemStatus struct {
+	saasv1alpha1.ComponentStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// System is the Schema for the systems API
+type System struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SystemSpec   `json:"spec,omitempty"`
+	Status SystemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SystemList contains a list of System
+type SystemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []System `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&System{}, &SystemList{})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Zync to the Hub version (v1alpha1)
+func (src *Zync) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*saasv1alpha1.Zync)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = src.Spec.convertTo()
+
+	spec := ZyncSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return spec.convertTo() })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *Zync) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*saasv1alpha1.Zync)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = zyncSpecFrom(src.Spec)
+
+	spec := saasv1alpha1.ZyncSpec{}
+	status, err := convertStatus(src.Status.ComponentStatus, &spec, func() interface{} { return zyncSpecFrom(spec) })
+	dst.Status.ComponentStatus = status
+	return err
+}
+
+func (spec ZyncSpec) convertTo() saasv1alpha1.ZyncSpec {
+	out := saasv1alpha1.ZyncSpec{
+		Image:            spec.Image,
+		Config:           spec.Config,
+		GrafanaDashboard: spec.GrafanaDashboard,
+		PrometheusRules:  spec.PrometheusRules,
+		Monitoring:       spec.Monitoring,
+		SecretsProvider:  spec.SecretsProvider,
+		Migration:        spec.Migration,
+	}
+	if spec.API != nil {
+		out.API = &saasv1alpha1.APISpec{
+			PDB:                       spec.API.PDB,
+			HPA:                       spec.API.HPA,
+			Replicas:                  spec.API.Replicas,
+			Resources:                 spec.API.Resources,
+			VPA:                       spec.API.VPA,
+			LivenessProbe:             spec.API.LivenessProbe,
+			ReadinessProbe:            spec.API.ReadinessProbe,
+			NodeAffinity:              spec.API.NodeAffinity,
+			Tolerations:               spec.API.Tolerations,
+			TopologySpreadConstraints: spec.API.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.API.PodAntiAffinity,
+			PodAffinity:               spec.API.PodAffinity,
+			PriorityClassName:         spec.API.PriorityClassName,
+		}
+	}
+	if spec.Que != nil {
+		out.Que = &saasv1alpha1.QueSpec{
+			PDB:                       spec.Que.PDB,
+			HPA:                       spec.Que.HPA,
+			ScaledObject:              spec.Que.ScaledObject,
+			Replicas:                  spec.Que.Replicas,
+			Resources:                 spec.Que.Resources,
+			VPA:                       spec.Que.VPA,
+			LivenessProbe:             spec.Que.LivenessProbe,
+			ReadinessProbe:            spec.Que.ReadinessProbe,
+			NodeAffinity:              spec.Que.NodeAffinity,
+			Tolerations:               spec.Que.Tolerations,
+			TopologySpreadConstraints: spec.Que.TopologySpreadConstraints,
+			PodAntiAffinity:           spec.Que.PodAntiAffinity,
+			PodAffinity:               spec.Que.PodAffinity,
+			PriorityClassName:         spec.Que.PriorityClassName,
+		}
+	}
+	return out
+}
+
+func zyncSpecFrom(in saasv1alpha1.ZyncSpec) ZyncSpec {
+	out := ZyncSpec{
+		Image:            in.Image,
+		Config:           in.Config,
+		GrafanaDashboard: in.GrafanaDashboard,
+		PrometheusRules:  in.PrometheusRules,
+		Monitoring:       in.Monitoring,
+		SecretsProvider:  in.SecretsProvider,
+		Migration:        in.Migration,
+	}
+	if in.API != nil {
+		out.API = &WorkloadSpec{
+			Replicas:       in.API.Replicas,
+			HPA:            in.API.HPA,
+			PDB:            in.API.PDB,
+			Resources:      in.API.Resources,
+			VPA:            in.API.VPA,
+			LivenessProbe:  in.API.LivenessProbe,
+			ReadinessProbe: in.API.ReadinessProbe,
+			SchedulingSpec: SchedulingSpec{
+				NodeAffinity:              in.API.NodeAffinity,
+				Tolerations:               in.API.Tolerations,
+				TopologySpreadConstraints: in.API.TopologySpreadConstraints,
+				PodAntiAffinity:           in.API.PodAntiAffinity,
+				PodAffinity:               in.API.PodAffinity,
+				PriorityClassName:         in.API.PriorityClassName,
+			},
+		}
+	}
+	if in.Que != nil {
+		out.Que = &QueueWorkerSpec{
+			ScaledObject: in.Que.ScaledObject,
+			WorkloadSpec: WorkloadSpec{
+				Replicas:       in.Que.Replicas,
+				HPA:            in.Que.HPA,
+				PDB:            in.Que.PDB,
+				Resources:      in.Que.Resources,
+				VPA:            in.Que.VPA,
+				LivenessProbe:  in.Que.LivenessProbe,
+				ReadinessProbe: in.Que.ReadinessProbe,
+				SchedulingSpec: SchedulingSpec{
+					NodeAffinity:              in.Que.NodeAffinity,
+					Tolerations:               in.Que.Tolerations,
+					TopologySpreadConstraints: in.Que.TopologySpreadConstraints,
+					PodAntiAffinity:           in.Que.PodAntiAffinity,
+					PodAffinity:               in.Que.PodAffinity,
+					PriorityClassName:         in.Que.PriorityClassName,
+				},
+			},
+		}
+	}
+	return out
+}
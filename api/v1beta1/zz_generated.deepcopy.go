@@ -0,0 +1,1518 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/3scale/saas-operator/api/v1alpha1"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Apicast) DeepCopyInto(out *Apicast) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Apicast.
+func (in *Apicast) DeepCopy() *Apicast {
+	if in == nil {
+		return nil
+	}
+	out := new(Apicast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Apicast) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicastEnvironmentSpec) DeepCopyInto(out *ApicastEnvironmentSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	in.Config.DeepCopyInto(&out.Config)
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Marin3r != nil {
+		in, out := &in.Marin3r, &out.Marin3r
+		*out = new(v1alpha1.Marin3rSidecarSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(v1alpha1.LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastEnvironmentSpec.
+func (in *ApicastEnvironmentSpec) DeepCopy() *ApicastEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicastEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicastList) DeepCopyInto(out *ApicastList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Apicast, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastList.
+func (in *ApicastList) DeepCopy() *ApicastList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicastList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApicastList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicastSpec) DeepCopyInto(out *ApicastSpec) {
+	*out = *in
+	in.Staging.DeepCopyInto(&out.Staging)
+	in.Production.DeepCopyInto(&out.Production)
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(v1alpha1.PrometheusRulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastSpec.
+func (in *ApicastSpec) DeepCopy() *ApicastSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicastSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicastStatus) DeepCopyInto(out *ApicastStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastStatus.
+func (in *ApicastStatus) DeepCopy() *ApicastStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicastStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoSSL) DeepCopyInto(out *AutoSSL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSSL.
+func (in *AutoSSL) DeepCopy() *AutoSSL {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoSSL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoSSL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoSSLList) DeepCopyInto(out *AutoSSLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AutoSSL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSSLList.
+func (in *AutoSSLList) DeepCopy() *AutoSSLList {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoSSLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoSSLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoSSLSpec) DeepCopyInto(out *AutoSSLSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(v1alpha1.LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSSLSpec.
+func (in *AutoSSLSpec) DeepCopy() *AutoSSLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoSSLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoSSLStatus) DeepCopyInto(out *AutoSSLStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSSLStatus.
+func (in *AutoSSLStatus) DeepCopy() *AutoSSLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoSSLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backend) DeepCopyInto(out *Backend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
+func (in *Backend) DeepCopy() *Backend {
+	if in == nil {
+		return nil
+	}
+	out := new(Backend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Backend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendList) DeepCopyInto(out *BackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Backend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendList.
+func (in *BackendList) DeepCopy() *BackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(v1alpha1.PrometheusRulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Listener.DeepCopyInto(&out.Listener)
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(WorkerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cron != nil {
+		in, out := &in.Cron, &out.Cron
+		*out = new(CronSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsProvider != nil {
+		in, out := &in.SecretsProvider, &out.SecretsProvider
+		*out = new(v1alpha1.SecretsProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
+func (in *BackendSpec) DeepCopy() *BackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendStatus.
+func (in *BackendStatus) DeepCopy() *BackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSProxy) DeepCopyInto(out *CORSProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSProxy.
+func (in *CORSProxy) DeepCopy() *CORSProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSProxyList) DeepCopyInto(out *CORSProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CORSProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSProxyList.
+func (in *CORSProxyList) DeepCopy() *CORSProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSProxySpec) DeepCopyInto(out *CORSProxySpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	if in.SecretsProvider != nil {
+		in, out := &in.SecretsProvider, &out.SecretsProvider
+		*out = new(v1alpha1.SecretsProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSProxySpec.
+func (in *CORSProxySpec) DeepCopy() *CORSProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSProxyStatus) DeepCopyInto(out *CORSProxyStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSProxyStatus.
+func (in *CORSProxyStatus) DeepCopy() *CORSProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronSpec) DeepCopyInto(out *CronSpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(v1alpha1.BackendCronMode)
+		**out = **in
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]v1alpha1.BackendCronJobSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1alpha1.ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(v1alpha1.VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronSpec.
+func (in *CronSpec) DeepCopy() *CronSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CronSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPI) DeepCopyInto(out *EchoAPI) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPI.
+func (in *EchoAPI) DeepCopy() *EchoAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(EchoAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EchoAPI) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPIList) DeepCopyInto(out *EchoAPIList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EchoAPI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPIList.
+func (in *EchoAPIList) DeepCopy() *EchoAPIList {
+	if in == nil {
+		return nil
+	}
+	out := new(EchoAPIList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EchoAPIList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPISpec) DeepCopyInto(out *EchoAPISpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	if in.Marin3r != nil {
+		in, out := &in.Marin3r, &out.Marin3r
+		*out = new(v1alpha1.Marin3rSidecarSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(v1alpha1.NLBLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPISpec.
+func (in *EchoAPISpec) DeepCopy() *EchoAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EchoAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EchoAPIStatus) DeepCopyInto(out *EchoAPIStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EchoAPIStatus.
+func (in *EchoAPIStatus) DeepCopy() *EchoAPIStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EchoAPIStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]EndpointHost, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointHost) DeepCopyInto(out *EndpointHost) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointHost.
+func (in *EndpointHost) DeepCopy() *EndpointHost {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha1.ListenerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Marin3r != nil {
+		in, out := &in.Marin3r, &out.Marin3r
+		*out = new(v1alpha1.Marin3rSidecarSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(v1alpha1.NLBLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerSpec.
+func (in *ListenerSpec) DeepCopy() *ListenerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingService) DeepCopyInto(out *MappingService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingService.
+func (in *MappingService) DeepCopy() *MappingService {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MappingService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceList) DeepCopyInto(out *MappingServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MappingService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceList.
+func (in *MappingServiceList) DeepCopy() *MappingServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MappingServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	if in.SecretsProvider != nil {
+		in, out := &in.SecretsProvider, &out.SecretsProvider
+		*out = new(v1alpha1.SecretsProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceSpec.
+func (in *MappingServiceSpec) DeepCopy() *MappingServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingServiceStatus) DeepCopyInto(out *MappingServiceStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingServiceStatus.
+func (in *MappingServiceStatus) DeepCopy() *MappingServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueWorkerSpec) DeepCopyInto(out *QueueWorkerSpec) {
+	*out = *in
+	if in.ScaledObject != nil {
+		in, out := &in.ScaledObject, &out.ScaledObject
+		*out = new(v1alpha1.ScaledObjectSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueWorkerSpec.
+func (in *QueueWorkerSpec) DeepCopy() *QueueWorkerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueWorkerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingSpec) DeepCopyInto(out *SchedulingSpec) {
+	*out = *in
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(v1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAntiAffinity != nil {
+		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
+		*out = new(v1alpha1.PodAntiAffinitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodAffinity != nil {
+		in, out := &in.PodAffinity, &out.PodAffinity
+		*out = new(v1.PodAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingSpec.
+func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *System) DeepCopyInto(out *System) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new System.
+func (in *System) DeepCopy() *System {
+	if in == nil {
+		return nil
+	}
+	out := new(System)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *System) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAccessTokensSpec) DeepCopyInto(out *SystemAccessTokensSpec) {
+	*out = *in
+	in.MappingService.DeepCopyInto(&out.MappingService)
+	in.Zync.DeepCopyInto(&out.Zync)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAccessTokensSpec.
+func (in *SystemAccessTokensSpec) DeepCopy() *SystemAccessTokensSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAccessTokensSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
+	*out = *in
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+	if in.Marin3r != nil {
+		in, out := &in.Marin3r, &out.Marin3r
+		*out = new(v1alpha1.Marin3rSidecarSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAppSpec.
+func (in *SystemAppSpec) DeepCopy() *SystemAppSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAppSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfig) DeepCopyInto(out *SystemConfig) {
+	*out = *in
+	if in.AMPRelease != nil {
+		in, out := &in.AMPRelease, &out.AMPRelease
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rails != nil {
+		in, out := &in.Rails, &out.Rails
+		*out = new(v1alpha1.SystemRailsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SSL.DeepCopyInto(&out.SSL)
+	in.Threescale.DeepCopyInto(&out.Threescale)
+	if in.ConfigFiles != nil {
+		in, out := &in.ConfigFiles, &out.ConfigFiles
+		*out = new(v1alpha1.ConfigFilesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Seed.DeepCopyInto(&out.Seed)
+	in.Database.DeepCopyInto(&out.Database)
+	in.Secrets.DeepCopyInto(&out.Secrets)
+	in.Integrations.DeepCopyInto(&out.Integrations)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	out.Memcached = in.Memcached
+	out.Redis = in.Redis
+	in.SMTP.DeepCopyInto(&out.SMTP)
+	in.AccessTokens.DeepCopyInto(&out.AccessTokens)
+	in.Backend.DeepCopyInto(&out.Backend)
+	in.Assets.DeepCopyInto(&out.Assets)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfig.
+func (in *SystemConfig) DeepCopy() *SystemConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemDatabaseSpec) DeepCopyInto(out *SystemDatabaseSpec) {
+	*out = *in
+	in.DSN.DeepCopyInto(&out.DSN)
+	in.Secret.DeepCopyInto(&out.Secret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemDatabaseSpec.
+func (in *SystemDatabaseSpec) DeepCopy() *SystemDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemIntegrationsSpec) DeepCopyInto(out *SystemIntegrationsSpec) {
+	*out = *in
+	in.Recaptcha.DeepCopyInto(&out.Recaptcha)
+	in.Segment.DeepCopyInto(&out.Segment)
+	in.Github.DeepCopyInto(&out.Github)
+	in.RedHatCustomerPortal.DeepCopyInto(&out.RedHatCustomerPortal)
+	if in.Bugsnag != nil {
+		in, out := &in.Bugsnag, &out.Bugsnag
+		*out = new(v1alpha1.BugsnagSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemIntegrationsSpec.
+func (in *SystemIntegrationsSpec) DeepCopy() *SystemIntegrationsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemIntegrationsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemList) DeepCopyInto(out *SystemList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]System, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemList.
+func (in *SystemList) DeepCopy() *SystemList {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SystemList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemMemcachedSpec) DeepCopyInto(out *SystemMemcachedSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemMemcachedSpec.
+func (in *SystemMemcachedSpec) DeepCopy() *SystemMemcachedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemMemcachedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemSSLSpec) DeepCopyInto(out *SystemSSLSpec) {
+	*out = *in
+	if in.Force != nil {
+		in, out := &in.Force, &out.Force
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertsDir != nil {
+		in, out := &in.CertsDir, &out.CertsDir
+		*out = new(string)
+		**out = **in
+	}
+	if in.SandboxProxyOpensslVerifyMode != nil {
+		in, out := &in.SandboxProxyOpensslVerifyMode, &out.SandboxProxyOpensslVerifyMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSSLSpec.
+func (in *SystemSSLSpec) DeepCopy() *SystemSSLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemSSLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemSecretsSpec) DeepCopyInto(out *SystemSecretsSpec) {
+	*out = *in
+	in.SecretKeyBase.DeepCopyInto(&out.SecretKeyBase)
+	in.AccessCode.DeepCopyInto(&out.AccessCode)
+	in.EventsSharedSecret.DeepCopyInto(&out.EventsSharedSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSecretsSpec.
+func (in *SystemSecretsSpec) DeepCopy() *SystemSecretsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemSecretsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(SystemAppSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sidekiq != nil {
+		in, out := &in.Sidekiq, &out.Sidekiq
+		*out = new(QueueWorkerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sphinx != nil {
+		in, out := &in.Sphinx, &out.Sphinx
+		*out = new(SystemSphinxSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(v1alpha1.SystemMigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(v1alpha1.PrometheusRulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsProvider != nil {
+		in, out := &in.SecretsProvider, &out.SecretsProvider
+		*out = new(v1alpha1.SecretsProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSpec.
+func (in *SystemSpec) DeepCopy() *SystemSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemSphinxSpec) DeepCopyInto(out *SystemSphinxSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha1.SphinxConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1alpha1.ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(v1alpha1.VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1alpha1.ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1alpha1.ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSphinxSpec.
+func (in *SystemSphinxSpec) DeepCopy() *SystemSphinxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemSphinxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemStatus) DeepCopyInto(out *SystemStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemStatus.
+func (in *SystemStatus) DeepCopy() *SystemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemThreescaleSpec) DeepCopyInto(out *SystemThreescaleSpec) {
+	*out = *in
+	if in.ProviderPlan != nil {
+		in, out := &in.ProviderPlan, &out.ProviderPlan
+		*out = new(string)
+		**out = **in
+	}
+	if in.Superdomain != nil {
+		in, out := &in.Superdomain, &out.Superdomain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemThreescaleSpec.
+func (in *SystemThreescaleSpec) DeepCopy() *SystemThreescaleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemThreescaleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha1.WorkerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaledObject != nil {
+		in, out := &in.ScaledObject, &out.ScaledObject
+		*out = new(v1alpha1.ScaledObjectSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.WorkloadSpec.DeepCopyInto(&out.WorkloadSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerSpec.
+func (in *WorkerSpec) DeepCopy() *WorkerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(v1alpha1.HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PDB != nil {
+		in, out := &in.PDB, &out.PDB
+		*out = new(v1alpha1.PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1alpha1.ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(v1alpha1.VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1alpha1.ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1alpha1.ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSpec.
+func (in *WorkloadSpec) DeepCopy() *WorkloadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Zync) DeepCopyInto(out *Zync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Zync.
+func (in *Zync) DeepCopy() *Zync {
+	if in == nil {
+		return nil
+	}
+	out := new(Zync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Zync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncList) DeepCopyInto(out *ZyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Zync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncList.
+func (in *ZyncList) DeepCopy() *ZyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncSpec) DeepCopyInto(out *ZyncSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1alpha1.ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	if in.GrafanaDashboard != nil {
+		in, out := &in.GrafanaDashboard, &out.GrafanaDashboard
+		*out = new(v1alpha1.GrafanaDashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(v1alpha1.PrometheusRulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(WorkloadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Que != nil {
+		in, out := &in.Que, &out.Que
+		*out = new(QueueWorkerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(v1alpha1.MigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsProvider != nil {
+		in, out := &in.SecretsProvider, &out.SecretsProvider
+		*out = new(v1alpha1.SecretsProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncSpec.
+func (in *ZyncSpec) DeepCopy() *ZyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZyncStatus) DeepCopyInto(out *ZyncStatus) {
+	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncStatus.
+func (in *ZyncStatus) DeepCopy() *ZyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
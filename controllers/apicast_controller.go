@@ -22,10 +22,12 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/redhat-cop/operator-utils/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
@@ -42,10 +44,16 @@ type ApicastReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=apicasts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=apicasts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=apicasts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 
@@ -66,21 +74,49 @@ func (r *ApicastReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	json, _ := json.Marshal(instance.Spec)
 	log.V(1).Info("Apply defaults before resolving templates", "JSON", string(json))
 
+	crs, err := apicastResources(instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
+	if err != nil {
+		log.Error(err, "unable to update locked resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return r.ManageError(ctx, instance, err)
+	}
+
+	return ctrl.Result{RequeueAfter: r.RequeueAfter(crs)}, nil
+}
+
+// apicastResources returns the ControlledResources for the given Apicast
+func apicastResources(instance *saasv1alpha1.Apicast) (basereconciler.ControlledResources, error) {
+
 	gen := apicast.NewGenerator(
 		instance.GetName(),
 		instance.GetNamespace(),
 		instance.Spec,
 	)
 
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{
 			{
 				Template: gen.Staging.Deployment(),
 				HasHPA:   !instance.Spec.Staging.HPA.IsDeactivated(),
+				HasVPA:   instance.Spec.Staging.VPA.IsUpdating(),
 			},
 			{
 				Template: gen.Production.Deployment(),
 				HasHPA:   !instance.Spec.Production.HPA.IsDeactivated(),
+				HasVPA:   instance.Spec.Production.VPA.IsUpdating(),
 			},
 		},
 		SecretDefinitions: []basereconciler.SecretDefinition{},
@@ -101,6 +137,14 @@ func (r *ApicastReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				Template: gen.Production.MgmtService(),
 				Enabled:  true,
 			},
+			{
+				Template: gen.Staging.MetricsService(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+			{
+				Template: gen.Production.MetricsService(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
 		},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{
 			{
@@ -122,40 +166,73 @@ func (r *ApicastReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				Enabled:  !instance.Spec.Production.HPA.IsDeactivated(),
 			},
 		},
+		VerticalPodAutoscalers: []basereconciler.VerticalPodAutoscaler{
+			{
+				Template: gen.Staging.VPA(),
+				Enabled:  !instance.Spec.Staging.VPA.IsDeactivated(),
+			},
+			{
+				Template: gen.Production.VPA(),
+				Enabled:  !instance.Spec.Production.VPA.IsDeactivated(),
+			},
+		},
 		PodMonitors: []basereconciler.PodMonitor{
 			{
 				Template: gen.Staging.PodMonitor(),
-				Enabled:  true,
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
 			},
 			{
 				Template: gen.Production.PodMonitor(),
-				Enabled:  true,
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
+			},
+		},
+		ServiceMonitors: []basereconciler.ServiceMonitor{
+			{
+				Template: gen.Staging.ServiceMonitor(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+			{
+				Template: gen.Production.ServiceMonitor(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 			},
 		},
 		GrafanaDashboards: []basereconciler.GrafanaDashboard{
 			{
 				Template: gen.ApicastDashboard(),
-				Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+				Enabled:  instance.Spec.GrafanaDashboard.IsDashboardEnabled("apicast"),
 			},
 			{
 				Template: gen.ApicastServicesDashboard(),
-				Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+				Enabled:  instance.Spec.GrafanaDashboard.IsDashboardEnabled("apicast-services"),
+			},
+		},
+		PrometheusRules: []basereconciler.PrometheusRule{
+			{
+				Template: gen.PrometheusRules(),
+				Enabled:  !instance.Spec.PrometheusRules.IsDeactivated(),
 			},
 		},
-	})
+	}
 
-	if err != nil {
-		log.Error(err, "unable to update locked resources")
-		return r.ManageError(ctx, instance, err)
+	for _, dashboard := range gen.CustomDashboards() {
+		crs.GrafanaDashboards = append(crs.GrafanaDashboards, basereconciler.GrafanaDashboard{
+			Template: dashboard,
+			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+		})
 	}
 
-	return ctrl.Result{}, nil
+	return crs, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ApicastReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&saasv1alpha1.Apicast{}, builder.WithPredicates(util.ResourceGenerationOrFinalizerChangedPredicate{})).
+		For(&saasv1alpha1.Apicast{}, builder.WithPredicates(predicate.Or(
+			util.ResourceGenerationOrFinalizerChangedPredicate{},
+			basereconciler.PausedAnnotationChangedPredicate{},
+			basereconciler.DryRunAnnotationChangedPredicate{},
+		))).
+		Owns(&appsv1.Deployment{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
-		Complete(r)
+		Complete(basereconciler.Instrument("Apicast", r))
 }
@@ -25,10 +25,12 @@ import (
 	"github.com/3scale/saas-operator/pkg/generators/autossl"
 	"github.com/go-logr/logr"
 	"github.com/redhat-cop/operator-utils/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
@@ -41,10 +43,15 @@ type AutoSSLReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=autossls,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=autossls/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=autossls/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 
@@ -65,17 +72,43 @@ func (r *AutoSSLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	json, _ := json.Marshal(instance.Spec)
 	log.V(1).Info("Apply defaults before resolving templates", "JSON", string(json))
 
+	crs, err := autosslResources(instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
+	if err != nil {
+		log.Error(err, "unable to update owned resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return r.ManageError(ctx, instance, err)
+	}
+
+	return r.ManageSuccessWithRequeue(ctx, instance, r.RequeueAfter(crs))
+}
+
+// autosslResources returns the ControlledResources for the given AutoSSL
+func autosslResources(instance *saasv1alpha1.AutoSSL) (basereconciler.ControlledResources, error) {
+
 	gen := autossl.NewGenerator(
 		instance.GetName(),
 		instance.GetNamespace(),
 		instance.Spec,
 	)
 
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{{
-			Template:        gen.Deployment(),
-			RolloutTriggers: nil,
-			HasHPA:          !instance.Spec.HPA.IsDeactivated(),
+			Template: gen.Deployment(),
+			HasHPA:   !instance.Spec.HPA.IsDeactivated(),
+			HasVPA:   instance.Spec.VPA.IsUpdating(),
 		}},
 		SecretDefinitions: []basereconciler.SecretDefinition{{
 			Template: nil,
@@ -84,6 +117,9 @@ func (r *AutoSSLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		Services: []basereconciler.Service{{
 			Template: gen.Service(),
 			Enabled:  true,
+		}, {
+			Template: gen.MetricsService(),
+			Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 		}},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{{
 			Template: gen.PDB(),
@@ -93,28 +129,43 @@ func (r *AutoSSLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			Template: gen.HPA(),
 			Enabled:  !instance.Spec.HPA.IsDeactivated(),
 		}},
+		VerticalPodAutoscalers: []basereconciler.VerticalPodAutoscaler{{
+			Template: gen.VPA(),
+			Enabled:  !instance.Spec.VPA.IsDeactivated(),
+		}},
 		PodMonitors: []basereconciler.PodMonitor{{
 			Template: gen.PodMonitor(),
-			Enabled:  true,
+			Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
+		}},
+		ServiceMonitors: []basereconciler.ServiceMonitor{{
+			Template: gen.ServiceMonitor(),
+			Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 		}},
 		GrafanaDashboards: []basereconciler.GrafanaDashboard{{
 			Template: gen.GrafanaDashboard(),
-			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+			Enabled:  instance.Spec.GrafanaDashboard.IsDashboardEnabled("autossl"),
 		}},
-	})
+	}
 
-	if err != nil {
-		log.Error(err, "unable to update owned resources")
-		return r.ManageError(ctx, instance, err)
+	for _, dashboard := range gen.CustomDashboards() {
+		crs.GrafanaDashboards = append(crs.GrafanaDashboards, basereconciler.GrafanaDashboard{
+			Template: dashboard,
+			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+		})
 	}
 
-	return r.ManageSuccess(ctx, instance)
+	return crs, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AutoSSLReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&saasv1alpha1.AutoSSL{}, builder.WithPredicates(util.ResourceGenerationOrFinalizerChangedPredicate{})).
+		For(&saasv1alpha1.AutoSSL{}, builder.WithPredicates(predicate.Or(
+			util.ResourceGenerationOrFinalizerChangedPredicate{},
+			basereconciler.PausedAnnotationChangedPredicate{},
+			basereconciler.DryRunAnnotationChangedPredicate{},
+		))).
+		Owns(&appsv1.Deployment{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
-		Complete(r)
+		Complete(basereconciler.Instrument("AutoSSL", r))
 }
@@ -22,12 +22,14 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/redhat-cop/operator-utils/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
@@ -44,14 +46,23 @@ type BackendReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=backends,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=backends/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=backends/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="secrets-manager.tuenti.io",namespace=placeholder,resources=secretdefinitions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -70,41 +81,50 @@ func (r *BackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	json, _ := json.Marshal(instance.Spec)
 	log.V(1).Info("Apply defaults before resolving templates", "JSON", string(json))
 
+	crs, err := backendResources(instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
+	if err != nil {
+		log.Error(err, "unable to reconcile owned resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return r.ManageError(ctx, instance, err)
+	}
+
+	return ctrl.Result{RequeueAfter: r.RequeueAfter(crs)}, nil
+}
+
+// backendResources returns the ControlledResources for the given Backend
+func backendResources(instance *saasv1alpha1.Backend) (basereconciler.ControlledResources, error) {
+
 	gen := backend.NewGenerator(
 		instance.GetName(),
 		instance.GetNamespace(),
 		instance.Spec,
 	)
 
-	// Calculate rollout triggers
-	triggers, err := r.TriggersFromSecretDefs(ctx,
-		gen.SystemEventsHookSecretDefinition(),
-		gen.InternalAPISecretDefinition(),
-		gen.ErrorMonitoringSecretDefinition(),
-	)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{
 			{
 				Template: gen.Listener.Deployment(),
 				HasHPA:   !instance.Spec.Listener.HPA.IsDeactivated(),
-				// Listener only depends on InternalAPISecretDefinition and ErrorMonitoringSecretDefinition
-				RolloutTriggers: []basereconciler.RolloutTrigger{triggers[1], triggers[2]},
+				HasVPA:   instance.Spec.Listener.VPA.IsUpdating(),
 			},
 			{
-				Template: gen.Worker.Deployment(),
-				HasHPA:   !instance.Spec.Worker.HPA.IsDeactivated(),
-				//Worker only depends on SystemEventsHookSecretDefinition and ErrorMonitoringSecretDefinition
-				RolloutTriggers: []basereconciler.RolloutTrigger{triggers[0], triggers[2]},
-			},
-			{
-				Template: gen.Cron.Deployment(),
-				HasHPA:   false,
-				// Cron only depends on ErrorMonitoringSecretDefinition
-				RolloutTriggers: []basereconciler.RolloutTrigger{triggers[2]},
+				Template:        gen.Worker.Deployment(),
+				HasHPA:          !instance.Spec.Worker.HPA.IsDeactivated() && instance.Spec.Worker.ScaledObject.IsDeactivated(),
+				HasVPA:          instance.Spec.Worker.VPA.IsUpdating(),
+				HasScaledObject: !instance.Spec.Worker.ScaledObject.IsDeactivated(),
 			},
 		},
 		SecretDefinitions: []basereconciler.SecretDefinition{
@@ -121,6 +141,7 @@ func (r *BackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				Enabled:  (instance.Spec.Config.ErrorMonitoringService != nil && instance.Spec.Config.ErrorMonitoringKey != nil),
 			},
 		},
+		SecretsProvider: instance.Spec.SecretsProvider,
 		Services: []basereconciler.Service{
 			{
 				Template: gen.Listener.Service(),
@@ -129,7 +150,16 @@ func (r *BackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			{
 				Template: gen.Listener.InternalService(),
 				Enabled:  true,
-			}},
+			},
+			{
+				Template: gen.Listener.MetricsService(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+			{
+				Template: gen.Worker.MetricsService(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+		},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{
 			{
 				Template: gen.Listener.PDB(), // Calculate rollout triggers
@@ -147,41 +177,101 @@ func (r *BackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			},
 			{
 				Template: gen.Worker.HPA(),
-				Enabled:  !instance.Spec.Worker.HPA.IsDeactivated(),
+				Enabled:  !instance.Spec.Worker.HPA.IsDeactivated() && instance.Spec.Worker.ScaledObject.IsDeactivated(),
+			},
+		},
+		ScaledObjects: []basereconciler.ScaledObject{
+			{
+				Template: gen.Worker.ScaledObject(),
+				Enabled:  !instance.Spec.Worker.ScaledObject.IsDeactivated(),
+			},
+		},
+		VerticalPodAutoscalers: []basereconciler.VerticalPodAutoscaler{
+			{
+				Template: gen.Listener.VPA(),
+				Enabled:  !instance.Spec.Listener.VPA.IsDeactivated(),
+			},
+			{
+				Template: gen.Worker.VPA(),
+				Enabled:  !instance.Spec.Worker.VPA.IsDeactivated(),
+			},
+			{
+				Template: gen.Cron.VPA(),
+				Enabled:  !instance.Spec.Cron.VPA.IsDeactivated() && instance.Spec.Cron.IsMode(saasv1alpha1.BackendCronDeploymentMode),
 			},
 		},
 		PodMonitors: []basereconciler.PodMonitor{
 			{
 				Template: gen.Listener.PodMonitor(),
-				Enabled:  true,
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
 			},
 			{
 				Template: gen.Worker.PodMonitor(),
-				Enabled:  true,
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
+			},
+		},
+		ServiceMonitors: []basereconciler.ServiceMonitor{
+			{
+				Template: gen.Listener.ServiceMonitor(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+			{
+				Template: gen.Worker.ServiceMonitor(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 			},
 		},
 		GrafanaDashboards: []basereconciler.GrafanaDashboard{
 			{
 				Template: gen.GrafanaDashboard(),
-				Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+				Enabled:  instance.Spec.GrafanaDashboard.IsDashboardEnabled("backend"),
+			},
+		},
+		PrometheusRules: []basereconciler.PrometheusRule{
+			{
+				Template: gen.PrometheusRules(),
+				Enabled:  !instance.Spec.PrometheusRules.IsDeactivated(),
 			},
 		},
-	})
+	}
 
-	if err != nil {
-		log.Error(err, "unable to reconcile owned resources")
-		return r.ManageError(ctx, instance, err)
+	// The backend cron runs either in a Deployment or in one CronJob per periodic task
+	if instance.Spec.Cron.IsMode(saasv1alpha1.BackendCronDeploymentMode) {
+		crs.Deployments = append(crs.Deployments, basereconciler.Deployment{
+			Template: gen.Cron.Deployment(),
+			HasHPA:   false,
+			HasVPA:   instance.Spec.Cron.VPA.IsUpdating(),
+		})
+	}
+	for _, job := range instance.Spec.Cron.Jobs {
+		crs.CronJobs = append(crs.CronJobs, basereconciler.CronJob{
+			Template: gen.Cron.CronJob(job),
+			Enabled:  instance.Spec.Cron.IsMode(saasv1alpha1.BackendCronCronJobMode),
+		})
+	}
+
+	for _, dashboard := range gen.CustomDashboards() {
+		crs.GrafanaDashboards = append(crs.GrafanaDashboards, basereconciler.GrafanaDashboard{
+			Template: dashboard,
+			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+		})
 	}
 
-	return ctrl.Result{}, nil
+	return crs, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *BackendReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&saasv1alpha1.Backend{}, builder.WithPredicates(util.ResourceGenerationOrFinalizerChangedPredicate{})).
+		For(&saasv1alpha1.Backend{}, builder.WithPredicates(predicate.Or(
+			util.ResourceGenerationOrFinalizerChangedPredicate{},
+			basereconciler.PausedAnnotationChangedPredicate{},
+			basereconciler.DryRunAnnotationChangedPredicate{},
+		))).
+		Owns(&appsv1.Deployment{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
 		Watches(&source.Kind{Type: &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret"}}},
 			r.SecretEventHandler(&saasv1alpha1.BackendList{}, r.Log)).
-		Complete(r)
+		Watches(&source.Kind{Type: &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{Kind: "ConfigMap"}}},
+			r.ConfigMapEventHandler(&saasv1alpha1.BackendList{}, r.Log)).
+		Complete(basereconciler.Instrument("Backend", r))
 }
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,14 +43,21 @@ type CORSProxyReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=corsproxies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=corsproxies/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=corsproxies/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="secrets-manager.tuenti.io",namespace=placeholder,resources=secretdefinitions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -68,31 +76,55 @@ func (r *CORSProxyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	json, _ := json.Marshal(instance.Spec)
 	log.V(1).Info("Apply defaults before resolving templates", "JSON", string(json))
 
+	crs, err := corsproxyResources(instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
+	if err != nil {
+		log.Error(err, "unable to reconcile owned resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return r.ManageError(ctx, instance, err)
+	}
+
+	return r.ManageSuccessWithRequeue(ctx, instance, r.RequeueAfter(crs))
+}
+
+// corsproxyResources returns the ControlledResources for the given CORSProxy
+func corsproxyResources(instance *saasv1alpha1.CORSProxy) (basereconciler.ControlledResources, error) {
+
 	gen := corsproxy.NewGenerator(
 		instance.GetName(),
 		instance.GetNamespace(),
 		instance.Spec,
 	)
 
-	// Calculate rollout triggers
-	triggers, err := r.TriggersFromSecretDefs(ctx, gen.SecretDefinition())
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{{
-			Template:        gen.Deployment(),
-			RolloutTriggers: triggers,
-			HasHPA:          !instance.Spec.HPA.IsDeactivated(),
+			Template: gen.Deployment(),
+			HasHPA:   !instance.Spec.HPA.IsDeactivated(),
+			HasVPA:   instance.Spec.VPA.IsUpdating(),
 		}},
 		SecretDefinitions: []basereconciler.SecretDefinition{{
 			Template: gen.SecretDefinition(),
 			Enabled:  true,
 		}},
+		SecretsProvider: instance.Spec.SecretsProvider,
 		Services: []basereconciler.Service{{
 			Template: gen.Service(),
 			Enabled:  true,
+		}, {
+			Template: gen.MetricsService(),
+			Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 		}},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{{
 			Template: gen.PDB(),
@@ -102,30 +134,43 @@ func (r *CORSProxyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			Template: gen.HPA(),
 			Enabled:  !instance.Spec.HPA.IsDeactivated(),
 		}},
+		VerticalPodAutoscalers: []basereconciler.VerticalPodAutoscaler{{
+			Template: gen.VPA(),
+			Enabled:  !instance.Spec.VPA.IsDeactivated(),
+		}},
 		PodMonitors: []basereconciler.PodMonitor{{
 			Template: gen.PodMonitor(),
-			Enabled:  true,
+			Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
+		}},
+		ServiceMonitors: []basereconciler.ServiceMonitor{{
+			Template: gen.ServiceMonitor(),
+			Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 		}},
 		GrafanaDashboards: []basereconciler.GrafanaDashboard{{
 			Template: gen.GrafanaDashboard(),
-			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+			Enabled:  instance.Spec.GrafanaDashboard.IsDashboardEnabled("cors-proxy"),
 		}},
-	})
+	}
 
-	if err != nil {
-		log.Error(err, "unable to reconcile owned resources")
-		return r.ManageError(ctx, instance, err)
+	for _, dashboard := range gen.CustomDashboards() {
+		crs.GrafanaDashboards = append(crs.GrafanaDashboards, basereconciler.GrafanaDashboard{
+			Template: dashboard,
+			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+		})
 	}
 
-	return r.ManageSuccess(ctx, instance)
+	return crs, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *CORSProxyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&saasv1alpha1.CORSProxy{}).
+		Owns(&appsv1.Deployment{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
 		Watches(&source.Kind{Type: &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret"}}},
 			r.SecretEventHandler(&saasv1alpha1.CORSProxyList{}, r.Log)).
-		Complete(r)
+		Watches(&source.Kind{Type: &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{Kind: "ConfigMap"}}},
+			r.ConfigMapEventHandler(&saasv1alpha1.CORSProxyList{}, r.Log)).
+		Complete(basereconciler.Instrument("CORSProxy", r))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
 	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
 	. "github.com/onsi/ginkgo"
@@ -14,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -144,4 +146,66 @@ var _ = Describe("CORSProxy controller", func() {
 			}, timeout, poll).ShouldNot(HaveOccurred())
 		})
 	})
+
+	Context("CORSProxy resource with the ExternalSecrets secrets provider", func() {
+
+		BeforeEach(func() {
+			By("creating a CORSProxy resource that uses ExternalSecrets")
+			corsproxy = &saasv1alpha1.CORSProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "instance",
+					Namespace: namespace,
+				},
+				Spec: saasv1alpha1.CORSProxySpec{
+					Config: saasv1alpha1.CORSProxyConfig{
+						SystemDatabaseDSN: saasv1alpha1.SecretReference{
+							FromVault: &saasv1alpha1.VaultSecretReference{
+								Path: "some-path",
+								Key:  "some-key",
+							},
+						},
+					},
+					SecretsProvider: &saasv1alpha1.SecretsProviderSpec{
+						Type: func() *saasv1alpha1.SecretsProviderType {
+							t := saasv1alpha1.ExternalSecretsProvider
+							return &t
+						}(),
+						SecretStoreRef: &saasv1alpha1.SecretStoreReference{Name: "vault"},
+					},
+				},
+			}
+			err := k8sClient.Create(context.Background(), corsproxy)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, corsproxy)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+		})
+
+		It("creates an ExternalSecret instead of a SecretDefinition", func() {
+
+			es := &externalsecretsv1beta1.ExternalSecret{}
+			Eventually(func() error {
+				return k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "cors-proxy-system-database", Namespace: namespace},
+					es,
+				)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			Expect(es.Spec.SecretStoreRef).To(Equal(externalsecretsv1beta1.SecretStoreRef{Name: "vault", Kind: "SecretStore"}))
+			Expect(es.Spec.Target.Name).To(Equal("cors-proxy-system-database"))
+			Expect(es.Spec.Data).To(Equal([]externalsecretsv1beta1.ExternalSecretData{{
+				SecretKey: "DATABASE_URL",
+				RemoteRef: externalsecretsv1beta1.ExternalSecretDataRemoteRef{Key: "some-path", Property: "some-key"},
+			}}))
+
+			sd := &secretsmanagerv1alpha1.SecretDefinition{}
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "cors-proxy-system-database", Namespace: namespace},
+				sd,
+			)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
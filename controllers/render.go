@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Render returns the resources that the operator would create for the given custom
+// resource, without accessing the cluster. Defaults are applied to the custom resource
+// and rollout triggers are calculated as if none of the Secrets existed yet.
+func Render(obj client.Object) ([]client.Object, error) {
+	crs, err := controlledResources(obj)
+	if err != nil {
+		return nil, err
+	}
+	return crs.Render()
+}
+
+// Diff returns the changes that the operator would apply to the owned resources of
+// the given custom resource, without modifying them. Defaults are applied to the
+// custom resource and rollout triggers are calculated from the live Secrets and ConfigMaps.
+func Diff(ctx context.Context, r *basereconciler.Reconciler, obj client.Object) ([]basereconciler.ResourceDiff, error) {
+	crs, err := controlledResources(obj)
+	if err != nil {
+		return nil, err
+	}
+	return r.DiffOwnedResources(ctx, obj, crs)
+}
+
+// controlledResources applies defaults to the given custom resource
+// and returns its ControlledResources
+func controlledResources(obj client.Object) (basereconciler.ControlledResources, error) {
+
+	switch instance := obj.(type) {
+	case *saasv1alpha1.Apicast:
+		instance.Default()
+		return apicastResources(instance)
+	case *saasv1alpha1.AutoSSL:
+		instance.Default()
+		return autosslResources(instance)
+	case *saasv1alpha1.Backend:
+		instance.Default()
+		return backendResources(instance)
+	case *saasv1alpha1.CORSProxy:
+		instance.Default()
+		return corsproxyResources(instance)
+	case *saasv1alpha1.EchoAPI:
+		instance.Default()
+		return echoapiResources(instance)
+	case *saasv1alpha1.MappingService:
+		instance.Default()
+		return mappingserviceResources(instance)
+	case *saasv1alpha1.System:
+		instance.Default()
+		return systemResources(instance)
+	case *saasv1alpha1.Zync:
+		instance.Default()
+		return zyncResources(instance)
+	default:
+		return basereconciler.ControlledResources{}, fmt.Errorf("unsupported kind %T", obj)
+	}
+}
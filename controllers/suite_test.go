@@ -17,6 +17,8 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
+	"io/ioutil"
 	"path/filepath"
 	"testing"
 	"time"
@@ -24,6 +26,7 @@ import (
 	"github.com/goombaio/namegenerator"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,14 +35,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/yaml"
 
 	"github.com/3scale/saas-operator/pkg/basereconciler"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
 	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -67,9 +75,11 @@ var _ = BeforeSuite(func() {
 	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(false)))
 
 	By("bootstrapping test environment")
+	crds, err := hubCRDs(filepath.Join("..", "config", "crd", "bases"))
+	Expect(err).NotTo(HaveOccurred())
 	testEnv = &envtest.Environment{
+		CRDs: crds,
 		CRDDirectoryPaths: []string{
-			filepath.Join("..", "config", "crd", "bases"),
 			filepath.Join("..", "config", "test", "external-apis"),
 		},
 	}
@@ -89,6 +99,15 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	err = secretsmanagerv1alpha1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
+	err = externalsecretsv1beta1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = kedav1alpha1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = vpav1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = batchv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: scheme.Scheme,
@@ -98,6 +117,21 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).ToNot(HaveOccurred())
 
+	err = basereconciler.IndexSecretDefinitions(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexDeploymentSecrets(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexStatefulSetSecrets(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexDeploymentConfigMaps(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexStatefulSetConfigMaps(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
 	k8sClient = mgr.GetClient()
 	Expect(k8sClient).ToNot(BeNil())
 
@@ -158,6 +192,42 @@ var _ = BeforeSuite(func() {
 
 }, 60)
 
+// hubCRDs returns the CRDs of the operator in the given directory serving and
+// storing only the hub version of the API. The test environment can't call the
+// conversion webhook, so it can't store objects in any other version.
+func hubCRDs(dir string) ([]client.Object, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	crds := []client.Object{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		crd := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &crd.Object); err != nil {
+			return nil, err
+		}
+		versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			version := v.(map[string]interface{})
+			if version["name"] == saasv1alpha1.GroupVersion.Version {
+				version["storage"] = true
+				if err := unstructured.SetNestedSlice(crd.Object, []interface{}{version}, "spec", "versions"); err != nil {
+					return nil, err
+				}
+			}
+		}
+		crds = append(crds, crd)
+	}
+	return crds, nil
+}
+
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
 	err := testEnv.Stop()
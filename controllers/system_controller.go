@@ -21,6 +21,8 @@ import (
 	"encoding/json"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,11 +44,19 @@ type SystemReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 
@@ -67,55 +77,61 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	json, _ := json.Marshal(instance.Spec)
 	log.V(1).Info("Apply defaults before resolving templates", "JSON", string(json))
 
-	gen := system.NewGenerator(
-		instance.GetName(),
-		instance.GetNamespace(),
-		instance.Spec,
-	)
-
-	// Calculate rollout triggers (app & sidekiq)
-	triggers, err := r.TriggersFromSecretDefs(ctx,
-		gen.ConfigFilesSecretDefinition(),
-		gen.SeedSecretDefinition(),
-		gen.DatabaseSecretDefinition(),
-		gen.RecaptchaSecretDefinition(),
-		gen.EventsHookSecretDefinition(),
-		gen.SMTPSecretDefinition(),
-		gen.MasterApicastSecretDefinition(),
-		gen.ZyncSecretDefinition(),
-		gen.BackendSecretDefinition(),
-		gen.MultitenantAssetsSecretDefinition(),
-		gen.AppSecretDefinition(),
-	)
+	crs, err := systemResources(instance)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Calculate rollout triggers (sphinx)
-	sphinxTriggers, err := r.TriggersFromSecretDefs(ctx,
-		gen.DatabaseSecretDefinition(),
-	)
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
 	if err != nil {
-		return ctrl.Result{}, err
+		log.Error(err, "unable to update owned resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return r.ManageError(ctx, instance, err)
 	}
 
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	return r.ManageSuccessWithRequeue(ctx, instance, r.RequeueAfter(crs))
+}
+
+// systemResources returns the ControlledResources for the given System
+func systemResources(instance *saasv1alpha1.System) (basereconciler.ControlledResources, error) {
+
+	gen := system.NewGenerator(
+		instance.GetName(),
+		instance.GetNamespace(),
+		instance.Spec,
+	)
+
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{
 			{
-				Template:        gen.App.Deployment(),
-				RolloutTriggers: triggers,
-				HasHPA:          !instance.Spec.App.HPA.IsDeactivated(),
+				Template: gen.App.Deployment(),
+				HasHPA:   !instance.Spec.App.HPA.IsDeactivated(),
+				HasVPA:   instance.Spec.App.VPA.IsUpdating(),
 			},
 			{
 				Template:        gen.Sidekiq.Deployment(),
-				RolloutTriggers: triggers,
-				HasHPA:          !instance.Spec.Sidekiq.HPA.IsDeactivated(),
+				HasHPA:          !instance.Spec.Sidekiq.HPA.IsDeactivated() && instance.Spec.Sidekiq.ScaledObject.IsDeactivated(),
+				HasVPA:          instance.Spec.Sidekiq.VPA.IsUpdating(),
+				HasScaledObject: !instance.Spec.Sidekiq.ScaledObject.IsDeactivated(),
 			},
 		},
 		StatefulSets: []basereconciler.StatefulSet{{
-			Template:        gen.Sphinx.StatefulSet(),
-			RolloutTriggers: sphinxTriggers,
-			Enabled:         true,
+			Template: gen.Sphinx.StatefulSet(),
+			Enabled:  true,
+			HasVPA:   instance.Spec.Sphinx.VPA.IsUpdating(),
+		}},
+		Jobs: []basereconciler.Job{{
+			Template:  gen.MigrationJob(),
+			Enabled:   !instance.Spec.Migration.IsDeactivated(),
+			Migration: true,
 		}},
 		SecretDefinitions: []basereconciler.SecretDefinition{
 			{Template: gen.ConfigFilesSecretDefinition(), Enabled: instance.Spec.Config.ConfigFiles.Enabled()},
@@ -130,9 +146,12 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			{Template: gen.MultitenantAssetsSecretDefinition(), Enabled: true},
 			{Template: gen.AppSecretDefinition(), Enabled: true},
 		},
+		SecretsProvider: instance.Spec.SecretsProvider,
 		Services: []basereconciler.Service{
 			{Template: gen.App.Service(), Enabled: true},
 			{Template: gen.Sphinx.Service(), Enabled: true},
+			{Template: gen.App.MetricsService(), Enabled: instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode)},
+			{Template: gen.Sidekiq.MetricsService(), Enabled: instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode)},
 		},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{
 			{Template: gen.App.PDB(), Enabled: !instance.Spec.App.PDB.IsDeactivated()},
@@ -140,31 +159,53 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		},
 		HorizontalPodAutoscalers: []basereconciler.HorizontalPodAutoscaler{
 			{Template: gen.App.HPA(), Enabled: !instance.Spec.App.HPA.IsDeactivated()},
-			{Template: gen.Sidekiq.HPA(), Enabled: !instance.Spec.Sidekiq.HPA.IsDeactivated()},
+			{Template: gen.Sidekiq.HPA(), Enabled: !instance.Spec.Sidekiq.HPA.IsDeactivated() && instance.Spec.Sidekiq.ScaledObject.IsDeactivated()},
+		},
+		ScaledObjects: []basereconciler.ScaledObject{
+			{Template: gen.Sidekiq.ScaledObject(), Enabled: !instance.Spec.Sidekiq.ScaledObject.IsDeactivated()},
+		},
+		VerticalPodAutoscalers: []basereconciler.VerticalPodAutoscaler{
+			{Template: gen.App.VPA(), Enabled: !instance.Spec.App.VPA.IsDeactivated()},
+			{Template: gen.Sidekiq.VPA(), Enabled: !instance.Spec.Sidekiq.VPA.IsDeactivated()},
+			{Template: gen.Sphinx.VPA(), Enabled: !instance.Spec.Sphinx.VPA.IsDeactivated()},
 		},
 		PodMonitors: []basereconciler.PodMonitor{
-			{Template: gen.App.PodMonitor(), Enabled: true},
-			{Template: gen.Sidekiq.PodMonitor(), Enabled: true},
+			{Template: gen.App.PodMonitor(), Enabled: instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode)},
+			{Template: gen.Sidekiq.PodMonitor(), Enabled: instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode)},
+		},
+		ServiceMonitors: []basereconciler.ServiceMonitor{
+			{Template: gen.App.ServiceMonitor(), Enabled: instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode)},
+			{Template: gen.Sidekiq.ServiceMonitor(), Enabled: instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode)},
 		},
 		GrafanaDashboards: []basereconciler.GrafanaDashboard{
-			{Template: gen.GrafanaDashboard(), Enabled: !instance.Spec.GrafanaDashboard.IsDeactivated()},
+			{Template: gen.GrafanaDashboard(), Enabled: instance.Spec.GrafanaDashboard.IsDashboardEnabled("system")},
+		},
+		PrometheusRules: []basereconciler.PrometheusRule{
+			{Template: gen.PrometheusRules(), Enabled: !instance.Spec.PrometheusRules.IsDeactivated()},
 		},
-	})
+	}
 
-	if err != nil {
-		log.Error(err, "unable to update owned resources")
-		return r.ManageError(ctx, instance, err)
+	for _, dashboard := range gen.CustomDashboards() {
+		crs.GrafanaDashboards = append(crs.GrafanaDashboards, basereconciler.GrafanaDashboard{
+			Template: dashboard,
+			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+		})
 	}
 
-	return r.ManageSuccess(ctx, instance)
+	return crs, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *SystemReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&saasv1alpha1.System{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&batchv1.Job{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
 		Watches(&source.Kind{Type: &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret"}}},
 			r.SecretEventHandler(&saasv1alpha1.SystemList{}, r.Log)).
-		Complete(r)
+		Watches(&source.Kind{Type: &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{Kind: "ConfigMap"}}},
+			r.ConfigMapEventHandler(&saasv1alpha1.SystemList{}, r.Log)).
+		Complete(basereconciler.Instrument("System", r))
 }
@@ -22,12 +22,15 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/redhat-cop/operator-utils/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
@@ -44,14 +47,23 @@ type ZyncReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=zyncs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=zyncs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=zyncs/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="batch",namespace=placeholder,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="secrets-manager.tuenti.io",namespace=placeholder,resources=secretdefinitions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -70,31 +82,57 @@ func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	json, _ := json.Marshal(instance.Spec)
 	log.V(1).Info("Apply defaults before resolving templates", "JSON", string(json))
 
+	crs, err := zyncResources(instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
+	if err != nil {
+		log.Error(err, "unable to reconcile owned resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
+		return r.ManageError(ctx, instance, err)
+	}
+
+	return ctrl.Result{RequeueAfter: r.RequeueAfter(crs)}, nil
+}
+
+// zyncResources returns the ControlledResources for the given Zync
+func zyncResources(instance *saasv1alpha1.Zync) (basereconciler.ControlledResources, error) {
+
 	gen := zync.NewGenerator(
 		instance.GetName(),
 		instance.GetNamespace(),
 		instance.Spec,
 	)
 
-	// Calculate rollout triggers
-	triggers, err := r.TriggersFromSecretDefs(ctx,
-		gen.ZyncSecretDefinition(),
-	)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{
 			{
-				Template:        gen.API.Deployment(),
-				HasHPA:          !instance.Spec.API.HPA.IsDeactivated(),
-				RolloutTriggers: triggers,
+				Template: gen.API.Deployment(),
+				HasHPA:   !instance.Spec.API.HPA.IsDeactivated(),
+				HasVPA:   instance.Spec.API.VPA.IsUpdating(),
 			},
 			{
 				Template:        gen.Que.Deployment(),
-				HasHPA:          !instance.Spec.Que.HPA.IsDeactivated(),
-				RolloutTriggers: triggers,
+				HasHPA:          !instance.Spec.Que.HPA.IsDeactivated() && instance.Spec.Que.ScaledObject.IsDeactivated(),
+				HasVPA:          instance.Spec.Que.VPA.IsUpdating(),
+				HasScaledObject: !instance.Spec.Que.ScaledObject.IsDeactivated(),
+			},
+		},
+		Jobs: []basereconciler.Job{
+			{
+				Template:  gen.MigrationJob(),
+				Enabled:   !instance.Spec.Migration.IsDeactivated(),
+				Migration: true,
 			},
 		},
 		SecretDefinitions: []basereconciler.SecretDefinition{
@@ -103,11 +141,21 @@ func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 				Enabled:  true,
 			},
 		},
+		SecretsProvider: instance.Spec.SecretsProvider,
 		Services: []basereconciler.Service{
 			{
 				Template: gen.API.Service(),
 				Enabled:  true,
-			}},
+			},
+			{
+				Template: gen.API.MetricsService(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+			{
+				Template: gen.Que.MetricsService(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+		},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{
 			{
 				Template: gen.API.PDB(),
@@ -125,41 +173,83 @@ func (r *ZyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 			},
 			{
 				Template: gen.Que.HPA(),
-				Enabled:  !instance.Spec.Que.HPA.IsDeactivated(),
+				Enabled:  !instance.Spec.Que.HPA.IsDeactivated() && instance.Spec.Que.ScaledObject.IsDeactivated(),
+			},
+		},
+		ScaledObjects: []basereconciler.ScaledObject{
+			{
+				Template: gen.Que.ScaledObject(),
+				Enabled:  !instance.Spec.Que.ScaledObject.IsDeactivated(),
+			},
+		},
+		VerticalPodAutoscalers: []basereconciler.VerticalPodAutoscaler{
+			{
+				Template: gen.API.VPA(),
+				Enabled:  !instance.Spec.API.VPA.IsDeactivated(),
+			},
+			{
+				Template: gen.Que.VPA(),
+				Enabled:  !instance.Spec.Que.VPA.IsDeactivated(),
 			},
 		},
 		PodMonitors: []basereconciler.PodMonitor{
 			{
 				Template: gen.API.PodMonitor(),
-				Enabled:  true,
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
 			},
 			{
 				Template: gen.Que.PodMonitor(),
-				Enabled:  true,
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.PodMonitorMode),
+			},
+		},
+		ServiceMonitors: []basereconciler.ServiceMonitor{
+			{
+				Template: gen.API.ServiceMonitor(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
+			},
+			{
+				Template: gen.Que.ServiceMonitor(),
+				Enabled:  instance.Spec.Monitoring.IsMode(saasv1alpha1.ServiceMonitorMode),
 			},
 		},
 		GrafanaDashboards: []basereconciler.GrafanaDashboard{
 			{
 				Template: gen.GrafanaDashboard(),
-				Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+				Enabled:  instance.Spec.GrafanaDashboard.IsDashboardEnabled("zync"),
 			},
 		},
-	})
+		PrometheusRules: []basereconciler.PrometheusRule{
+			{
+				Template: gen.PrometheusRules(),
+				Enabled:  !instance.Spec.PrometheusRules.IsDeactivated(),
+			},
+		},
+	}
 
-	if err != nil {
-		log.Error(err, "unable to reconcile owned resources")
-		return r.ManageError(ctx, instance, err)
+	for _, dashboard := range gen.CustomDashboards() {
+		crs.GrafanaDashboards = append(crs.GrafanaDashboards, basereconciler.GrafanaDashboard{
+			Template: dashboard,
+			Enabled:  !instance.Spec.GrafanaDashboard.IsDeactivated(),
+		})
 	}
 
-	return ctrl.Result{}, nil
+	return crs, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ZyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&saasv1alpha1.Zync{}, builder.WithPredicates(util.ResourceGenerationOrFinalizerChangedPredicate{})).
+		For(&saasv1alpha1.Zync{}, builder.WithPredicates(predicate.Or(
+			util.ResourceGenerationOrFinalizerChangedPredicate{},
+			basereconciler.PausedAnnotationChangedPredicate{},
+			basereconciler.DryRunAnnotationChangedPredicate{},
+		))).
+		Owns(&appsv1.Deployment{}).
+		Owns(&batchv1.Job{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
 		Watches(&source.Kind{Type: &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret"}}},
 			r.SecretEventHandler(&saasv1alpha1.ZyncList{}, r.Log)).
-		Complete(r)
+		Watches(&source.Kind{Type: &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{Kind: "ConfigMap"}}},
+			r.ConfigMapEventHandler(&saasv1alpha1.ZyncList{}, r.Log)).
+		Complete(basereconciler.Instrument("Zync", r))
 }
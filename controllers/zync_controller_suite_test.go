@@ -182,6 +182,15 @@ var _ = Describe("Zync controller", func() {
 					gd,
 				)
 			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			pr := &monitoringv1.PrometheusRule{}
+			Eventually(func() error {
+				return k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "zync", Namespace: namespace},
+					pr,
+				)
+			}, timeout, poll).ShouldNot(HaveOccurred())
 		})
 	})
 })
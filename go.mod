@@ -11,10 +11,13 @@ require (
 	github.com/onsi/gomega v1.10.2
 	github.com/openshift/api v3.9.0+incompatible
 	github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring v0.42.1
+	github.com/prometheus/client_golang v1.7.1
 	github.com/redhat-cop/operator-utils v1.1.3-0.20210602122509-2eaf121122d2
+	gomodules.xyz/jsonpatch/v2 v2.1.0
 	k8s.io/api v0.20.0
 	k8s.io/apimachinery v0.20.0
 	k8s.io/client-go v0.20.0
 	k8s.io/utils v0.0.0-20201110183641-67b214c5f920
 	sigs.k8s.io/controller-runtime v0.7.0
+	sigs.k8s.io/yaml v1.2.0
 )
@@ -17,17 +17,20 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -37,10 +40,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	saasv1beta1 "github.com/3scale/saas-operator/api/v1beta1"
 	"github.com/3scale/saas-operator/controllers"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
 	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
 	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/migrate"
+	"github.com/3scale/saas-operator/pkg/render"
 	"github.com/3scale/saas-operator/pkg/version"
 	// +kubebuilder:scaffold:imports
 )
@@ -51,6 +61,9 @@ const (
 	// which specifies the Namespace to watch.
 	// An empty value means the operator is running with cluster scope.
 	watchNamespaceEnvVar string = "WATCH_NAMESPACE"
+	// enableWebhooksEnvVar is the constant for env variable ENABLE_WEBHOOKS
+	// which disables the admission webhooks when set to "false"
+	enableWebhooksEnvVar string = "ENABLE_WEBHOOKS"
 )
 
 var (
@@ -62,21 +75,88 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(saasv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(saasv1beta1.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1.AddToScheme(scheme))
 	utilruntime.Must(grafanav1alpha1.AddToScheme(scheme))
 	utilruntime.Must(secretsmanagerv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(externalsecretsv1beta1.AddToScheme(scheme))
+	utilruntime.Must(kedav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(vpav1.AddToScheme(scheme))
+	utilruntime.Must(batchv1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
+	// Print the resources generated for a set of custom resources, without
+	// starting the manager. Useful to review changes before applying them.
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		if err := render.Run(os.Args[2:], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	// Print the changes that applying a set of custom resources would cause
+	// in the cluster of the current kubeconfig, without modifying anything
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := render.RunDiff(os.Args[2:], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	// Print the GrafanaDashboard with the metrics of the operator itself
+	if len(os.Args) > 1 && os.Args[1] == "dashboard" {
+		if err := render.RunDashboard(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	// Rewrite all the custom resources in the storage version of the API, so
+	// older versions can be removed from the stored versions of the CRDs
+	if len(os.Args) > 1 && os.Args[1] == "migrate-storage" {
+		if err := migrate.Run(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var serverSideApply string
+	var secretsProviderType string
+	var secretStoreName string
+	var secretStoreKind string
+	var secretsRefreshInterval time.Duration
+	var waitForSecrets bool
+	var resyncPeriod time.Duration
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&serverSideApply, "server-side-apply", "",
+		"Comma separated list of controllers (e.g. 'Backend,System') that reconcile their owned resources "+
+			"using server-side apply instead of the locked resources controller. Use 'all' to enable it for every controller.")
+	flag.StringVar(&secretsProviderType, "secrets-provider", string(saasv1alpha1.SecretsManagerProvider),
+		"The provider used to populate the Secrets of the custom resources that do not configure their own. "+
+			"One of 'SecretsManager' (secrets-manager.tuenti.io SecretDefinitions) or 'ExternalSecrets' (external-secrets.io ExternalSecrets).")
+	flag.StringVar(&secretStoreName, "secret-store-name", "",
+		"The name of the SecretStore used by the 'ExternalSecrets' secrets provider.")
+	flag.StringVar(&secretStoreKind, "secret-store-kind", basereconciler.DefaultSecretStoreKind,
+		"The kind of the SecretStore used by the 'ExternalSecrets' secrets provider, either 'SecretStore' or 'ClusterSecretStore'.")
+	flag.DurationVar(&secretsRefreshInterval, "secrets-refresh-interval", 0,
+		"The interval at which the 'ExternalSecrets' secrets provider refreshes the Secrets. Zero leaves the default of external-secrets.")
+	flag.BoolVar(&waitForSecrets, "wait-for-secrets", false,
+		"Hold back the creation and the updates of the workloads until all the Secrets they depend on are ready. "+
+			"Can be overridden for each custom resource with the 'saas.3scale.net/wait-for-secrets' annotation.")
+	flag.DurationVar(&resyncPeriod, "resync-period", basereconciler.DefaultResyncPeriod,
+		"The period after which the custom resources reconciled with server-side apply are reconciled again, "+
+			"reverting any change made to their owned resources. Custom resources with VerticalPodAutoscalers are "+
+			"also reconciled again after it to refresh the recommendations in their status.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -87,6 +167,12 @@ func main() {
 
 	printVersion()
 
+	secretsProvider, err := newSecretsProvider(secretsProviderType, secretStoreName, secretStoreKind, secretsRefreshInterval)
+	if err != nil {
+		setupLog.Error(err, "invalid secrets provider")
+		os.Exit(1)
+	}
+
 	watchNamespace, err := getWatchNamespace()
 	if err != nil {
 		setupLog.Error(err, "unable to get WatchNamespace, "+
@@ -116,70 +202,178 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := basereconciler.IndexSecretDefinitions(context.Background(), mgr); err != nil {
+		setupLog.Error(err, "unable to index SecretDefinitions")
+		os.Exit(1)
+	}
+
+	if err := basereconciler.IndexDeploymentSecrets(context.Background(), mgr); err != nil {
+		setupLog.Error(err, "unable to index Deployments")
+		os.Exit(1)
+	}
+
+	if err := basereconciler.IndexStatefulSetSecrets(context.Background(), mgr); err != nil {
+		setupLog.Error(err, "unable to index StatefulSets")
+		os.Exit(1)
+	}
+
+	if err := basereconciler.IndexDeploymentConfigMaps(context.Background(), mgr); err != nil {
+		setupLog.Error(err, "unable to index Deployments")
+		os.Exit(1)
+	}
+
+	if err := basereconciler.IndexStatefulSetConfigMaps(context.Background(), mgr); err != nil {
+		setupLog.Error(err, "unable to index StatefulSets")
+		os.Exit(1)
+	}
+
+	autoscalingV2, err := basereconciler.IsAutoscalingV2Supported(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to discover the autoscaling API versions")
+		os.Exit(1)
+	}
+	setupLog.Info(fmt.Sprintf("using autoscaling/v2 for HorizontalPodAutoscalers: %t", autoscalingV2))
+
 	if err = (&controllers.AutoSSLReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("AutoSSL"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("AutoSSL"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("AutoSSL"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "AutoSSL")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("AutoSSL"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AutoSSL")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.ApicastReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Apicast"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("Apicast"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Apicast"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "Apicast")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("Apicast"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Apicast")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.MappingServiceReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("MappingService"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("MappingService"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("MappingService"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "MappingService")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("MappingService"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MappingService")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.CORSProxyReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("CORSProxy"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("CORSProxy"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("CORSProxy"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "CORSProxy")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("CORSProxy"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CORSProxy")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.BackendReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Backend"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("Backend"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Backend"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "Backend")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("Backend"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Backend")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.SystemReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("System"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("System"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("System"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "System")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("System"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "System")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.ZyncReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Zync"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("Zync"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Zync"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "Zync")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("Zync"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Zync")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.EchoAPIReconciler{
-		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("EchoAPI"), false),
-		Log:        ctrl.Log.WithName("controllers").WithName("EchoAPI"),
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("EchoAPI"), false,
+			basereconciler.WithServerSideApply(useServerSideApply(serverSideApply, "EchoAPI")),
+			basereconciler.WithSecretsProvider(secretsProvider),
+			basereconciler.WithWaitForSecrets(waitForSecrets),
+			basereconciler.WithAutoscalingV2(autoscalingV2),
+			basereconciler.WithResyncPeriod(resyncPeriod)),
+		Log: ctrl.Log.WithName("controllers").WithName("EchoAPI"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EchoAPI")
 		os.Exit(1)
 	}
 
+	// Webhooks can be disabled when running the operator locally, without certificates
+	if os.Getenv(enableWebhooksEnvVar) != "false" {
+		if err = (&saasv1alpha1.AutoSSL{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AutoSSL")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.Apicast{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Apicast")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.MappingService{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "MappingService")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.CORSProxy{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "CORSProxy")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.Backend{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Backend")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.System{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "System")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.Zync{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Zync")
+			os.Exit(1)
+		}
+		if err = (&saasv1alpha1.EchoAPI{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "EchoAPI")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
@@ -213,3 +407,37 @@ func printVersion() {
 	setupLog.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	setupLog.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
 }
+
+// useServerSideApply returns true if the controller is included in the
+// comma separated list of controllers passed in the "server-side-apply" flag
+func useServerSideApply(controllers, name string) bool {
+	for _, c := range strings.Split(controllers, ",") {
+		c = strings.TrimSpace(c)
+		if c == "all" || strings.EqualFold(c, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// newSecretsProvider returns the SecretsProviderSpec configured
+// through the command line flags of the operator
+func newSecretsProvider(providerType, storeName, storeKind string, refreshInterval time.Duration) (saasv1alpha1.SecretsProviderSpec, error) {
+	provider := saasv1alpha1.SecretsProviderSpec{}
+
+	switch t := saasv1alpha1.SecretsProviderType(providerType); t {
+	case saasv1alpha1.SecretsManagerProvider, saasv1alpha1.ExternalSecretsProvider:
+		provider.Type = &t
+	default:
+		return provider, fmt.Errorf("unsupported secrets provider '%s'", providerType)
+	}
+
+	if storeName != "" {
+		provider.SecretStoreRef = &saasv1alpha1.SecretStoreReference{Name: storeName, Kind: &storeKind}
+	}
+	if refreshInterval > 0 {
+		provider.RefreshInterval = &metav1.Duration{Duration: refreshInterval}
+	}
+
+	return provider, nil
+}
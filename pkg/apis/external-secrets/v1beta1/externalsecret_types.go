@@ -0,0 +1,101 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Only the subset of the ExternalSecret API used by the operator is defined here
+
+// SecretStoreRef defines which SecretStore to fetch the ExternalSecret data from
+type SecretStoreRef struct {
+	// Name of the SecretStore resource
+	Name string `json:"name"`
+	// Kind of the SecretStore resource (SecretStore or ClusterSecretStore)
+	Kind string `json:"kind,omitempty"`
+}
+
+// ExternalSecretCreationPolicy defines rules on how to create the resulting Secret
+type ExternalSecretCreationPolicy string
+
+const (
+	// Owner creates the Secret and sets the ExternalSecret as its owner
+	Owner ExternalSecretCreationPolicy = "Owner"
+)
+
+// ExternalSecretTarget defines the Kubernetes Secret to be created
+type ExternalSecretTarget struct {
+	// Name defines the name of the Secret resource to be managed
+	Name string `json:"name,omitempty"`
+	// CreationPolicy defines rules on how to create the resulting Secret
+	CreationPolicy ExternalSecretCreationPolicy `json:"creationPolicy,omitempty"`
+}
+
+// ExternalSecretDataRemoteRef defines the remote secret data to fetch
+type ExternalSecretDataRemoteRef struct {
+	// Key is the key (path) of the secret in the provider
+	Key string `json:"key"`
+	// Property is the property of the secret to fetch
+	Property string `json:"property,omitempty"`
+}
+
+// ExternalSecretData defines the connection between the Kubernetes Secret key
+// and the provider data
+type ExternalSecretData struct {
+	// SecretKey is the key of the Kubernetes Secret
+	SecretKey string `json:"secretKey"`
+	// RemoteRef points to the remote secret data
+	RemoteRef ExternalSecretDataRemoteRef `json:"remoteRef"`
+}
+
+// ExternalSecretSpec defines the desired state of ExternalSecret
+type ExternalSecretSpec struct {
+	SecretStoreRef SecretStoreRef       `json:"secretStoreRef"`
+	Target         ExternalSecretTarget `json:"target,omitempty"`
+	// RefreshInterval is the amount of time before the values are read again from the SecretStore
+	RefreshInterval *metav1.Duration     `json:"refreshInterval,omitempty"`
+	Data            []ExternalSecretData `json:"data,omitempty"`
+}
+
+// ExternalSecretStatus defines the observed state of ExternalSecret
+type ExternalSecretStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ExternalSecret is the Schema for the externalsecrets API
+type ExternalSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalSecretSpec   `json:"spec,omitempty"`
+	Status ExternalSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalSecretList contains a list of ExternalSecret
+type ExternalSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ExternalSecret{}, &ExternalSecretList{})
+}
@@ -0,0 +1,100 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Only the subset of the ScaledObject API used by the operator is defined here
+
+// ScaleTarget holds the reference to the scale target Object
+type ScaleTarget struct {
+	Name       string `json:"name"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	// EnvSourceContainerName is the container whose environment is used
+	// by the triggers that read values from env vars
+	EnvSourceContainerName string `json:"envSourceContainerName,omitempty"`
+}
+
+// HorizontalPodAutoscalerConfig specifies horizontal scale config
+type HorizontalPodAutoscalerConfig struct {
+	Behavior *autoscalingv2beta2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// AdvancedConfig specifies advance scaling options
+type AdvancedConfig struct {
+	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig `json:"horizontalPodAutoscalerConfig,omitempty"`
+}
+
+// ScaledObjectAuthRef points to the TriggerAuthentication object that
+// is used to authenticate the scaler with the environment
+type ScaledObjectAuthRef struct {
+	Name string `json:"name"`
+}
+
+// ScaleTriggers reference the scaler that will be used
+type ScaleTriggers struct {
+	Type              string               `json:"type"`
+	Metadata          map[string]string    `json:"metadata"`
+	AuthenticationRef *ScaledObjectAuthRef `json:"authenticationRef,omitempty"`
+}
+
+// ScaledObjectSpec is the spec for a ScaledObject resource
+type ScaledObjectSpec struct {
+	ScaleTargetRef  *ScaleTarget    `json:"scaleTargetRef"`
+	PollingInterval *int32          `json:"pollingInterval,omitempty"`
+	CooldownPeriod  *int32          `json:"cooldownPeriod,omitempty"`
+	MinReplicaCount *int32          `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount *int32          `json:"maxReplicaCount,omitempty"`
+	Advanced        *AdvancedConfig `json:"advanced,omitempty"`
+	Triggers        []ScaleTriggers `json:"triggers"`
+}
+
+// ScaledObjectStatus is the status for a ScaledObject resource
+type ScaledObjectStatus struct {
+	// +optional
+	OriginalReplicaCount *int32 `json:"originalReplicaCount,omitempty"`
+	// +optional
+	LastActiveTime *metav1.Time `json:"lastActiveTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ScaledObject is a specification for a ScaledObject resource
+type ScaledObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScaledObjectSpec   `json:"spec"`
+	Status ScaledObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScaledObjectList is a list of ScaledObject resources
+type ScaledObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ScaledObject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScaledObject{}, &ScaledObjectList{})
+}
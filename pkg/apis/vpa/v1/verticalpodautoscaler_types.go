@@ -0,0 +1,146 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Only the subset of the VerticalPodAutoscaler API used by the operator is defined here
+
+// UpdateMode controls when autoscaler applies changes to the pod resources
+// +kubebuilder:validation:Enum=Off;Initial;Recreate;Auto
+type UpdateMode string
+
+const (
+	// UpdateModeOff means that autoscaler never changes Pod resources.
+	// The recommender still sets the recommended resources in the
+	// VerticalPodAutoscaler object.
+	UpdateModeOff UpdateMode = "Off"
+	// UpdateModeInitial means that autoscaler only assigns resources on pod
+	// creation and does not change them during the lifetime of the pod
+	UpdateModeInitial UpdateMode = "Initial"
+	// UpdateModeRecreate means that autoscaler assigns resources on pod
+	// creation and additionally can update them during the lifetime of the
+	// pod by deleting and recreating the pod
+	UpdateModeRecreate UpdateMode = "Recreate"
+	// UpdateModeAuto means that autoscaler assigns resources on pod creation
+	// and additionally can update them during the lifetime of the pod,
+	// using any available update method
+	UpdateModeAuto UpdateMode = "Auto"
+)
+
+// PodUpdatePolicy describes the rules on how changes are applied to the pods
+type PodUpdatePolicy struct {
+	// +optional
+	UpdateMode *UpdateMode `json:"updateMode,omitempty"`
+}
+
+// ContainerResourcePolicy controls how autoscaler computes the recommended
+// resources for a specific container
+type ContainerResourcePolicy struct {
+	// Name of the container or DefaultContainerResourcePolicy, in which
+	// case the policy is used by the containers that don't have their own
+	// policy specified
+	ContainerName string `json:"containerName,omitempty"`
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+	// +optional
+	ControlledResources *[]corev1.ResourceName `json:"controlledResources,omitempty"`
+}
+
+// PodResourcePolicy controls how autoscaler computes the recommended resources
+// for containers belonging to the pod
+type PodResourcePolicy struct {
+	// +optional
+	ContainerPolicies []ContainerResourcePolicy `json:"containerPolicies,omitempty"`
+}
+
+// VerticalPodAutoscalerSpec is the specification of the behavior of the autoscaler
+type VerticalPodAutoscalerSpec struct {
+	TargetRef *autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	// +optional
+	UpdatePolicy *PodUpdatePolicy `json:"updatePolicy,omitempty"`
+	// +optional
+	ResourcePolicy *PodResourcePolicy `json:"resourcePolicy,omitempty"`
+}
+
+// RecommendedContainerResources is the recommendation of resources computed by
+// autoscaler for a specific container
+type RecommendedContainerResources struct {
+	// Name of the container
+	ContainerName string `json:"containerName,omitempty"`
+	// Recommended amount of resources, capped by the container policy
+	Target corev1.ResourceList `json:"target"`
+	// Minimum recommended amount of resources
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// Maximum recommended amount of resources
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+	// The most recent recommended resources target computed by the
+	// autoscaler, not taking into account the container policy
+	// +optional
+	UncappedTarget corev1.ResourceList `json:"uncappedTarget,omitempty"`
+}
+
+// RecommendedPodResources is the recommendation of resources computed by
+// autoscaler
+type RecommendedPodResources struct {
+	// Resources recommended by the autoscaler for each container
+	// +optional
+	ContainerRecommendations []RecommendedContainerResources `json:"containerRecommendations,omitempty"`
+}
+
+// VerticalPodAutoscalerStatus describes the runtime state of the autoscaler
+type VerticalPodAutoscalerStatus struct {
+	// The most recently computed amount of resources recommended by the
+	// autoscaler for the controlled pods
+	// +optional
+	Recommendation *RecommendedPodResources `json:"recommendation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vpa
+
+// VerticalPodAutoscaler is the configuration for a vertical pod autoscaler,
+// which automatically manages pod resources based on historical and real
+// time resource utilization
+type VerticalPodAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerticalPodAutoscalerSpec   `json:"spec"`
+	Status VerticalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscalerList is a list of VerticalPodAutoscaler objects
+type VerticalPodAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []VerticalPodAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerticalPodAutoscaler{}, &VerticalPodAutoscalerList{})
+}
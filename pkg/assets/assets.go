@@ -6,7 +6,7 @@ import (
 )
 
 // Important: Run "make assets" to regenerate code after modifying/adding/removing any asset
-//go:generate go-bindata -o bindata.go -pkg $GOPACKAGE dashboards
+//go:generate go-bindata -o bindata.go -pkg $GOPACKAGE dashboards rules
 
 // SafeStringAsset Returns asset data as string
 // panic if not found or any err is detected
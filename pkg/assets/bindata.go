@@ -6,8 +6,13 @@
 // dashboards/backend.json.tpl
 // dashboards/cors-proxy.json.tpl
 // dashboards/mapping-service.json.tpl
+// dashboards/saas-operator.json.tpl
 // dashboards/system.json.tpl
 // dashboards/zync.json.tpl
+// rules/apicast.yaml.tpl
+// rules/backend.yaml.tpl
+// rules/system.yaml.tpl
+// rules/zync.yaml.tpl
 package assets
 
 import (
@@ -124,7 +129,7 @@ func dashboardsApicastJsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dashboardsAutosslJsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x7b\x6f\xdb\xb6\x16\xc0\xff\xdf\xa7\xe0\x55\x77\xd7\x74\x70\x5a\x3f\xf3\x02\x8a\x8b\x3e\xd6\xbb\x01\x49\x6f\x6e\x1f\x03\x7a\x8b\xc2\xa3\xa5\x13\x9b\x88\x44\x6a\x24\x95\x38\x2b\xb2\xcf\x7e\x21\x4a\x96\x65\x89\x94\xad\xc4\x76\x6c\x87\xf9\x63\xab\xf9\x90\xc4\x73\x0e\x0f\x7f\xa4\x0e\xa9\xef\x3f\x20\x84\x90\x83\x29\x65\x12\x4b\xc2\xa8\x70\x4e\x50\x92\xa8\x32\x7c\x22\xa4\x73\x82\xbe\x66\x29\xf1\xdf\xf7\x99\x5f\xaa\xdc\x20\x22\xbe\xfc\x8d\x3a\x27\xa8\xd5\x28\xe7\x7a\x58\x62\xc1\x22\xee\x82\x73\x82\x9c\xfd\x7d\xf4\x6f\x8e\x2f\x30\xc5\x68\x7f\xdf\xd1\x14\x07\x8a\x07\x7e\x5c\x54\xf2\x08\x34\xf9\x23\xe2\x55\xe4\x12\x97\xd1\x37\xcc\x67\x3c\xbe\x17\x1f\x0e\xf0\x5e\xb3\x81\xda\xad\x56\x03\xb5\x7b\xbd\x06\x6a\x3d\xd3\xdd\x92\xe2\x40\x3d\xdb\xab\xa9\x20\xd0\x4f\xe8\x95\x0f\x5c\x0a\x5d\x79\x79\x13\xaa\xf2\x1e\x16\xa3\x01\xc3\xdc\x73\x66\xca\xdc\x66\xbf\xbe\xa9\x7f\xdd\x26\x97\x70\xc0\x23\xb2\xd4\x36\x67\x48\x41\xfe\xe6\x39\x27\x88\x46\xbe\x3f\x49\xe3\x38\x1c\x7d\x62\xcc\x97\x24\x74\x4e\x50\x33\x4d\x26\xde\x54\xc4\x8e\x4f\xe8\x65\xac\xaf\xaf\xdf\xd2\x84\x10\x53\xf0\xc5\x8c\xbe\x66\x75\xe5\xb8\xcc\xf7\x71\x28\x20\xbe\xcc\x05\xf6\x45\x41\x80\xce\x90\x13\xef\x9c\xcd\x1a\xc1\x54\xee\x06\xf5\x5e\x3b\x27\xa8\xdd\xd5\x64\x8c\xa7\x4f\x3e\x93\x7e\x13\xa7\xcf\x0a\xac\xf0\x1c\xaa\x9d\xed\x42\xdd\x5c\xfb\xbe\x15\x72\x24\x91\x7e\xa2\xc0\x30\xf4\x89\xab\x34\xe8\x14\xcb\xa4\x3a\xe3\xec\x7a\xaa\xad\xdc\x8d\x0b\xa2\xc2\x3e\xc1\x42\x19\x92\x12\x47\xf1\x09\x07\x58\xa5\xeb\x84\x18\x1b\xc5\x29\xd0\xa1\x54\x02\x2b\x36\x22\xce\x05\x73\xd5\x7c\x4f\xf9\x31\xf7\xb3\x50\xf0\x82\xf8\x7e\x59\x1d\xf3\xf5\x77\x64\xd0\x5f\xab\x5d\x53\x7f\xad\x05\xf4\x57\xb8\xa6\xe3\xc3\x10\xa8\xa7\x7f\x38\x7c\x35\xd4\xcb\x44\xe5\xba\x11\xe7\x40\x65\x45\x89\x00\x8f\xab\x72\x09\xad\xc8\x15\x23\x76\x6d\x76\x28\x92\x49\xec\x57\xd4\xbe\xc2\x7e\x34\x55\x68\xa5\x58\x7c\x42\x55\xc9\xf2\x9d\x54\xd6\x35\xf1\xa4\xa6\x93\x95\x3a\x7a\x96\x11\x3b\x8c\x73\x46\xa8\x3c\x63\xca\x25\xaa\x84\xa2\xa9\xb0\x30\x73\xec\xc5\xe7\x09\x81\xbb\x40\x25\x1e\x82\xc1\x1e\xc3\xf8\xe2\x1c\x7b\x24\x8a\xeb\x17\xf5\xa9\x72\x4d\xa6\xcc\x81\x7a\xc0\x41\xb9\xe1\x0b\x9f\xc9\xe2\x63\x09\xe0\x04\xc4\x7f\xae\x80\x73\xe2\x81\x28\x8d\x32\x48\x3b\xd2\xa0\xac\x6b\xc6\xd7\x05\xce\x19\xd7\xf8\x67\x94\xfa\xba\x64\x10\x78\xf2\xa6\xdb\x3a\x68\xbf\x72\x4a\xc5\x6e\x67\x52\x8a\xc2\x15\x21\x76\xc1\xdc\x8f\x85\xc4\xee\xa5\xa1\xed\x42\x42\x18\x82\x77\x4a\xa8\x49\xb0\x12\xf3\x21\xc8\x5a\xad\x86\x71\xa8\x9a\x23\xa2\x60\x8f\x50\x97\x03\x16\xb0\x87\x23\xc9\x84\xf0\xfb\x1e\x0b\x30\xa1\xa2\x8f\x7d\x9f\x5d\x83\xf7\x3d\x1e\xd0\x54\x03\x5e\x3e\xfd\x31\xfb\xf7\xd3\x06\x0a\x99\xf7\xf2\xef\xa7\x69\xad\xe7\x3f\x3f\xbd\xfd\xda\xee\x8e\xbe\x3d\x7b\x86\x06\x37\x68\x4f\x48\x2c\x23\xa1\x1b\x20\xd5\x03\x5c\x30\x1e\xe0\xb8\x0f\x3a\x92\x04\xd0\x4f\x14\x68\x2a\x4c\xa8\x04\x7e\x85\xfd\x77\xd8\x95\x4a\x0d\x9a\x91\x03\x4d\x9d\xc2\xbb\xec\xda\xdf\xbf\xff\xf1\xfd\x7b\xfc\x24\x70\x7b\xfb\xc7\xed\xad\xe9\xfa\x1c\x2e\xd4\x98\xe9\xd4\xd6\xab\x1c\x71\x10\x23\xe6\x7b\x86\x91\x24\x80\x77\x9c\x05\x33\xc3\xf1\x4c\xee\x07\x18\xa6\xfd\x49\x5b\xf9\xe3\x88\x5c\x48\x53\xed\x74\x94\x7a\xab\xb4\x85\xae\xb0\x4f\xbc\x94\x36\xf6\x7c\x2c\x24\x6a\x77\x47\x45\xf1\x3b\x32\xa3\x00\x8d\xdb\x14\x23\xcc\xd5\x68\x6e\x70\x5e\x82\x71\x69\xf0\xe2\xca\x6f\xf5\x27\xa3\x22\xa1\x1e\xb9\x22\x5e\x84\x7d\xa7\xd2\x85\x4d\xca\x2b\x40\x29\x3e\xea\x18\x8f\x89\x61\xf0\x19\x44\xee\x65\x62\xf0\x65\xc1\xa8\x02\x41\xea\xc2\x62\x19\x56\x20\x9a\xa1\x76\xb5\x0b\xcf\x5c\xf4\xd7\x6f\x95\x8d\xbb\xc1\xe3\x7a\x9e\x68\xda\x25\xc4\x28\x96\xb4\xc9\xc6\xf1\x00\x7c\xe3\xc3\x27\x45\xd8\xf0\x35\x16\x50\xd1\x51\x92\x11\xae\xe2\x12\xc9\x20\x57\x51\x20\x27\xa4\x72\x9f\x29\xd7\xd9\xfd\x36\x57\xfa\x89\x1b\xb3\x31\x63\x9f\x0c\xab\x80\x42\xe5\x9f\xc2\x55\x26\x00\xc3\xec\xc0\xd2\xe7\x1c\xfa\xd4\x66\x2c\x8a\x9f\x5d\x8b\x9f\x16\x3f\x37\x19\x3f\x17\x76\xb9\xd9\x1d\xd9\xe5\xfc\xdb\x75\x0e\x8f\x0e\xdb\x6f\x77\x9a\x76\x7d\x90\x02\xa8\xcb\x6f\x42\xd9\xe7\xf0\x67\x04\x42\x0a\x8b\xbc\xd3\xbf\x0d\x44\xde\x53\x90\x4f\x05\x4a\x95\x86\x88\x10\x11\x78\xc8\x05\x2e\xc9\x05\x71\xb1\x04\x4b\xc0\x96\x80\x2d\x01\xaf\xbd\xcd\x96\x80\x0b\x99\x9b\x46\xc0\xa6\xf5\xd7\xe3\x05\x00\xf8\xc0\x02\xb0\x05\xe0\x1a\x00\xbc\x4d\x4c\x48\x87\x84\x8e\xfb\x23\x29\xc3\xbe\xcb\x28\x05\x57\x09\x79\x71\x08\x9c\xe2\x1f\x58\xfa\x5b\x39\xfd\xbd\x4a\x04\x8f\xde\x4c\x55\x65\x21\xcf\x42\x9e\xae\x84\x85\xbc\xa5\xb7\xd9\x42\x5e\x21\x73\xd3\x20\xcf\xb8\xcc\xb9\x08\xe5\x1d\x59\xca\xb3\x94\xb7\xf2\x65\x4e\x23\xf7\xec\xf2\x6b\xf6\x04\x32\x03\x90\x9c\xb8\xfd\x44\x0c\x7d\x65\xa8\x35\x38\xf3\x61\xe1\xb2\x5a\x77\x5b\xcd\x94\xe7\x9c\x05\x20\x47\x10\x09\x74\xa6\x34\x24\xd0\x2f\xba\xd6\x5a\xb2\x4c\xf2\x2d\x59\x5a\xb2\x5c\x76\x9b\x37\x97\x2c\x37\x27\xd2\xb5\x75\x38\x1f\xe2\x5a\x9d\xe2\xa0\x3f\x3f\xd4\xf5\x9c\x79\x65\x57\x57\x2f\xc6\xd5\xc5\xee\x08\x3e\x91\x00\x58\x64\xf0\xb6\x6a\x74\x7f\x8d\xdd\xcb\x21\x67\x11\xd5\xfb\xc9\xa4\xd0\xef\xb1\x8b\x30\x08\xdb\x9d\x10\x7e\xd9\x2f\x38\x4f\xde\xb5\xbb\xc7\xbd\x37\x3a\x8f\xa5\xa2\xb7\xdb\x9d\xc3\x06\x6a\xb5\x8f\x1b\xa8\xdb\x6c\xa0\xe6\xf3\xa3\x63\x6d\x04\xf7\x93\xf6\xf1\xb1\xdb\x3d\x70\x2a\x2d\x72\x61\xf2\xf7\xc0\x25\x01\x56\x0a\x28\x02\xc9\xb4\xf7\x52\x46\x4b\x15\x87\x38\x52\x98\xa7\x31\xaa\x00\x8f\x27\x32\x6a\x35\x75\xc6\x12\x10\x3a\x29\xa0\xcb\x4e\x3b\xa2\xa9\xcb\x64\x23\xf2\x69\xec\x37\x0c\xbc\x38\x5b\xf2\x0c\xf3\x4b\xe0\x42\xd7\xbb\x8b\x56\x3a\xb7\xbb\x74\x0c\xdd\xe5\xa0\x6e\x6f\x39\xaa\x7c\x8e\x11\xf1\x94\xc1\x4e\x88\x56\x6f\x90\xaa\x4f\x75\x8a\x9a\x9b\x40\x54\xac\xbb\xa2\xde\x8c\xf0\x1f\xe0\x30\x24\x74\xf8\x29\xe9\x58\xc5\x39\x43\x2e\xb7\xd6\xb0\x37\xd9\x6f\xa0\xc6\x55\x24\x19\x92\x30\x36\x0e\x04\x57\x13\xab\xb9\xc7\x98\x33\xb9\x21\xc7\x74\xb8\xf0\x0d\xdb\x35\x1d\x7e\x80\xc7\x6f\xb1\xc4\xe7\x93\x09\x4b\xc9\xcc\xcb\x53\xa9\x74\xed\x16\xbc\xa2\x42\xe2\x92\x9f\xe2\x67\xd4\xba\xa5\xaa\xd9\x96\x1f\x0d\x09\xfd\x1d\xb8\x20\x2c\x1e\x62\x9c\x83\xe7\xed\xe7\xdd\xe2\xe5\x43\x26\xe4\x05\x19\xeb\x4c\x21\xcd\x7a\xc7\xa8\xfc\x48\xfe\x52\x4f\xd9\x6b\xfe\xb3\x54\x8a\x83\xa9\xbe\xca\x99\x53\x5d\xe9\xe1\x0c\x87\xf5\x60\x29\x41\x6a\xdd\xfc\x33\x2b\x23\x13\x99\x39\xef\x5f\xbc\x32\x16\x61\xd9\x45\xea\xcf\xd5\xf8\xa5\x9f\x4c\xb7\x34\x7e\xe0\x82\xf8\xfe\xec\xd6\x9b\x4e\xab\x81\x5a\xad\xa3\x06\x6a\x1d\x1d\xc7\xce\xbb\x75\xa4\x75\xde\x17\x91\x5f\x35\xf7\x8f\xef\x98\xbf\x6e\x72\xd9\x76\xb3\x81\x5a\xc7\x1d\xed\x05\xf3\xbe\xb2\x9a\xc6\xf1\xc0\x8f\xaf\x1d\x05\x54\xa7\xcb\x7b\xcc\x1f\x2f\xa3\x01\xf4\x3d\x08\x7d\x76\x13\x00\x95\xfd\x24\xd2\xa4\xcf\x41\x6d\x50\x11\x7d\x7c\x85\x89\x1f\xdf\xdd\x34\x97\x9c\xd6\x9d\x9d\x51\xae\x77\x42\xb9\xa4\x29\xa2\xd3\x6a\xb4\x4b\xc2\x9d\xce\x12\x9d\x4e\xb3\xcc\x32\xb9\x79\xa0\x3e\x3f\x05\xa1\x0f\x11\xa5\x84\x0e\xe3\xb9\xb7\x11\x88\x04\xa1\x43\x1f\x62\x1d\x14\x4b\x28\x67\x97\xef\xad\x47\xe5\xde\xaa\xca\xd4\xed\xad\x2c\x9e\x71\x3a\x2f\xe7\x75\xd4\xe6\x3c\x2f\x7c\xa7\x9e\xaa\x2a\xbf\x4f\x9d\x3e\xbe\x1a\xae\x9b\x06\xcf\x27\xee\xd1\x88\x83\x77\xe6\xc5\x14\xf4\xee\xcb\x8b\x5e\xb7\x8b\x3b\xd8\xf2\xe2\x96\xf0\xa2\x2e\x7d\xc9\xbc\x58\x5c\x78\xcd\xf1\xa2\xa6\x2f\x58\x62\xd4\xdc\x70\x3b\x89\xd1\xb2\xa0\x65\xc1\x07\x65\xc1\x88\x5a\x1a\x5c\x16\x0d\x7e\x9e\xca\xd2\x12\x61\xb1\xf2\x03\x12\xa1\x5d\x1f\xb4\xbc\x57\x48\xaf\xe2\x3d\xf3\xce\xaf\xe5\x01\xdf\xa1\x5d\x20\x2c\xdd\xf0\x11\xe0\x9e\x5d\x20\xb4\x50\xb8\x01\x50\xe8\xb2\x88\xca\xbd\xc9\x7f\xa9\xc4\x84\x02\xef\x07\x10\x30\x7e\xd3\xbf\x66\xfc\x92\xd0\x61\x5f\x80\xec\x0f\x6e\x24\x18\x23\x9b\x0d\x81\xcd\x94\x79\xf0\x6c\xcd\xb1\x27\x5b\x00\x87\xe7\xcc\x13\xc8\x23\x42\x72\x32\x88\x24\x78\x88\x51\x34\x62\xa2\x74\xc8\x93\xa5\xc4\x6d\xa3\x44\xbb\x2a\x38\x29\xf0\xa8\x28\x51\x17\x6a\xbb\x64\x4a\x2c\xee\xa2\xb2\xcb\x82\x8f\x84\x13\x2d\x01\x5a\x02\x5c\x29\x01\x06\x78\xbc\x27\xa2\x60\xcf\x03\x5f\xe2\x3d\xb5\x48\x18\x32\xaf\x3f\x85\xc1\x6c\x9d\x50\x48\xcc\xe5\x9c\xd8\xe3\xf2\x39\x07\xbd\x60\x72\xcc\x41\xc8\xbc\x75\xd3\x60\x31\x12\x79\xc5\x31\xc8\xab\xa4\xc6\x33\x3c\x56\x4b\x89\x68\xa2\x88\xf4\xac\x82\x1e\x0a\x08\x8d\x24\x94\xce\x8f\xb0\xf8\x58\x03\x1f\x77\x73\x0f\xd0\xa1\x81\x64\x6a\x87\x8f\xb6\x17\x38\xea\xa8\x55\xda\xcb\xb2\xc2\x3d\x40\x31\x3b\xfd\x12\x84\xf2\xa6\xfa\x84\xde\xff\x01\x67\xe6\x12\x76\x23\xd1\xa4\x8b\x21\x2c\xd0\x5f\xb1\xac\x6a\x90\x49\xbd\x0d\x45\x3d\x5d\xee\x23\xdc\x36\x3e\xe7\x2d\xdc\x66\xbc\x79\xd3\x2c\x7b\x23\xdd\x70\xaa\xcc\x7c\x5f\xf3\x82\xab\x50\xe3\x94\xd0\xcb\xea\x00\xff\xdc\x00\x6c\x28\x11\x6b\x45\x69\xf2\x1e\xf3\x86\x1d\x0b\x8b\x2b\xaa\x43\x3d\x76\xa5\x3a\x32\x31\xbf\xbe\xcf\x49\x60\x3b\xf7\x46\xb9\x28\xc8\xf4\xc1\x17\x13\xe5\x9b\x65\x88\x72\xe7\xd6\x61\x4b\x22\x15\xe0\xed\xeb\x56\x39\xcb\x02\xad\x7d\x6c\xdc\x26\xec\x03\x64\x1e\x52\xda\x43\x7b\xca\x29\x36\x90\xb2\xa0\x06\x8a\x68\xfc\xff\x67\x08\x53\x2f\xc1\x77\x25\x83\xe9\xf2\xaf\xee\x8c\xf8\x25\x6c\x15\xd4\xbd\xb8\xb4\x5b\x05\xef\xbf\x55\xd0\xbc\x9a\x9a\x15\xd9\xc0\x9d\x75\xa6\x47\x7d\xc4\x5b\x09\xcb\xe0\x8d\x36\x7b\x2f\xe1\x6e\xce\x50\x75\x6b\xea\x77\x9b\xa1\x56\xaf\xb5\xab\x19\xea\xb1\x9d\xa0\x4e\x72\xb7\x6d\x82\xba\xba\x89\xa9\x3d\xcf\x6c\x7a\xd4\xc4\x7a\xd6\x80\x1f\x16\x44\xd5\x49\x67\x21\xf3\x76\xf7\x9c\xb3\xf3\x7a\xab\xc4\xf6\x60\x8a\x8d\xa5\xcd\xad\x24\xab\xc7\x48\x93\xf6\x60\x8a\xbb\x72\x5b\xa7\x3b\x9f\xdb\x8a\xdf\x50\x30\x9f\x4b\xc1\x21\x04\x3c\xcf\x3f\xbe\x39\xff\x8c\x3e\x8b\x98\x0f\x0c\xce\xe7\x51\x7f\x9c\x6d\x69\xef\x8c\x3a\xbd\xf9\x9a\x2d\x36\xdd\x1e\x1b\x67\xdf\xf6\xec\x1a\x54\x53\xe6\x41\x3f\xc3\xe3\x59\xb0\x3e\x99\x22\xb6\x1b\x46\xfd\x28\xf6\x4a\x7d\x01\x2e\xa3\x5e\x0a\xd9\x27\x22\x0a\xfa\x1c\xcb\xfc\x0a\xfa\xdf\x0b\x1d\x2b\xbc\x3a\xd4\x5e\xf4\xf5\xd0\x42\xa8\xbd\x96\x37\x44\x9b\x8e\xec\xe6\x21\xc9\xae\x05\x5b\x3a\xb7\x0b\xbd\xc6\x06\xef\xe0\x42\xef\xe6\xb0\x79\xb7\x3d\x9f\xe0\x4a\x23\xfd\xfd\xd9\xfc\xbf\x11\x93\xf8\xc1\xd8\xdc\x55\x41\x96\xda\x16\x3c\x20\xb6\x5f\xe4\x82\xf4\x5a\xcd\x72\x94\xde\xfa\xb0\xbe\xdb\x99\x6f\x14\xeb\x8c\x04\xb3\x58\x7f\x1f\xac\xc7\x43\x48\xcd\x4a\xd3\x2b\x1f\x14\xfa\x5d\xce\x54\x4f\xd0\x88\x6c\x91\x09\xc1\x88\x5d\xff\x0a\xd8\x53\x37\xd0\x5d\x22\x41\x23\x8d\x3d\xba\x4c\xd3\xff\x54\x8e\x07\xc2\x5d\x48\xdb\x2b\x9d\x8d\x08\x79\xe3\xdf\xf1\x0c\xed\x4f\x7a\xba\x42\xa9\x83\x82\x29\xb9\x7f\xf9\xf2\xe5\xcb\xfe\xd9\xd9\xfe\xdb\xb7\xe8\xd7\x5f\x4f\x82\xe0\x44\x18\x67\x07\x21\x96\x12\x38\x9d\x77\xfd\x89\xff\x1e\x11\xcf\x03\xba\x94\xef\x10\x9a\xa0\x39\x2b\xa9\xf6\x6a\xa5\x3d\xa4\x02\x14\xa6\x5b\xba\xbe\x2d\x5b\x38\xb9\x70\x01\xe3\xa4\x29\x99\xf9\x18\x9c\x4c\x56\xe4\x53\x36\x11\x70\xde\x72\xe2\xfb\xc8\x63\xd7\xc5\xc8\x91\x99\x0a\x9f\xb9\x6e\x0b\x79\x56\x24\xa7\x36\xb5\x53\x0b\x3d\x31\xef\xc3\xa8\x9a\x1f\x4d\x4b\xa5\x0a\xa6\x51\x30\x00\xe3\x07\x29\x23\x4a\x72\x00\xb9\x2c\x2b\xf8\x90\x7e\x7e\xd1\x1a\x82\xa6\x42\x6d\x43\x78\xbd\xfd\x86\x80\x8a\x80\x94\x15\xb7\xa6\x50\xc7\x14\x74\x27\x9e\xa0\x55\x99\x42\x8a\x1b\xea\xe7\xb2\x0c\xe2\x94\x04\xc4\xfa\x05\x6d\x85\xda\xc6\xf0\x76\x7b\xfd\x42\x62\x06\xd6\x2b\x68\x2b\xd4\x36\x84\x5f\xb6\xd9\x2b\x9c\xb3\xe2\xee\xdc\xac\xcc\x36\x59\x81\x7e\xce\x8a\xee\x6b\x04\x2f\xbc\x17\x07\xbd\xa3\x16\x74\x0f\xa0\x0b\x3d\xf7\x70\x80\xbb\x4d\xdc\x3c\x3c\xe8\x1e\x74\x8e\x7b\x70\x71\x38\xe8\xf5\x5e\x74\x84\x8b\x7d\xd8\x77\x59\x10\x46\x12\xf6\x39\x24\x2b\x19\x62\x3f\x64\xde\xbf\xae\x30\xdf\x9f\xbe\xa9\x98\xbe\xa7\xf8\x29\xce\x08\x99\xf7\xf2\xc7\x7e\xdf\x05\xf3\xbe\xe0\x9c\xb5\x85\x66\x4d\x6d\xb6\xc7\xd9\x6a\xfb\xca\x29\xe0\xc5\xf3\x9f\x5f\x2c\x47\x03\x42\x72\x42\x87\x77\xd5\xc0\x4c\x4a\xe9\x15\xcb\x4e\xbf\x9d\xc3\x03\xdf\x38\xbd\x25\x54\x48\xac\xd6\xc6\x2a\x9c\xc1\x9d\x5f\xde\x2d\x10\x1a\x67\x28\xb1\xcc\x9d\x5a\xb1\x8a\x34\xf1\x88\x13\x9f\x93\x7d\x78\x5f\x29\xc8\x65\x7c\x66\x6f\xcc\x63\xd1\x84\x71\xc2\xb6\x6c\x4d\x3c\x64\x67\x41\x2f\x90\x35\x86\x05\x8c\xc1\x38\x65\x5b\x67\xb7\xf4\x15\x6f\x3f\x6a\x3d\x18\x67\x4b\x8f\xac\x53\x5a\x53\x70\x8c\xf3\xa5\x87\x8a\x58\x59\x34\x24\x45\xff\x26\x76\xb3\x02\xc6\x39\xa6\x22\x36\x03\x93\x11\x64\x0c\xaa\xcd\xb4\x41\x2b\x06\x1b\xb7\x41\x2b\xab\x6b\xf0\x92\x82\x56\xb2\x5f\xdb\x10\x4b\xd2\x6b\x56\xda\xb1\x0a\x1b\x28\x1e\x72\x77\xcf\x58\x92\x33\xb5\x7b\xde\x86\x7a\xaf\x3e\x26\xa4\xb7\xc0\xf1\x40\xc5\x7d\x76\x36\x26\x64\x53\x63\x42\x6c\xa8\xf7\x5d\x17\x93\x6a\x9d\xdc\x31\x87\x7e\x1b\x28\xbb\xda\x3f\x5e\x3e\xb5\x41\xdd\x6b\x46\xe4\x25\x04\x75\x57\x8e\x3f\x36\xae\x7b\x0b\x10\x59\x75\xd9\x4d\x20\x46\x8b\xc8\xa5\x36\xdb\xb8\xee\xbb\xe3\xda\x02\x67\x65\x14\x3f\x4b\xb2\x1c\x16\xb7\xa1\xdd\x9b\x1c\xda\xdd\x3b\x9e\x6f\x17\xc5\x6f\xc4\x5b\x8c\xdf\x54\x8c\xb7\xa1\xdd\x85\xbf\xf9\xa1\xdd\x73\xbf\x1d\x60\x23\xbb\xcb\xc5\x56\x10\xd9\x5d\x41\xce\x59\xe1\xad\x08\xa6\xd8\x98\x90\xad\xb5\x06\x77\x7b\xe0\x26\xe4\xbc\x44\x5b\xb0\x21\xde\xcb\x34\x87\xb5\x86\x78\xaf\xd2\x1c\x6c\x48\xa7\xb6\xc2\xe3\x0a\xf4\x4e\x6d\xc2\xc6\x7a\x1b\x2b\x6c\x76\xac\xf7\x2a\x1c\x84\x8d\xf8\x5e\xa6\x39\xd8\x88\xef\x87\xb7\x02\x1b\xf1\x6d\x23\xbe\x6d\xc4\xf7\x0e\xbd\xa4\xdb\xe0\x88\xb5\xcd\x8a\xed\x4e\xf5\x54\x53\x37\x3b\xa3\x8c\xb5\x85\x77\xaf\xa7\x67\xd4\x09\xe4\x7e\xe4\x9a\xdf\xa4\x58\xee\x47\xae\x8a\xb5\x85\x73\x6f\x4c\x27\xb4\x7a\xdf\xda\xd8\xed\xca\xb7\xad\x36\x7c\x3b\x29\xb0\xe5\xb1\x29\x1b\x13\xaa\x61\x63\x53\x4a\x6d\xde\xbd\xf0\xed\x83\x83\x4a\x3b\x4e\x4e\x7d\x5b\xfc\x2c\xc0\xcc\x53\xbd\x07\x19\x8f\x6f\x36\x48\x7b\xf5\x5f\xc8\x39\x38\x5c\x40\x85\xc5\xb0\x1f\x1b\xde\x61\xbf\x6e\x33\x15\xfa\x76\x47\x67\x13\x8e\x25\xe4\xf8\x9a\x26\xbe\xa7\xcf\xc1\x05\x72\x05\x09\xe2\x96\x3e\x6d\x33\x0f\x74\xd7\xf2\x71\x9b\xa5\x06\x67\x6f\xf5\xc7\x6d\x3e\x24\xca\x42\xaf\x31\xf5\x92\xce\xb7\x02\xbe\xb5\x91\xd5\x2b\xff\x7a\x62\x05\x44\x4d\xbb\xca\xeb\xd0\x46\x5f\x3f\x44\x83\x77\x30\xfa\xda\xc2\x61\x25\x1c\x1e\x2e\x72\xaa\xf3\x91\x85\x43\x0b\x87\x8f\x0c\x0e\xd5\x02\x55\x40\xa4\xa5\xc3\x6d\xa0\xc3\x4f\xa9\xb6\x2c\x1e\x6e\x23\x1e\x5a\xf0\x7b\xe8\x06\x6f\x2f\xf8\xfd\x90\xbb\x71\xec\xc3\x62\x57\x94\xec\xa8\x9a\x58\x92\x23\xdc\x11\x04\xf8\x77\xe0\x82\x30\x9a\xe3\x99\x64\xe3\x85\x0a\x44\xc4\xfc\x72\x52\x5a\xe2\xe1\xac\xf1\x3a\x49\x14\x54\x4e\x4d\x4e\xea\xe8\xf3\x49\xe2\x46\x48\x08\x9c\x99\xc7\x91\x10\x84\x3e\x96\x84\x0e\x67\x84\xe0\xf8\x44\xc8\x52\xff\xd0\x91\x1e\x51\x9d\x59\xc7\x6e\x84\xba\x7e\xe4\xc1\x2b\xbf\x8a\x68\xaa\x2d\xc8\x09\x22\x5f\x92\x8a\xea\xa9\xaf\x70\x8c\xf8\x8a\x66\xb1\x44\x17\x1b\xe4\xfc\x19\x01\xbf\x51\x31\x5e\x9c\x05\x20\x47\x10\xe9\x7a\x78\x4e\x73\xba\xa5\x6b\x0e\x43\x18\x1b\x5e\xdb\x39\xe2\x92\x84\x9f\xb9\xff\xf1\x86\xba\x15\x8d\x99\x78\xdc\x5c\x63\xaa\x9c\x98\xd6\x64\x7d\x15\x15\x69\x16\xe8\x94\x6e\x0d\x3d\x7f\x62\x5b\xc6\x20\x2a\x18\xa7\x03\x36\x7a\xfe\x7e\x82\x17\xc8\x3c\x66\x5f\xa5\xcf\x53\xae\xb0\x88\x7b\x5a\x9a\x7d\x39\x19\x0a\xe9\xf4\xb3\xa8\x99\x55\x5e\x24\x67\x65\x5a\x51\xe8\x05\x8e\x12\x40\xf5\xc1\x95\x15\xc3\x7b\x56\xb2\xa6\xf8\x51\x4d\x15\xa0\x92\xc3\x44\x65\xa7\x89\x66\x3a\xcd\x02\x4f\x52\xd3\xfe\xdd\x48\x48\x16\x38\x06\x77\x9a\x0c\xec\xb7\x13\xff\x45\x94\x66\x72\x9e\xeb\x22\x01\x39\x87\xb2\xeb\xfd\x56\x1e\x5c\x1c\xc9\xd2\x74\xa7\x74\x89\x90\xb8\x97\x6a\xfe\x90\xbb\x50\xda\xdf\xfb\x13\xa6\x2d\x6b\xd6\xe9\x15\x1d\x45\xce\xa7\x67\x49\x9d\x72\x52\x2b\x28\xa6\xf4\x4a\x29\xad\x72\x52\xa7\x59\x2e\x55\x42\xb3\x76\x29\xa5\xe5\x4d\x65\xf9\x2d\x2f\x8f\x98\xe7\x4d\x56\xbb\xd8\x13\x95\x6f\x7f\x50\xbe\x7d\xf9\x89\xda\xdd\x72\x52\x31\xbc\xd6\x39\x2c\xa5\x74\x9a\xf9\x96\x94\x94\xf8\x17\x53\x33\x30\x27\x1b\x26\x27\xe8\x9d\x8c\x8e\xe8\xa3\x1a\xff\xd0\xab\x48\xb2\x8f\x1f\x4f\x9d\x1f\x6e\xff\x1f\x00\x00\xff\xff\x88\x42\x1d\x7d\xde\xe7\x00\x00")
+var _dashboardsAutosslJsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6b\x6f\xdb\xb8\x9a\xfe\xee\x5f\xc1\xe5\xcc\x9e\xa6\x07\x4e\x6b\xcb\x97\x5c\x80\xc1\x22\x4d\xa6\x3b\x03\x24\xdd\xec\xb4\x1d\xa0\x5b\x04\x3e\x8c\xc4\xd8\x44\x24\x52\x87\xa4\x12\x67\x02\xcf\x6f\x5f\x50\x17\x5b\x17\x52\xb1\x1d\xdb\xb5\x13\x5a\xc5\x4c\x4b\x52\xb7\xf7\xc6\x87\x2f\x1f\x52\x8f\x0d\x00\x00\x80\x88\x52\x26\x91\x24\x8c\x0a\x78\x0c\x92\x42\x75\x40\x9f\x08\x09\x8f\xc1\xf7\x69\x89\xfa\x33\xab\xcf\x7e\xf0\x3a\x22\xbe\xfc\x9d\xc2\x63\xd0\x6e\x56\x6b\x3d\x24\x91\x60\x11\x77\x31\x3c\x06\x70\x7f\x1f\xfc\x37\x47\x37\x88\x22\xb0\xbf\x0f\x35\xcd\x31\x45\xd7\xbe\x6a\x2a\x79\x84\x35\xf5\x23\xe2\xd5\xd4\x12\x97\xd1\x53\xe6\x33\xae\xee\xc5\x87\xd7\x68\xaf\xd5\x04\x4e\xbb\xdd\x04\x4e\xaf\xd7\x04\xed\xb7\xba\x5b\x52\x14\xa8\x4b\xc2\x93\x99\x20\xc0\x3f\xc0\x89\x8f\xb9\x14\xba\xf6\xf2\x21\x8c\xdb\x7b\x48\x8c\xae\x19\xe2\x1e\x2c\xb4\x99\x4c\xff\x75\x15\xff\x6d\x92\x5c\x02\x62\x8f\xc8\xca\xbb\xc1\x21\xc5\xf2\x77\x0f\x1e\x03\x1a\xf9\x7e\x56\xc6\x51\x38\xfa\xc2\x98\x2f\x49\x08\x8f\x41\x2b\x2d\x26\xde\x4c\xc4\xd0\x27\xf4\x56\xe9\xeb\xfb\x55\x5a\x10\x22\x8a\x7d\x51\xd0\x57\x51\x57\xd0\x65\xbe\x8f\x42\x81\xd5\x65\x6e\x90\x2f\x4a\x02\x84\x43\x4e\xbc\x4b\x56\x34\x82\xec\x07\x47\x06\xf5\xde\xc3\x63\xe0\x74\x35\x15\xe3\xd9\x93\xe7\x7f\xf0\x41\x95\x17\x8a\x27\xcd\xc2\x3f\x93\xf7\x74\x4a\xe7\xe6\xde\xef\xaa\x54\x23\x89\x8c\xa5\x0a\x4f\xc2\xd0\x27\x6e\xac\xc1\x92\xde\xa6\x3a\xe3\xec\x1e\x36\x34\x37\x2e\x89\x0a\xf9\x04\x89\xd8\x90\x62\x71\x94\x9f\xf0\x1a\x71\x61\x10\xa2\x32\x8a\x73\x4c\x87\x32\x16\x58\xf9\x25\x54\x2d\x36\x9f\x9a\xf7\x94\x9f\x73\xff\x2c\x35\xbc\x21\xbe\x5f\x55\xc7\xd3\xfa\x3b\x34\xe8\xaf\xed\x2c\xa8\xbf\x76\xa1\xb8\x2c\x9d\x44\x7f\xa5\x6b\x42\x1f\x0f\x31\xf5\xf4\x0f\x87\xee\x86\x7a\x99\xa8\x03\xba\x11\xe7\x98\xca\x9a\x16\x01\x1a\xd7\xd5\x12\x5a\x53\x2b\x46\xec\xde\x1c\x50\x24\x93\xc8\xaf\x39\xfb\x0e\xf9\xd1\x4c\xa1\xb5\x62\xf1\x09\xc5\x42\x7b\x27\xe5\xcc\xf8\x9e\x78\x52\xe3\x64\x15\x47\xcf\x0e\xa8\x02\xc6\x25\x23\x54\x5e\xb0\x38\x24\xc6\x05\x65\xbb\x67\xe1\x34\xb0\x97\x9f\x27\xc4\xdc\xc5\x54\xa2\x21\xd6\xbf\x1f\x0c\xd5\xc5\x39\xf2\x48\xa4\xce\x2f\xeb\x33\xae\x35\x99\x32\xc7\xd4\xc3\x1c\xc7\x61\xf8\xc6\x67\xb2\xfc\x58\x02\x73\x82\xc5\xff\xdc\x61\xce\x89\x87\x8b\x51\x2b\x3b\xaa\x76\x32\x73\x4d\x75\x5d\xcc\x39\xe3\xb0\xa9\x6f\xe5\x66\x9d\xc0\x4f\xa7\xdd\x76\xdf\x39\x29\x86\xe8\x62\x98\x56\x47\x59\xb8\x22\x44\x2e\x36\xfb\xb1\x90\xc8\xbd\x35\xbc\xbb\x90\x38\x0c\xb1\x77\x4e\xa8\x49\xb0\x12\xf1\x21\x96\x0b\xbd\x35\x1e\x87\xb1\x30\x45\x14\xec\x11\xea\x72\x8c\x04\xde\x43\x91\x64\x42\xf8\x03\x8f\x05\x88\x50\x31\x40\xbe\xcf\xee\xb1\xf7\xa8\x3a\xb4\xf8\x05\x7e\x79\xf3\xf3\xf4\xef\x6f\x9a\x20\x64\xde\x2f\x7f\xbf\x79\x7c\x04\xef\x4e\x59\x10\x32\x8a\xa9\x04\x93\xc9\xbb\x7f\xbe\x99\x7c\x77\xba\xa3\xab\xb7\x6f\xc1\xf5\x03\xd8\x13\x12\xc9\x48\xe8\x7a\x4a\x75\xc0\x1b\xc6\x03\xa4\x9c\x11\x4a\x12\xe0\x41\xa2\x49\x53\x63\x42\x25\xe6\x77\xc8\xff\x88\x5c\xc9\x78\xd5\xba\x4b\xd1\xe1\xe3\xf4\xda\x8f\x8f\xff\x7a\x7c\x54\x4f\x82\x27\x93\x7f\x4d\x26\xa6\xeb\x73\x7c\x13\x77\x9e\x70\x61\x05\xcb\x11\xc7\x62\xc4\x7c\xcf\xd0\xa5\x04\xf8\x23\x67\x41\xa1\x5f\xce\x8e\xb8\xf6\x0f\x3c\x4c\x1d\x4b\x7b\xf2\xe7\x11\xb9\x91\xa6\xb3\xd3\xee\xea\x2c\x56\x1b\xb8\x43\x3e\xf1\x52\xd8\xb1\xe7\x23\x21\x81\xd3\x1d\x95\xc5\x0f\xe5\x14\x0e\x54\x2d\x04\x8a\x11\xe2\xd8\xd3\xc6\x16\xf5\x07\x0a\xc6\xa5\x21\x9c\xc7\x01\x6c\x90\x75\x8f\x84\x7a\xe4\x8e\x78\x11\xf2\x61\x6d\x2c\xcb\xda\xc7\x48\xa5\xfc\xa8\x63\x34\x26\x86\x5e\xe8\x3a\x72\x6f\x13\xcb\xaf\x0a\x46\x1d\x30\x48\x63\x99\x12\x71\x0d\x56\x33\x9c\x5d\x1f\xcb\xa7\xb1\xfa\xfb\x55\xed\xcb\x3d\xa0\xf1\x62\x21\x69\xe6\x12\x62\xa4\x24\x5d\xbd\xb7\x3a\xa0\x8f\xae\xb1\x6f\x7c\x78\x75\x40\x9f\x0d\x3f\x20\x81\x6b\x1c\x25\xe9\xea\x6a\x2e\x11\x10\x5a\xdf\x20\x27\xa4\x4a\x83\x49\xf3\x15\xbe\x73\xa1\xa4\xec\xcc\x0f\x66\x63\x46\x3e\x19\xd6\x21\x8b\xb8\xfe\x1c\xdf\x4d\x05\xd0\xd0\x87\xa7\x9c\xd0\x8b\x77\xb1\x30\x34\x81\xa1\x6d\xe7\x19\x38\xb4\x6b\x71\xa8\xc5\xa1\xdb\x8c\x43\x9b\x0b\xdf\x91\xdd\x3e\x7d\xbb\xce\xc1\xe1\x81\x73\xa6\xb9\x5d\x6d\xb4\xdb\x29\xd8\xeb\x63\x29\x30\x75\xf9\x43\x28\x07\x1c\xff\x3b\xc2\x42\x0a\x8b\x7d\x77\x03\xfb\x9e\x63\xf9\x46\x80\x54\x7b\x80\x08\x11\x61\x0f\xb8\x98\x4b\x72\xa3\x32\x38\xd8\x42\x61\x0b\x85\x2d\x14\xb6\x50\xd8\x42\xe1\x22\x14\xd6\xc5\x2d\x95\x51\x3f\x2a\x14\x97\xa5\x93\x64\x64\xfb\x16\x09\x5b\x24\xbc\x00\x12\xde\x25\x70\x48\x87\x84\x8e\x07\x23\x29\xc3\x81\xcb\x28\xc5\x6e\x3c\xdc\x58\x02\x0d\xce\x70\x20\xb6\x30\x70\xed\x30\xf0\x24\x81\xf2\xe0\x74\xa6\x33\x8b\xf6\x2c\xda\xb3\x68\xcf\xa2\xbd\x57\x8e\xf6\x62\xb4\xd7\x76\x9e\x01\xf7\x0e\x2d\xdc\xb3\x70\x6f\x01\xb8\x37\x6f\x00\x2c\x26\x3e\xc5\xd3\xa9\xc8\x97\x37\x03\x9f\xa0\xcd\x00\x4b\x4e\xdc\x41\xcc\x43\x10\x83\xd8\x50\x97\x01\x9c\xb0\xf9\x54\x47\xb3\x4e\x94\x59\xaf\xc4\x9d\x06\x97\x97\x9c\x05\x58\x8e\x70\x24\xc0\x45\xac\x2a\x01\x7e\xd5\xbd\xad\x85\x98\x16\x62\x5a\x88\xf9\xda\x20\xe6\xf6\xb0\x61\xdb\x07\x8d\x1a\x3b\x49\xd0\x5c\xbb\xb3\x38\x1d\xf6\x92\x79\x02\x1a\x82\xcb\x9c\x3c\x58\x17\xb9\x23\xfc\x85\x04\x98\x45\x86\x68\x1b\xcf\x38\x7e\x40\xee\xed\x90\xb3\x88\xea\xe3\x64\x82\x05\xfe\x54\xd1\xd0\x20\x6c\x37\x83\xfa\xd5\xb8\x00\x7f\xfa\xe8\x74\x8f\x7a\xa7\x1a\x2f\x48\x18\xde\x4e\xe7\xa0\x09\xda\xce\x51\x13\x74\x5b\x4d\xd0\x7a\x77\x78\xa4\xeb\x52\xe1\x4f\xce\xd1\x91\xdb\xed\xc3\x5a\x8b\x9c\x7b\x08\xe0\x61\x97\x04\xc8\x17\x55\xb5\xe6\xbc\x97\x32\x5a\x39\x71\x88\xa2\x21\xd6\x1b\x55\x80\xc6\x99\x8c\xda\x2d\x9d\xb1\x04\x84\x66\x0d\x74\xd5\xa9\x23\x6a\xe4\x5b\xec\x91\xcf\xd5\xd0\x54\xcc\xd3\xf2\x02\xf1\x5b\xcc\x33\xcc\x5c\x6b\xa5\x4f\xba\x4b\xc7\xe0\x2e\xfd\x45\xbd\xe5\xb0\xf6\x39\xd4\xfa\x00\x65\xb0\x19\xb4\xd5\x1b\x64\xec\x53\x9d\xd2\x2d\xa6\x20\x4a\xe9\x1e\xce\x3b\x0a\x08\x50\x18\x12\x3a\xfc\xf2\x10\x6a\xc2\x6c\xbe\x76\xa1\x6e\x2f\xed\x8b\x13\x08\x01\x24\x03\x12\x8f\x8d\x1d\xc1\x5d\x66\x35\x95\xea\x49\x73\xd1\x1b\x72\x44\x87\x73\xdf\xd0\x59\x30\xe0\x07\x68\x7c\x86\x24\xba\xcc\x46\x2e\x15\x33\xaf\x8e\xa9\xd2\x6c\x2e\xf6\x4a\xcf\x12\xb7\xfc\x82\xc7\x86\xb0\x54\x37\xec\xf2\xa3\x21\xa1\x7f\x62\x2e\xd4\x3a\x81\x63\x00\xfb\xef\x9c\x77\xdd\xf2\xe5\x43\x26\xe4\x0d\x19\xeb\x4c\x21\xad\xfa\xc8\xa8\xfc\x4c\xfe\x52\x82\x87\xbd\xd6\x7f\x56\x5a\x71\x6c\x3a\x9f\xe3\xa7\x4f\x8f\xf5\x70\x81\xc2\x85\xac\xe6\x26\x99\xb6\xd7\x0d\x44\xb3\x1f\x8c\x0d\xe9\x18\xc0\x4f\xef\x4f\x8c\x4d\xd8\xf4\x22\x0b\x2a\x58\x84\x88\xdf\xaa\xc1\xb4\x3e\x0e\xa8\x85\x0b\xc5\xe5\x39\x9d\x76\x13\xb4\xdb\x87\x4d\xd0\x3e\x3c\x52\xc1\xbb\x7d\xa8\x0d\xde\x37\xea\x85\xcc\x01\x4b\xdd\x31\x7f\xdd\xe4\xb2\x4e\xab\x09\xda\x47\x9d\xb7\xf0\x89\x58\xd9\xa8\xf1\x18\x18\x2f\xd9\x39\x65\x7e\x14\x50\x9d\x2e\x9f\x31\x90\xbc\x8d\xae\xf1\xc0\xc3\xa1\xcf\x1e\x02\x4c\xe5\x40\xa5\xfa\x23\x31\xe0\x38\x5e\xc4\x22\x06\xe8\x0e\x11\x5f\xdd\xdd\x34\xa8\x9c\x9d\x6b\x18\x5a\x6a\x5e\x7c\x8d\x23\xcb\x15\x8d\x15\x61\xbb\xe9\x40\xf3\x70\x11\x76\x5a\x02\xd6\x0c\x08\xf5\xf5\x29\x22\xfa\x23\xa2\x94\xd0\xa1\x1a\x8d\x1b\x91\x91\x20\x74\xe8\x63\xa5\x8c\x72\x8b\x38\xcc\xe6\xdd\xf6\xb0\xea\xb6\x71\x9b\x45\xdd\x96\xa9\xd9\x0d\xf8\x0b\x6c\xea\xab\x33\x8f\x6d\x3d\x15\x8e\x97\x72\xd9\xf8\xe4\x4f\x69\xf4\x57\x79\xc0\x86\xc6\x15\x8a\x0f\xbe\x52\x58\x78\x99\xc5\x49\x8d\x73\x3f\x13\x38\xa6\x88\xef\xb9\xc0\xd1\xeb\x76\x51\x07\xc1\x5a\x29\x5a\xe0\xb8\x35\xc0\xb1\xbf\x7e\xe0\xe8\x98\x81\xa3\xc6\x17\x2c\x74\x7c\x31\xd0\xd1\x82\x42\x0b\x0a\x7f\x28\x28\x8c\xa8\x85\x85\x2b\x87\x85\x5f\x67\x42\xb5\xd0\x70\x8b\xa0\x61\x86\x59\x16\x06\x7e\x36\x63\xf8\x1a\x33\x86\x6d\x67\xfd\xc8\xef\xa0\xd9\xd0\x86\x41\x9b\x32\xb4\x29\x43\x9b\x32\xb4\x29\xc3\xf5\xa6\x0c\x5d\x16\x51\xb9\x97\xfd\x97\x4a\x44\x28\xe6\x83\x00\x07\x8c\x3f\x0c\xee\x19\xbf\x25\x74\x38\x10\x58\x0e\xae\x1f\x24\x36\xae\x85\x7b\x8a\xfc\x4c\x99\x87\xdf\xea\x44\xf0\xba\x51\xa2\x9a\x4e\x05\x1e\x11\x92\x93\xeb\x48\x62\x0f\x30\x0a\x46\x4c\x48\x0b\x17\x77\x1d\x2e\xda\x3c\xe1\x6b\xcc\x13\xb6\x0f\xd7\x0f\x17\xfb\x66\xb8\x68\x13\x85\x36\x51\x68\x13\x85\x36\x51\xb8\x64\xa2\x30\x40\xe3\x3d\x45\x45\xf6\xb0\x2f\xd1\x5e\x3c\x97\x1c\x32\x6f\x30\x43\x85\xd3\xcc\xa1\x90\x88\xcb\x27\xf8\xc9\x66\x48\xf8\xbd\x17\x64\x9b\x23\x84\xcc\x7b\xfb\x83\xd9\xca\x70\x77\xe1\xe3\x05\x1a\xc7\xc9\x45\x90\x69\x24\xdd\xe1\xa0\x07\x02\x42\x23\x89\xc5\x5b\x8b\x23\x97\xc7\x91\x2f\x73\xc1\xd0\x81\x01\xd2\x2c\x4c\x31\x75\x8a\xdd\x76\x59\x3c\x71\x6e\xab\xdd\xde\xe0\x82\x21\x95\x73\xfb\x35\x08\xe5\x83\x16\x3d\x4d\x9b\xfc\x1f\xe6\xcc\xdc\xc2\xae\x3a\xca\x56\x1d\x01\x24\xc0\x5f\x4a\x56\x0b\x40\x94\xc5\x56\x1f\xf5\x74\xb5\x2b\x5c\x7d\xb4\x2b\xcb\x7f\x9e\x98\xa0\xdb\xb2\x49\x39\x67\xce\x7e\x35\xb6\xf7\x7d\xcd\xdc\x57\xe9\x8c\x73\x42\x6f\xb5\x60\x58\xd7\x13\x1b\x5a\xa8\xfd\x51\x55\xb8\x29\x6e\x02\xad\x71\x9d\x57\xc8\xa1\x2b\xeb\x25\x9e\x97\xac\xd5\xcb\x54\xde\x1f\x34\xdd\xec\xca\xe5\x19\xd1\x5d\x97\x68\x44\x17\x90\xe9\xe9\x2a\x64\xfa\x72\x93\xb6\x15\xd9\x0a\xec\xed\xeb\x52\xa2\x55\xc9\x9e\x3d\x0b\xa7\x7f\xbf\x32\x83\xf4\x35\xae\x27\x64\x1e\x88\xd5\x08\xf6\xe2\x78\xd9\x04\xb1\x29\x35\x41\x44\xd5\xff\xdf\x02\x44\xbd\x04\xe2\xc7\x32\x98\xe5\x8a\x75\xfb\xd1\xaf\x60\xc9\xa1\x63\x97\x1c\xae\x65\xc9\xa1\x39\xf5\xaa\xf1\xa7\xad\xd9\x04\xc2\xf4\xa8\x39\x21\x56\x1a\x4c\x9a\xf3\xca\x64\x0b\x5f\xb8\xa6\x81\x31\x3f\xb3\xe5\x6b\x12\x5f\xe6\x28\xb6\xbf\xb2\x51\x6c\x7d\x62\x3e\x1e\xc5\x1e\xd9\x41\xec\xae\x0e\x62\xd7\x37\x78\x75\xec\xe0\x35\x1e\xbc\xfe\x80\x84\x31\x6c\xea\x1f\x69\x33\x88\x34\xde\x4a\x37\x64\xde\xcb\xdd\x41\xed\x72\xb1\x94\xb2\xdd\xe9\xc2\xee\x74\x61\x77\xba\xb0\x3b\x5d\xfc\x98\x9d\x2e\x3a\xdd\x46\x8d\x9d\x24\x9c\x89\xee\xdc\x1b\x5d\x70\x1c\x62\xf4\x54\x7c\x3c\xbd\xfc\x0a\xbe\x0a\x05\x14\x0c\xc1\xe7\x55\x7f\x11\x6e\x65\x13\x4c\x9d\x5e\xa1\xbc\x2c\x1e\xe2\x55\xcf\x5d\x2b\x34\xb7\x53\x43\x76\x6a\x68\xd3\x53\x43\x0a\x5d\x53\xe6\xe1\xc1\x14\x27\x17\x11\xf6\xf1\x0c\x6b\xbb\x61\x34\x88\x54\x54\x1a\x08\xec\x32\xea\xa5\x68\xfb\x58\x44\xc1\x80\x23\x99\x4f\xae\xff\x3d\xf7\x46\x72\x6b\xc6\xdc\xce\x2a\x31\xf7\x46\xa6\x93\x0a\x25\x5b\x88\xdd\xcd\x7d\x93\xcd\x0e\x6f\x6d\x76\x78\x0b\x21\x6b\xcb\x50\x9b\x93\x50\xa5\xc1\xa4\xb9\xc3\x2f\x5c\xd3\x20\x7d\xe7\x6a\xe7\x6b\x41\xfa\x9c\x20\xbd\xeb\x34\x6a\x0c\x25\x01\xe9\xbd\xd5\x83\xf4\xff\x8d\x98\x44\xd0\x10\x7a\xd6\x0e\xd2\xdd\x78\xb9\x8e\xf6\x0d\x7e\x20\x7e\xbf\xc9\x51\xfb\xda\xad\x2a\xb7\x6f\x73\xf8\xbe\xdb\x29\x94\x97\x25\x9b\xff\x34\x79\x09\x18\x58\x7c\xbf\x7d\xf8\x1e\x0d\x71\x6a\x56\x1a\xaf\xfc\xa1\xe8\xdf\xe5\xcc\xf7\xf5\x22\x9b\x67\x64\x30\x62\xf7\xbf\x61\xe4\x61\x6e\xb8\x84\xea\xbf\xb4\xf6\xe8\x32\x8d\xff\xa5\xb3\xc1\xc2\xcd\xde\xa6\x56\xdb\x6b\x1d\x96\x08\xf9\xe0\x2f\xb9\x4d\xf7\x17\x3d\xba\xca\x02\x14\x9e\x21\xf7\x6f\xdf\xbe\x7d\xdb\xbf\xb8\xd8\x3f\x3b\x03\xbf\xfd\x76\x1c\x04\xc7\xc2\x38\x3a\x08\x91\x94\x98\xd3\xa7\xae\x9f\xc5\xef\x11\xf1\x3c\x4c\x8b\x30\x53\x23\xc3\x79\x5e\xc7\x04\x9a\xb3\x9f\xea\x49\x19\x4f\x3d\xa4\x6a\xde\x9a\x15\x61\x57\x86\x16\x4b\x0b\x27\x47\x20\x30\x0e\x9a\x08\x35\x18\x43\x76\xa8\xe8\x71\xfb\x65\x3a\x10\x80\x67\x9c\xf8\x3e\xf0\xd8\x3d\x85\x35\x27\x7c\xe5\x7e\x1d\x4f\x3e\xa7\xb6\x78\xa1\x17\xf8\xc9\x38\xae\xaa\x1d\x1f\x55\x3a\x68\x1a\x05\xd7\xd8\xf8\x15\xcc\x88\x92\x1c\x80\x5c\x95\x15\xfc\x91\x7e\xf3\xd1\x1a\xc2\x2a\x0c\xe1\xc3\xee\x1b\x02\x28\x03\x24\x6b\x0a\x4b\x99\xc2\xe9\x26\x4d\x21\x85\x1b\xb1\x65\xac\xca\x20\xce\x49\x40\x6c\x5c\x58\x4d\x5c\x38\xdb\xa4\x31\xac\x36\x2e\x24\x66\x60\xa3\xc2\x6a\xa2\xc2\xaf\x9b\x34\x84\x55\x47\x85\x4b\x56\x5e\xdc\xbb\x93\x56\x50\x1d\xd3\xac\xc4\x08\xde\x7b\xef\xfb\xbd\xc3\x36\xee\xf6\x71\x17\xf7\xdc\x83\x6b\xd4\x6d\xa1\xd6\x41\xbf\xdb\xef\x1c\xf5\xf0\xcd\xc1\x75\xaf\xf7\xbe\x23\x5c\xe4\xe3\x7d\x97\x05\x61\x24\xf1\x3e\xc7\x49\x26\x43\xa8\xd5\x09\xff\x75\x87\xf8\xfe\x6c\xca\x62\x36\x61\xf1\x0f\x55\xa1\xa6\x2c\x7e\x1e\x0c\x5c\xec\xfb\x73\x58\x5b\xc8\xbc\x4d\x1a\xda\xea\x22\xce\x4e\xdb\x57\x4e\x01\xef\xdf\xfd\xf3\xfd\x6a\x34\xa0\xb6\x4a\xa1\xc3\x65\x35\x50\x28\xb9\x7a\x9d\xd3\x74\x6a\xe9\x92\xa9\x19\xa1\x42\x22\x2a\xeb\xa3\xc2\xd2\xb3\x78\x70\x1d\x13\x72\xcd\x65\x74\xa5\xa1\x2a\x66\xc1\x67\xfa\xd9\xff\x58\x53\x2e\xe3\x58\xbc\x66\x95\x7c\xd8\x94\x4a\xb6\xc2\x7d\xc0\x7b\x60\xcd\x63\x11\xf3\x38\xdd\x94\x79\xd4\xa9\xc4\x8f\x31\xb9\x55\x88\x52\xc8\xd9\xa6\x14\xb2\x2b\xfe\x6a\x8d\x63\x66\x1c\xbf\x2e\x61\x1c\xf5\x90\xe9\x99\x84\x97\x79\x19\x2d\xfa\x89\xdc\xe9\xc8\x64\x79\x46\x4b\x6b\x75\x8c\x16\x8e\xa8\x50\x66\x60\x32\x82\x29\x84\xd5\x56\x5a\xce\x8b\xe5\xbc\xec\x2a\xe7\xa5\xa1\x11\xc6\xd6\x52\x51\x7a\xad\x46\x8d\xfe\x12\x2a\x4a\x7f\xb5\x54\x94\x8b\x78\x33\x55\x03\x2d\x6f\x53\x6c\x94\x57\x41\x19\xef\xcd\xb1\x27\x51\xa9\x37\x4d\x7b\x51\x4b\x29\xd9\x3e\x4a\x89\xa5\x8c\x2f\x4b\x19\x5f\x68\x4f\x90\x79\x61\x70\x13\x4c\x2f\xfb\x1f\xbf\xbc\xb1\xe4\xf0\xdd\x23\x87\xd7\x76\x44\x2b\x40\xd3\xce\xea\xd0\xb4\xe5\x87\x6b\xf9\xe1\xf1\x26\xec\xdb\x00\x1d\x35\x3d\x70\x59\x42\x95\x06\x93\xe6\xe2\x2f\xfc\xd2\xb0\xb2\xe5\x87\xeb\xf8\xe1\xbd\x39\x76\xe1\x38\x58\x0b\x28\xb7\x14\xf1\x6d\xa6\x88\xf7\x8e\x0a\xe5\x65\xc9\x12\xaf\xba\x47\x7b\xda\xd3\x5b\x3c\xbf\x7d\x78\xde\x52\xc4\x17\xa6\x88\x57\xfa\xd1\xc9\x26\x47\x21\x96\x21\x9e\x31\xc4\x6b\x90\xf3\x6e\x91\x32\xb6\x86\xfa\x65\x1c\x5f\xd5\x8e\x93\x2a\x3a\x9e\x8f\x91\xe3\x61\x37\x41\xce\x2b\xb4\x05\x4b\x15\xdf\x59\xaa\xf8\x3a\xcd\xc1\x12\xc6\x5f\x39\x61\x5c\x11\xc6\x53\x9b\xb0\x9c\xf1\x1d\xe5\x8c\xaf\x23\x40\x58\xe6\xb8\x65\x8e\x5b\xe6\xb8\x65\x8e\x5b\xe6\xb8\x65\x8e\x17\x99\xe3\xdb\x37\x5b\xa7\x61\x28\x6d\x0b\x87\x6d\x99\x79\xb9\xe6\x32\x5a\x99\x8b\x04\x9c\x2a\x6c\x59\x25\x59\x9a\xf8\xa2\x34\xf1\x0d\xfb\xca\x22\x84\x70\x6b\x0b\x89\x2d\x6c\x13\x27\xdc\xea\x24\xd1\xc9\xc6\x68\xe1\x33\x55\x6c\x8b\x7f\x5a\x4b\x28\x58\xc2\x4e\x72\xc0\x6b\x27\x6b\xa7\xc3\x8c\xe5\x89\x2b\xad\xd5\x11\x57\x2c\x0d\xdc\xd2\xc0\x2d\x0d\x7c\xeb\x69\xe0\xfd\x7e\xa3\x46\x7f\xe9\xe6\x73\xbd\xb9\x29\x27\xd3\x48\xf5\x09\xcb\x7b\xc6\x6f\x0d\x1c\xbb\x4d\xf1\x4a\x5e\xc5\xa7\x7b\xfa\x07\x85\xf2\xb2\x78\x12\x15\x1e\x58\x76\xc8\x0f\x60\x87\x2c\xc2\x0a\x59\x8c\xf7\xe1\xac\x94\xf7\xb1\xdb\x2c\x6f\xa2\xf6\x0b\xc8\x8d\x88\x69\x12\x7b\x06\x1c\xbb\x98\xdc\xe1\x04\x6e\x57\xbe\xb9\x33\x37\xe2\xdd\xc8\x57\x77\x56\x4a\xf2\xde\xe9\xaf\xee\xfc\x91\x68\x0d\x7c\x40\xd4\x4b\xbc\x70\x0d\x40\xd7\x59\x1d\xd0\xb5\x0c\xed\x1c\x43\x3b\x97\xd4\xd6\x3f\x58\xc9\x55\x3e\x84\x62\x1b\x90\x9f\xa6\x6b\x2d\x4b\xb1\xd2\x60\xd2\x9c\x57\x28\x2f\x17\xea\x6e\x13\x8b\xdb\xa2\xc4\x5a\x94\x78\x30\xcf\x2e\xd3\x87\x16\x25\x5a\x94\xf8\xca\x50\x62\x9c\xa9\x0a\x88\xb4\x30\x71\xa7\x60\xe2\x97\x54\x6d\x16\x27\xee\x22\x4e\xb4\x08\xd0\x22\xc0\x65\x11\x60\x23\x77\x63\x15\xc3\xd4\x88\x55\x59\x52\xbb\x95\x59\x12\x14\xee\x08\x07\xe8\x4f\xcc\x05\x61\x34\x07\x6c\x92\x95\x1c\xaa\xb1\x87\xf8\x6d\xd6\x5a\xa2\x61\xd1\x78\x61\xb2\x21\x67\x5a\xaf\xfe\xc0\x72\xc4\x2f\xd7\xfd\x1e\xcf\x6b\xba\xf8\x13\x0a\x70\xa9\x5a\x3c\x08\x89\x03\x58\x78\x6c\x89\x83\xd0\x47\x92\xd0\x61\x41\x58\xd0\x27\x42\x56\xfc\x48\x23\x4c\xf5\xed\x6b\x03\xd8\x23\xd4\xf5\x23\x0f\x9f\xf8\x75\x10\xa8\xde\xd2\x60\x10\xf9\x92\xd4\x9c\x9e\xc6\x94\x3c\xfc\xd5\xb4\x9a\xe1\x98\x72\x94\x57\x07\xfc\x77\x84\xb9\x5a\xdc\x06\x43\xce\x02\x2c\x47\x38\xd2\x45\x82\x9c\x86\x35\x16\x0f\x39\x1e\xe2\xb1\x61\xc2\x0f\x8a\x5b\x12\x7e\xe5\xfe\xe7\x07\x3a\xfd\xcc\x84\xa6\x55\x16\x99\x73\x2f\xd3\xa8\x71\x73\x8d\x36\x90\xef\xc7\xab\x39\xcc\x02\x9d\xc1\xe1\xea\xe9\x05\x1b\xd4\x08\x2a\x35\x98\x71\xda\xb1\x83\x77\x9f\x32\x3c\x52\x34\xb4\xfc\x2f\x89\xdc\xda\x13\xe6\x09\x63\x2b\xb3\x2f\x38\xc5\x4e\xf0\x19\x66\x56\x7b\x91\x9c\x95\x55\x2a\xf5\xfa\xca\x7e\x50\x60\x1f\xbb\xb2\x06\x06\x2c\x2b\xfe\x45\x55\x50\x8c\x70\xd9\xaf\xd6\x69\xe6\x78\x92\x05\xed\xdf\x8d\x84\x64\x69\x9c\xaa\x3e\xd4\x55\x23\x67\x2b\x09\xd8\x28\x44\xae\x9b\x04\xf0\x41\xca\xee\xf7\xdb\x79\x80\x03\x25\x4b\xcb\x61\xe5\x12\x21\x71\x6f\x31\x2f\x5e\x28\xf5\xf7\x41\x86\x7d\xab\x9a\x85\xbd\x72\xa0\xc8\xc5\xfe\xec\x80\x9d\x6a\x51\x3b\x28\x97\xf4\x2a\x25\xed\x6a\x51\xa7\x55\x6d\x55\x81\x70\x4e\xa5\xa4\xed\xc1\x86\x46\x95\xb1\xec\x06\x26\xab\x9d\xef\x89\xaa\xb7\xef\x57\x6f\x5f\x7d\x22\xa7\x5b\x2d\x2a\xf3\x7a\xe1\x41\xa5\xa4\xd3\xf2\x60\x8d\x1d\xfc\xc5\x28\xce\x45\xe0\x19\x44\x4f\x7a\x51\xf0\x39\xee\xff\xc0\x49\x24\xd9\xe7\xcf\xe7\xb0\x31\xf9\xff\x01\x00\x7f\xe5\x63\xbc\x1d\xe9\x00\x00")
 
 func dashboardsAutosslJsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -139,7 +144,7 @@ func dashboardsAutosslJsonTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "dashboards/autossl.json.tpl", size: 59358, mode: os.FileMode(420), modTime: time.Unix(1614940003, 0)}
+	info := bindataFileInfo{name: "dashboards/autossl.json.tpl", size: 59677, mode: os.FileMode(420), modTime: time.Unix(1792328605, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -204,6 +209,26 @@ func dashboardsMappingServiceJsonTpl() (*asset, error) {
 	return a, nil
 }
 
+var _dashboardsSaasOperatorJsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x5b\x6f\xdb\x36\x14\x7e\xef\xaf\xe0\x84\x62\x49\x86\xa4\xb5\x93\xba\x6d\x06\x64\x40\xd7\x2d\x45\x81\x6e\xed\x9a\xae\x7b\x08\x02\x97\x96\x68\x9b\x08\x4d\xaa\x24\x95\xc4\x33\xbc\xdf\x3e\x5e\x24\x8a\xba\x38\x71\xae\x4d\x5c\xe6\x21\x31\x8f\x28\xf2\xf0\xdc\xbe\x8f\x92\xc3\xd9\x23\x00\x22\x48\x29\x93\x50\x62\x46\x45\xf4\x33\x98\x29\x91\x12\x12\x2c\xa4\x6a\x1d\x9a\x16\xc8\xa5\xe6\xca\x20\xc3\x44\xbe\xa5\xea\x62\x77\xb3\x94\x26\x50\x42\xc1\x32\x1e\x23\x75\x21\xda\xda\x02\x6f\x38\x1c\x42\x0a\xc1\xd6\x56\xe4\x75\x43\x14\x0e\x88\xee\x22\x79\x86\x3c\xf9\x18\x27\x2d\x52\x1c\x33\xfa\x9a\x11\xc6\xf5\x98\x7c\x34\x80\xeb\x9d\x4d\xb0\xdd\xed\xaa\x5f\xbd\xde\x26\xe8\x6e\xf8\x43\x53\x38\x31\x73\xbf\x2a\x97\x03\x7e\x04\xaf\x08\xe2\x52\xf8\xfd\xe4\x34\x35\xfd\x12\x28\xc6\x03\x06\x79\x12\xe5\xd7\xe6\xe6\xef\x91\xfa\x3d\xd7\xdd\x23\x94\x60\x59\xd3\x36\x1a\x51\x24\xdf\x26\x4a\x42\x33\x42\xac\x84\xc3\x74\xfc\x89\x31\x22\x71\x5a\xd8\x24\xc2\x12\x71\xa3\x82\x93\x10\x4c\x8f\xb5\x79\x0f\x8f\x4c\x33\x85\x14\x11\xe1\x0c\x5c\x98\x37\x8a\x19\x21\x30\x15\x48\x4f\x31\x84\x44\x38\x6b\xa8\x79\x70\xf2\x81\x95\x1e\xb2\x66\xab\x79\xe1\x54\xb5\xb7\x9f\x79\x82\x33\x25\xe8\x78\xed\xa9\x6e\x17\xeb\x75\x63\x63\x3d\xdd\xb6\x6b\x96\xca\x1d\x39\x99\xc4\xd2\x58\x22\xfa\x88\x94\x53\x62\x4c\x50\x69\x54\x67\x52\xce\x4e\xad\x31\xf3\xa1\xdd\xb2\x20\xc1\x50\x18\x4f\x9a\x05\x94\x33\x0f\xa0\x91\x54\x97\xaa\x3d\xf3\x0e\xd1\x91\x34\xcb\xeb\x54\xe4\xa8\xad\xbb\x1f\x7a\x8f\xbd\xa6\xeb\x32\xc4\x84\xf8\xa6\x5a\x6c\xcd\x97\x35\x6b\x76\xb7\x2f\xb0\x66\xb7\xdd\x9a\x3b\xae\x49\xd0\x08\xd1\xa4\x3a\x13\x3c\x19\xd5\x97\xa1\x9d\x9f\x71\x8e\xa8\x6c\xb9\x32\x81\x67\x6d\x52\x4c\x5b\xa4\x62\xcc\x4e\x9b\xa9\x24\x55\x4e\x90\x96\xde\x27\x90\x64\xa5\x4d\x1b\x6b\x51\x61\x6b\xae\xfa\xa3\x19\xe1\x29\x4e\x64\x25\xfa\x6a\x11\x6e\x44\x3a\x49\x3e\x30\x4c\xe5\x1f\xcc\xa4\xb7\x11\x94\x5e\x61\xa9\x2b\x3a\xe5\x8c\x29\x52\x9e\xa3\x12\x8e\x50\xc3\xd1\xa9\x1e\x8a\xc3\x04\x67\xa2\x1a\xaf\x5a\xde\x8c\x0b\x65\xcb\x04\x71\x64\x8a\xc7\x90\x30\x59\x4e\x2c\x10\xc7\x48\xbc\x3f\x41\x5c\x85\x01\xaa\x29\x2d\x52\x18\xa3\xb6\xf0\x13\x12\xc6\xc7\x8d\x59\x84\x44\x69\x8a\x92\x77\xca\x26\x8d\x6b\x12\xf2\x11\x92\x65\x9a\xeb\x9f\x32\x0a\x74\x89\x39\x4b\x8d\x7a\x22\x9b\xac\xab\x82\x81\xd6\x05\x84\xa2\xcf\x52\x5d\x3d\x18\xef\xf3\x22\xd7\xfa\xc6\x7d\x33\x5d\xe2\x8c\x7a\x7b\x6b\x8f\xdd\xe7\xb5\xcd\x63\x4c\x93\xbd\xff\xd6\x1e\xeb\xbf\x6b\xf3\xc3\xde\xe4\x68\x63\x03\x0c\xa6\x60\x5d\x0b\x36\x01\x47\x22\x23\xd2\xaf\x95\x3a\x23\x18\x9f\x40\x1d\x6a\x2a\xb3\x27\xa8\x6f\x2d\x52\xed\xa2\x8c\x8a\xf8\x89\x89\x9a\xa8\x3b\x69\xbf\xb6\x0f\x63\x69\xca\x73\xb7\x72\xd9\xc6\xfc\xbe\x9b\x63\x36\x03\x5f\x66\x33\xad\xce\x7c\x0e\x66\x33\xab\xd1\x7c\xfe\x05\xcc\xe7\xd5\x71\x39\x1a\x9a\xf2\x1a\xbd\x8a\x9c\x78\x9e\x7f\xf2\x8a\xd1\x58\x8d\x30\x66\x24\x69\x14\xa9\x09\xda\xe7\x6c\xe2\xd5\x67\x27\xff\x88\x46\x79\xb0\xd5\x6e\x38\x18\xe3\xa1\x6c\xde\x51\x2f\x77\x40\xfb\x47\x5b\xd5\x2a\xef\x15\x3f\x57\xfb\xbd\x04\x17\x63\xc8\x4d\x11\xaf\xa5\xa1\x60\x5c\xfa\xb1\x5b\x64\x60\xbf\x28\xa1\xca\x44\xf8\x04\x27\x99\x32\x7b\x23\x19\x8b\x3e\x06\x72\x4a\x05\xce\xe0\x19\xae\x55\xb2\x41\x16\x1f\xdb\xc0\xf3\x57\xa5\x4b\x46\x9e\x88\x7a\xe1\x2d\xe0\x59\xeb\xdd\x5e\x4a\x5c\xc9\x38\x3c\x6a\xa8\x38\x85\x67\xe8\x9c\x78\x2f\xa3\x8e\xa5\xb5\x68\x23\x70\x80\x48\x43\x05\x7d\x81\x8d\x7e\x85\x02\x35\x62\xcc\xd6\xc4\x46\x77\x5b\x14\xa3\x4e\x75\x74\x6f\x25\x65\x5c\x6d\x5e\xa4\xa4\xba\x8b\xcb\xdb\x54\xb3\x21\x6e\xd5\xb3\x11\xff\xd3\xa6\xc7\x15\xc2\x8e\xda\xd0\xc0\xc8\xdf\xa1\x13\xa7\x74\x85\xec\xac\x30\x4e\x57\x04\xe7\x00\xb5\x63\x4b\x01\xa8\x03\x50\x5f\x08\xd4\x63\xb5\x2d\x62\xaa\xfe\x4e\xfa\x5f\x33\x48\xa5\xc2\x85\xf5\xce\x93\x5d\xb5\x17\xb9\x10\xc1\x93\xcc\xee\x08\x14\xd4\x2a\x51\x22\xfa\xb6\x46\x5f\x1a\xd3\x09\xda\x04\x5a\xb8\x71\x6f\x00\xfd\x41\xa1\x78\xe1\x06\xb0\x9e\xee\xf6\x36\x02\x8a\x5f\x0f\xc5\x03\x86\x07\x0c\xbf\xc3\xbd\xf6\x6e\x3b\x84\xf7\x02\x84\x07\x08\xbf\xd4\x5e\x1b\xd3\x98\x23\x95\xe9\x37\xb0\xdf\xde\xb4\xfb\xc1\x1f\xf6\xd6\x44\x16\xc7\x48\x88\xf6\x1d\xb8\x49\x92\xbe\x1b\xa4\x26\xdb\x00\xbf\x80\xce\x7d\xc1\x73\xbb\x41\xaf\x29\x3c\x9f\x3f\xad\x89\x1f\x04\xf0\xef\x43\xe5\xcd\x04\x38\xc7\x0a\xed\x95\x38\x53\x24\x6e\xe2\x3c\x10\x48\xc0\x35\x49\xc0\x6d\x23\x6c\x20\x02\xbe\xfc\x7b\x24\x02\x8d\xcd\xfc\x02\x26\xf0\x3c\x30\x81\xc0\x04\x6e\x82\x09\xa8\xe0\xc9\x3f\xa5\x90\xe2\x58\x5c\xe3\x01\x7c\xd8\xa7\x2f\x0f\xd7\x6f\x0a\xb3\x03\x6b\x76\x03\xdc\x2a\x60\x14\xfe\x06\x90\x0e\x20\xbd\x82\x20\x7d\x17\x2f\xfc\xbb\x2f\xda\xd1\xf2\x45\x59\xcb\xcf\x7b\xe3\xff\xfe\x94\x1a\x0a\x6d\xf1\x3d\xbc\xf6\xb7\x36\x7d\xd9\x6e\x53\x37\x50\x60\x20\xab\xc6\x40\x2a\xb6\xb8\x31\x02\x52\xe5\x1d\x4c\xe7\x5a\xdf\xe5\xda\x72\x4f\x20\xec\x97\xaa\x92\xbd\x35\xad\xe1\xda\xdc\x52\x0f\x3b\xd2\x3d\x21\x20\xa5\x32\x0f\x82\x86\xfc\x6e\x0d\x0a\x58\xb5\xf2\x69\xbb\xea\x35\x04\x2e\x12\xb8\xc8\x2a\x72\x91\x15\x82\xeb\xe6\xdb\xff\x05\x78\xbd\x1b\xf0\x7a\x55\xf1\xfa\x96\x9e\x18\x54\x01\x5b\xa0\x98\xab\x5b\xfb\x94\x49\x05\xdb\x30\x99\x2e\xf7\x8c\x20\xbc\x1d\xc8\x7f\xbe\x29\xce\x1f\x58\xe7\x01\xe5\x3c\x60\x9c\x17\xde\x0e\x04\xb0\xbf\x25\x35\x03\xd8\xdf\xd9\xde\x7c\xfb\x79\x3b\xd6\x7b\x7a\x07\xb0\x0f\x60\x7f\x9d\x2f\x0a\x30\x42\x58\xa6\x60\x5f\x72\x3c\x1a\xe9\xe7\xd3\x57\x7c\x41\xd0\x86\xfd\xe5\x66\xb9\xf8\x7c\x8f\x48\x40\x1b\xf6\x83\xea\x06\xff\x69\xd1\x7c\x30\x3c\xe0\x63\xee\x4e\xe0\xdc\x69\x88\x00\xa3\x43\x3c\x2a\xbe\x2f\x18\x8f\x21\x1d\x55\x9e\x7e\x06\x3a\x10\xe8\xc0\x95\xd5\x0c\x74\xe0\xee\xf6\xfe\x0b\xf9\x80\x87\x93\x81\x0f\x04\x3e\x70\x75\x3e\x90\x70\x05\x2f\xfd\x98\xa9\xd0\x88\x8d\xb9\x02\x1d\x78\xc0\x74\xe0\x37\xed\x4d\xe0\x79\x33\x80\x7e\x00\xfd\x55\x04\xfd\xbb\xf8\xf2\xc1\xce\xb3\x05\xe0\xbb\xe4\x79\x03\xaf\x99\x82\x13\xa5\x27\xe2\x80\x67\xb4\x12\xca\xdf\xf5\x17\x10\x76\x7a\x0b\xec\x1a\x4e\x1e\x08\xa4\xe6\x72\xa4\xe6\x94\xf1\xe3\xaf\x19\x52\xc0\x94\xa0\x54\x8e\x17\xb0\x96\xfc\x9d\x85\x21\x1f\xdf\x9e\x78\x3c\x18\x32\xf1\x8f\x32\x2e\x30\xd6\x05\xc6\xba\x81\x4b\x04\x2e\xb1\x8a\x5c\x62\x85\xa0\xb6\xf1\x00\x61\x21\xd6\x86\xc3\x03\x02\xd6\x5e\xe1\x94\x9f\xd8\x71\xda\x7e\xce\x69\xbd\x7f\x3d\x54\xea\xaa\x1c\x3a\xf7\x01\x82\xff\xc8\xa0\x1c\xeb\x9e\x3c\x12\x28\x15\x7a\x10\xf8\x5c\x1e\x0d\x60\x0d\x9f\x3f\xf9\xcf\x97\x10\xd0\x3a\x1c\xf3\xb3\x02\x58\xfd\x28\x1f\x56\xa7\x9f\xce\x2c\xbd\xea\x9d\x8e\x75\x4d\x24\xe2\x31\x9a\xc0\xcf\x88\x8b\xfc\xc0\xc2\x97\x56\x2c\xa7\x24\x3f\x28\x91\x1f\xdb\x9e\xaa\x5e\x97\x5e\x8f\x76\x44\x0c\x49\x11\x5e\x91\x7e\x20\xba\x55\x3c\x10\x8d\xdc\x84\x12\x4d\x52\x02\x25\xa6\x23\xb7\x90\x73\xce\x96\xcc\x8f\x81\xf4\xf7\xba\x98\xc6\x24\x4b\xd0\x2b\xd2\x86\x6b\xed\x6e\x8a\x26\x99\x4a\xd5\x96\xee\xc5\x11\x91\x2d\xfc\xa1\x02\x54\x65\x01\x51\x62\xc5\xdd\xb9\x26\x01\x51\xaa\x0a\x0f\x92\x63\x94\x55\x8e\x93\x2c\x2d\xda\xad\x48\x47\x48\x07\x44\xe4\x77\x15\xc7\x38\xfd\x9b\x93\x83\x29\x8d\x5b\x94\x2b\xcf\xa5\x74\xca\xd5\xf3\xae\x12\x08\xe4\xb3\x4e\xd0\xe6\xe2\x4b\x7e\x51\x09\xf7\xc2\x77\x47\x95\x40\x94\xe8\xac\x28\xdd\x4f\xfe\x2c\xf0\xa5\x51\xb4\x4f\xf2\x99\x9a\xdd\xda\xb2\xec\xca\x5e\x8c\x1c\xc2\x45\x97\x70\x66\xeb\x4d\x9e\x2f\xbd\x85\xf8\x06\x31\x04\x81\xa0\x58\xb6\xd4\xef\xe5\x4d\xb3\x9c\x71\xca\xe4\xf6\xd2\xdb\x0f\xad\x73\xe6\x58\x32\x6a\xec\xb7\xd6\x96\x8c\x98\xe8\xc9\x4f\xfe\x0c\x17\xb3\x6b\xdd\x09\x0d\x31\xc5\xf9\x99\xa6\xd6\x65\x7d\x0b\x11\x57\x3a\x44\x61\x9e\x9f\x62\x14\x5d\x22\x6e\x6a\x00\xe5\xc2\xa6\xf2\x9d\x7c\x2f\x62\x6a\xfd\x8b\x80\xa9\x77\xbf\x28\xef\x6f\x65\xad\x37\x56\x36\x72\xaa\xe1\x8f\xa2\x52\xdd\x78\x5a\xfc\x55\x2c\x21\xaa\x5e\x6d\xac\x55\xcb\xda\x3b\xe7\xe1\x65\x8d\xe1\x5d\xc8\x04\xfa\x64\x07\xaa\x6c\x29\xcc\xdf\xf2\x08\x5d\xc3\x3f\x5c\xdd\x1f\x5a\xe6\x16\x51\x76\xba\xd5\x2d\x98\x8d\x22\x56\xb9\x2c\xaa\xdc\x96\x62\xc5\x6d\x78\x79\x73\x6e\xb0\x7e\xc1\x49\xfd\xdc\x8e\x7a\xde\x2b\x9a\x6e\xc7\x6b\xec\xf8\x0d\x8f\xde\x46\x3d\xef\x73\xd7\x6f\xec\x74\xfc\x2b\x1e\xff\xda\xf6\x3e\x77\xf3\x43\x83\x8f\x8a\x35\x68\x76\xdd\xac\x39\x8b\x67\xf1\x07\x7e\xee\x0f\xec\xcf\xb2\xfd\xcc\x6f\x78\xff\x76\xf2\x22\xf1\xf5\x2d\x74\xa9\x98\xef\x5f\x66\x76\x2a\x51\x0e\xdc\x05\xdb\xb5\x98\x0d\x0e\x20\x3c\x00\xef\x1d\x58\xcf\x1f\xfd\x0f\x3b\x35\x69\x2d\x09\x5a\x00\x00")
+
+func dashboardsSaasOperatorJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dashboardsSaasOperatorJsonTpl,
+		"dashboards/saas-operator.json.tpl",
+	)
+}
+
+func dashboardsSaasOperatorJsonTpl() (*asset, error) {
+	bytes, err := dashboardsSaasOperatorJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dashboards/saas-operator.json.tpl", size: 23049, mode: os.FileMode(420), modTime: time.Unix(1792320495, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _dashboardsSystemJsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x7b\x73\xdb\x36\xb6\xc0\xff\xef\xa7\xc0\x65\xd3\x8d\xd3\x95\x62\x49\x96\xfc\x9a\xc9\xdc\x89\x93\x66\xbb\x3b\x49\xaf\x9b\xa4\x9d\xe9\xcd\xfa\x6a\x21\x12\x96\xb0\x26\x09\x06\x00\x6d\x2b\xbe\xee\x67\xdf\x21\xf8\x02\x09\x50\x4f\xea\x65\xe1\x9f\xc4\x02\x49\x10\x38\x07\xc0\xf9\xe1\xe0\x00\x7c\xf8\x0e\x00\x0b\xfa\x3e\xe1\x90\x63\xe2\x33\xeb\x1c\x44\x49\x00\x58\x2e\x66\xdc\x3a\x07\x5f\xc4\x2f\x90\xa4\x8a\x2b\x83\x10\xbb\xfc\xef\xbe\x75\x0e\xda\x8d\x3c\xd5\x81\x1c\x32\x12\x52\x1b\x59\xe7\xc0\x6a\x36\xc1\xdf\x28\xbc\x86\x3e\x04\xcd\xa6\x25\xdd\x86\x7c\x38\x70\xa3\x5b\x38\x0d\x91\x94\x3e\xc2\x8e\x26\x15\xdb\xc4\x7f\x43\x5c\x42\xa3\x3c\xe9\x70\x00\x0f\x5a\x0d\xd0\x69\xb7\x1b\xa0\xd3\xeb\x35\x40\xfb\x85\x9c\xb5\x0f\x3d\xf1\xee\xd7\x79\x75\xc0\x5f\xc0\x6b\x17\x51\xce\xe4\xfb\xf8\x38\x10\xf7\x39\x90\x8d\x06\x04\x52\xc7\x4a\xae\x3d\x8a\xff\xaf\xbe\x03\xe0\x31\xba\xdd\x42\x0e\xe6\xa5\xd2\x5a\x43\x1f\xf1\xbf\x3b\xd6\x39\xf0\x43\xd7\x8d\x53\x28\x0c\x46\x9f\x09\x71\x39\x0e\x52\x99\x58\xd8\xc9\xfe\x74\xb1\x7f\x13\xc9\xf5\xcb\x95\xf8\x19\x40\x1f\xb9\x2c\x93\x6c\x2a\x57\xcb\x26\xae\x0b\x03\x86\xa2\x07\xaf\xa1\xcb\x32\x31\x14\x25\x9b\xbe\x56\x5c\x19\x52\xec\x5c\x92\x5c\x69\xb1\x24\x4b\x8a\xb9\xb3\xce\x41\xa7\x2b\x25\xdc\x5b\xe7\xa0\x25\xfd\x1e\x47\xbf\x53\x11\x64\x79\x8b\x1a\x9c\xf6\xb2\xdf\x79\xb9\xaf\xb2\x34\x8e\xb9\x1b\x8b\x3c\x08\xac\x3c\x35\x91\x2f\x25\x77\xb1\x64\x93\x4c\xb3\xaa\x42\x17\x43\x26\xd4\x2a\x8a\x9e\xbf\x73\x00\x45\x4a\xb9\xfa\x6c\xf4\x1e\xf9\x43\x2e\x2a\xd6\x2a\xa4\x23\xdd\xed\x72\x3b\x7c\x26\xfd\xcc\x6e\xb9\xc6\xc8\x75\xde\x10\xff\x1a\x0f\x8b\xa2\x73\xd0\x35\x0c\x5d\x5e\x14\x68\xa4\x9b\x90\x71\xe2\x89\xb2\x66\xc9\x8f\x92\x04\xc9\x2d\xa2\x14\x3b\x28\x16\x8f\x22\xca\x6b\xec\xba\xb2\x52\x44\xc2\xdf\x28\x74\x30\xf2\xb9\xac\x8c\x6a\x85\x9e\x96\x14\xda\xee\x4c\x51\x68\x5b\x29\xc5\x08\x3b\x0e\xf2\x3f\x21\x8a\x35\x52\x13\xda\x3e\x39\xc9\x7e\xbb\x68\x88\x7c\xa7\x58\x0e\x78\x3b\x2c\x3f\x27\x64\x43\x69\x5c\x8d\xf2\x95\xa8\x4b\xff\xe4\x05\x7c\xac\xef\xed\xff\x8b\x28\x51\xaf\x78\xf0\x5e\x93\x95\x87\x7d\x4d\x2a\x1b\x91\x3b\x35\x07\x4e\x38\x74\x35\x77\xdf\x42\x37\xcc\x6b\xae\x88\xc7\xc5\xbe\xb8\x2a\xe7\x26\x12\xef\xb0\xc3\x0b\x7d\xaa\xd4\xa3\x45\x52\xd4\x2d\x2f\x09\xf6\xf9\x07\x22\xc6\x31\x91\x90\xb7\xb8\x00\x51\x1b\xf9\x1c\x0e\x91\x22\xf9\xc0\x0d\x87\xd8\xff\x1d\x51\x86\x49\x54\x49\xeb\xe4\x65\xfb\x65\x5b\x7a\x34\xca\x35\x6a\x2b\x61\xf4\xca\x4e\x31\x5d\x55\x24\x45\xbe\x83\x28\x12\x03\xe6\xb5\x4b\x78\x9e\x11\x13\x9a\xff\x9f\x42\x53\xcd\x2f\x06\xd0\x46\xba\x5e\xc6\x38\xb4\x6f\xca\x62\x61\x1c\x05\x01\x72\xde\x63\x5f\xad\x0f\x87\x74\x88\x38\x93\x4c\x07\x28\x76\x26\x74\x1f\x88\xd2\xb1\xd0\x3b\xa0\x90\xa3\x03\x0a\xb1\xcb\xfa\x14\x7d\x0d\x11\xe3\xac\x2f\xf4\xf7\x10\x0d\xe6\xa2\x50\xaf\x9e\x3f\xcb\xfe\x7e\xde\x08\x88\xf3\xea\xcf\xe7\x6c\xcc\x38\xf2\x9a\x30\x08\x9a\x5f\x60\xf3\x5b\xab\x79\x76\xf5\xd7\xfc\xaf\xe7\x8f\x5f\xda\xde\xd5\x8b\x17\x60\x30\x06\x07\x8c\x43\x1e\x32\xd9\x4e\x44\xfd\x8f\x50\x0f\x46\x4d\xd6\xe2\xd8\x43\xfd\x58\x32\xc5\x5b\xb0\xcf\x11\xbd\x15\x0d\xc9\x6a\x7b\xfa\x6b\xef\xa0\xcd\x85\x69\x6a\xb7\x0a\xd7\xe3\xce\xf3\x2e\x7b\xc9\xc3\xc3\xbf\x1e\x1e\xe2\x82\x3c\x3e\xfe\xeb\xf1\xb1\x98\x1b\x45\xd7\xc2\xa0\x58\xaf\xad\x7c\x70\x49\xfe\x92\x86\xda\x11\x45\x6c\x44\x5c\x47\x19\x82\x3d\xf4\x8e\x8a\xc1\xa9\x60\x1a\xa2\xf4\x8f\x68\x98\xd8\xf4\xd2\x03\x9f\x46\xf8\x9a\xab\x4f\x24\x83\xf9\x27\x21\x5d\x90\x2a\x04\x04\x88\x02\x86\x6c\xe2\x3b\xe0\x60\x30\x06\x65\x89\x5a\x3c\xb3\x7d\x0f\x72\xdf\x84\x54\xd8\xb2\x52\xef\x64\x84\x72\xb9\x1d\xa7\x1d\xb3\x9f\x5a\x0d\xec\x3b\xf8\x16\x3b\x21\x74\x2d\xa5\x8f\xa6\xf7\x08\x93\x9b\x17\xe0\x1e\xde\xe3\xd2\x98\x39\x08\xed\x9b\xb8\x15\xca\x75\x8c\x46\x92\xa4\x7f\x46\x62\xd0\xc0\x43\xe9\x6e\xfd\x08\x93\x8d\x24\x9a\xb1\x7e\x0c\xef\xd1\x84\xc6\xef\x20\x1b\x7b\x50\xd8\xd1\x76\x45\x93\xa4\xe8\x6b\x50\x6a\x8c\x2e\x1c\x20\xd1\x12\x4b\xc9\x64\x78\x01\x19\x52\xf2\x8a\xc7\xd0\x62\x55\xb2\x41\xd4\x6a\x15\x33\x91\xaa\xa8\xb3\x6d\x0f\xfa\x42\xb2\x51\xa4\x47\x6d\x21\x95\xf7\x2e\x54\x4c\x25\x59\x5b\x4e\xa5\x9b\x8c\xd5\xa6\x00\x5d\x3c\xd4\x59\x0f\x91\xfe\x1e\xdd\x66\x85\x2e\x50\xa0\x61\x96\xd5\x33\x4b\x21\x61\x61\x68\x39\x35\xd0\x62\xa0\xe5\x69\x41\x8b\x4d\x7c\x4e\x89\xeb\x22\xba\x05\xe0\x92\x17\xe6\x09\xc0\x8b\x4e\xb2\x06\x60\x0c\xc0\xd4\x0f\x30\x33\x15\xd3\xf0\xcb\x2e\xf3\x4b\xd9\xe7\x72\xb6\x08\xbe\x9c\x19\x7c\x31\xf8\xb2\xfb\xf8\xd2\x88\xdd\x02\xaf\xfe\x7c\xde\xf9\x12\xa5\xfc\x68\x80\xa6\x56\xa0\xe9\xdc\xdf\x1b\xa8\xc9\x6a\x61\xa0\x46\x5b\x48\xe3\x95\x31\x54\x93\x5c\x98\x99\x6a\x8e\x4b\x50\xa3\x38\x65\x16\xa1\x9a\xd3\x96\xa1\x9a\x35\x50\x4d\xe1\x0d\x3b\x02\x35\xc5\xb7\xec\x0c\xd5\x74\x0d\xd5\xac\x80\x6a\xba\x86\x6a\xa4\x5a\x18\xaa\xd1\x16\xd2\x50\x8d\xa1\x9a\xe4\xc2\xe2\x54\x73\x5a\x07\xd5\xb4\x0d\xd5\x18\x5f\xcd\x93\xc2\x9a\x9e\xc1\x9a\x15\x60\x4d\xcf\x60\x8d\x54\x0b\x83\x35\xda\x42\x1a\xac\x31\x58\x93\x5c\xa8\xc4\x9a\x93\x12\xd6\x4c\x8d\xe3\x6e\x9f\x2c\xc2\x35\x47\x86\x6b\x0c\xd7\x6c\x98\x6b\xfa\x4e\x48\xc5\xa6\x8d\x7e\x6c\x2c\x59\x9f\x85\x5e\x2d\x11\x35\x87\x60\xd6\x37\xda\x24\xf4\x79\x1d\xef\xdc\x28\x37\x7d\x16\x1d\x61\x3a\x28\x55\x58\xa8\x0a\x35\x39\x83\x3e\x0d\xfd\xa4\x02\x2b\x56\x90\xe6\x5d\x4f\x43\x35\xaf\x6d\x8e\x6f\xd1\x47\x64\x13\xea\x54\x68\xe8\x62\x61\x0d\xdd\x62\x74\xb7\x3e\x1d\x69\xdf\xf6\x34\xb4\xf4\x3b\x46\x77\x15\xda\x79\xb3\x25\x13\x8d\x8f\x91\x0e\x00\xbc\x45\x14\x0e\x11\xa0\x88\x05\xc4\x67\x08\x14\x90\xdc\xcc\x2a\x16\x9b\x55\x28\xdc\x2c\x31\xbb\x99\x54\x6c\xd9\xa4\xc2\x86\xd4\x29\x65\x1c\x25\x5d\x42\xc7\xc1\xfe\x50\x6d\x38\xd1\xc5\x8f\x24\xf4\x9d\x52\xe6\x59\x49\xed\x64\xbb\x69\x29\xc3\x6c\x17\xea\xf7\xbd\x93\xb3\xee\xbb\x8e\xdc\x44\xc5\x23\x9f\x6c\x18\x77\x4d\xf6\xb5\xa0\x81\xf4\xea\x08\x79\x49\x37\xe2\x88\x06\xc4\x85\x1c\x5d\x88\xd6\x2a\xdd\x8a\xee\x03\xe2\x27\x93\x83\x97\x3d\x4d\xe7\x20\x01\xb4\x31\x1f\xab\x1d\x30\x9a\xef\xe4\x03\x18\x67\x69\x37\x9b\x67\x7e\xe4\x20\x66\x53\x1c\xf0\x84\x47\x37\x37\x71\x9a\xd9\xcb\x3b\x75\x3a\xd4\xe9\xaa\xd3\x21\x04\xb9\x07\x83\xe2\x0c\x34\x9d\x97\x5c\x64\xa3\x53\x91\x6d\x47\x78\x38\x72\xf1\x70\xc4\xdf\x24\xcd\xad\x30\x5b\x88\x27\x51\x93\x37\x4f\x26\xdd\xa4\x72\x0e\x52\x9e\x58\x50\x74\x8b\x28\x43\x7f\x54\x95\xa8\x76\xda\x8e\x5b\x4c\x6d\x21\xec\x2e\xaa\xb4\xe1\xa9\x06\x56\x66\xbf\x85\xe3\xd0\x45\x8b\x3b\x0c\x27\xd8\xeb\x29\x66\x59\xe3\xf4\x4b\xaa\x2b\x9c\x7f\x05\x43\x0d\x62\x91\x03\xec\x27\xb7\xce\xb0\xa9\x4e\x67\xf5\xa2\xd4\x9f\x31\xe3\x64\x48\xa1\x57\xd9\xc6\x52\x13\x5d\x96\xbe\x75\xff\x5a\x19\x9a\xd5\x31\x3d\xcf\xe7\x3e\x6e\x91\xbf\x84\xde\x40\xcc\x36\x0b\x82\x48\x2e\x7e\xc2\xdf\x94\xbd\xea\x63\xf5\x35\x92\xcd\x95\x3b\xae\xde\xdc\xea\xcd\x95\xd6\x58\x69\x4d\x55\x95\xf0\x02\x17\xf3\xac\x61\x69\x2d\xc2\x38\xae\xd4\x45\x62\x35\x2c\x18\x72\x62\x95\xaf\xea\xe5\x31\x56\xe4\xa1\x37\x62\xd2\xde\xff\xc2\xc8\xb2\x8e\xad\xff\x47\x1d\xa5\xc6\xf1\xe9\x05\x2d\xc9\xe9\x50\x3c\xb4\x00\x94\x07\x1b\xd5\x0a\x83\x69\x96\x18\x4c\xb0\xc6\xa0\x68\x2f\xb4\x56\x19\xcc\x60\x99\xc1\x54\xeb\x0c\xe6\xb3\xd0\x60\x92\x95\x06\x13\x2c\xb5\x5a\xa3\x69\x16\x1b\xcc\x66\xb5\xc1\x24\xcb\x0d\x26\x59\x6f\x30\xc1\x82\x83\x2a\x2b\xae\xd4\xa3\xca\x9a\xab\x15\xd6\xb5\x58\x00\x54\x47\x27\x00\x9a\x78\x7b\xa0\x69\xbd\x20\x6b\xc1\x47\x95\x2f\xd5\x59\x7b\x30\x83\xc5\x07\x53\xad\x3e\x90\x3a\xca\x51\xa5\x29\x3b\x6a\x95\xa7\x0c\x2a\x1b\x80\x0a\x3e\x50\x6b\xa3\x72\x02\x48\xc6\xc0\xb7\x90\xc3\xcb\xd4\x33\xd8\x6d\xb5\x4a\x56\x2f\x40\x71\x33\xcb\x17\x9f\xca\x76\x71\x22\x6d\x80\x2a\xe2\x00\xa5\x81\x00\x6c\x88\x3c\x1a\x79\xc5\x5e\xfd\xd3\x7a\x96\xff\xfa\xa7\x35\x09\x4a\xc0\x74\x30\x51\x34\x5a\x82\x13\x30\x03\xa0\x80\xb9\x20\x05\x54\x81\x0a\x90\x60\x05\xc8\xc0\x02\x26\x40\x0b\x98\x04\x2e\xa0\x00\x2f\xcf\xaa\x5a\x87\x8e\x45\x40\xa5\x49\x05\xd3\x98\x04\x28\xed\xba\x8a\x4d\x80\x9e\x4f\xca\xef\xae\xcc\x77\x12\xab\x80\xc9\xbc\x02\xf4\xcc\x02\xaa\xb9\x05\x54\xb3\x0b\x98\x30\xdd\xae\x9c\x70\x57\x4e\xb9\x27\x0b\xbe\x8a\x67\x54\xe9\x4c\xe4\x1a\x30\x8d\x6d\xc0\x04\xbe\x01\x5a\xac\xce\x86\x21\x3d\x3b\xbf\x0e\x02\x20\xad\x8e\x83\x84\xa5\x6b\x40\xe9\x69\xe7\x11\x6d\xf6\xe8\xa5\xcc\x7a\x95\xf8\xab\xa7\xc3\x2f\xcd\xd9\x4b\x9f\xb0\x83\x6e\xf0\xd7\x39\xea\xbb\xe9\x85\xd8\xad\x71\x34\xac\x20\xf0\x6c\xba\xba\x35\x2e\x89\xa9\x2b\xb4\xdd\xb5\xed\x12\x34\xeb\xb0\xfb\xb7\x0e\xcb\xe2\x11\xa4\xff\x6f\x32\x60\x7d\x16\xda\x36\x62\x93\xa3\xcc\xb6\x61\x91\xe6\x53\x5c\xce\xeb\xd0\x05\x51\xb9\x6b\x5c\xef\x2c\x88\xe3\x1a\x62\x17\x39\xf3\x4b\xa3\xce\xaa\xbe\x13\x65\x98\x54\xcd\x8b\x2d\x59\x96\xfa\x07\x19\x30\x10\x50\x12\x69\x06\x39\x20\x9a\x55\x8c\x13\x8b\x6c\x96\xa5\x96\x5c\x96\x6a\x55\xf4\x36\x52\x15\xea\xb6\xc6\x73\x0c\x2a\xba\x96\x39\x6e\xc1\x20\xd6\x06\xce\x61\xe8\xaa\x1e\xcd\xe9\x88\x75\xb6\xb6\x20\x38\x83\x58\x06\xb1\x66\x45\x2c\xe1\xb6\xfa\x1a\xa2\xb0\x7a\x39\x6d\x2d\xb4\x75\x99\xd9\x74\xec\x03\xe1\xba\x12\x85\x9a\x75\x89\x6d\xc5\xe8\x55\x29\xa5\x15\x50\xd8\xcc\xf5\xdf\x52\x26\x1b\x8c\x81\x28\xba\xe1\x31\xc3\x63\x2b\x2c\xe6\xb6\xc5\x09\x19\x20\xcb\xde\xb7\x81\x83\x3d\x17\x23\xb2\xae\x21\x32\x43\x64\x5b\x49\x64\x77\x84\xde\x6c\xc5\xf6\xc8\xb8\x20\xbb\xb0\x35\x52\xc5\x90\x7f\x93\x01\x10\x44\x60\x48\xc4\x90\x88\x21\x11\x43\x22\x6b\x70\x0d\xf5\xd4\x0f\xdd\xcc\x00\x22\xc7\x06\x44\x0c\x88\xd4\x0c\x22\x1e\xbc\x2f\x32\xc8\x1d\xc4\x1c\xfb\xc3\x89\x7b\xa8\x1e\xb7\xc6\x21\x34\xbf\x0f\x68\x93\xf0\xf1\x6b\x54\x50\xc0\xf0\x37\x49\x24\x86\x36\xea\xa5\x0d\x9f\xf8\xc8\xe0\x86\xc1\x8d\x7d\xc6\x0d\xc5\xf1\xb1\x18\x6f\xf4\x0c\x6f\x18\xde\x58\x21\x6f\x08\xbb\xdd\x77\x21\x47\xbe\x3d\x36\xa8\x51\x3f\x6a\x30\x90\x08\xd7\xd0\xc6\x8a\x68\xc3\x78\x36\x0c\x6a\xec\x35\x6a\x28\x9e\x8d\xd3\x45\x48\xa3\xde\x2f\xbe\x1a\x9c\xd8\x1b\x9c\x18\xa5\x9b\x7b\xfa\x5f\x43\xe8\x73\xec\xa2\x83\xd6\xcb\xb3\x5e\x03\xe8\x16\x58\x94\x73\x62\x26\x6e\xf9\x2a\xed\xd8\x7a\x01\x96\xa2\x8f\xe5\xd8\xe3\xac\xf7\x03\x48\x2b\x08\xc8\x35\x48\x2a\x05\xb6\x1c\x42\xfe\x41\x06\xe9\x5a\x0b\xf6\x87\xd9\x39\x31\xe6\x78\x18\x43\x24\x86\x48\x0c\x91\xac\xcb\xf9\xb1\x10\x92\xd4\xfb\x3d\x57\x83\x24\x06\x49\xea\x45\x92\x38\xe4\xa2\x01\x36\x8d\x26\xbb\x17\xff\x51\x85\x24\x26\x18\x04\x18\x3c\x31\x78\x62\xf0\x64\xad\x0e\x93\xe3\xe3\x45\xe8\xc4\x6c\xc4\x36\xe0\xb2\x9e\x98\x54\xe4\x8b\x35\x8f\xe5\x36\xc0\xac\x9f\x48\x76\x66\xa1\x26\x39\x37\x43\x6c\xab\x06\x41\xc8\x46\x66\x73\xcc\xea\x29\xc4\x04\x89\x18\x10\xd9\x77\x10\x51\xfc\x24\x0b\x91\x48\xbb\xb5\xb6\x6f\xac\x1a\x14\xd9\x1b\x14\x51\xa2\x52\x29\xe2\x74\x3c\x25\x26\x75\x83\xc8\xb1\x4b\x8c\x11\x89\x12\x23\x73\x10\x8a\x61\x8b\xbd\x62\x8b\xcd\x9e\xae\x77\xa2\x1e\xb7\x16\x9b\xcf\xfc\xb8\x0f\xdd\xe9\x7a\xf9\x91\xa9\x0e\x0a\x5c\x32\xf6\x22\xa3\xa9\xf6\xef\x4b\xe2\x30\x70\xf0\x2c\xbf\x67\xae\x63\x07\xa1\x3d\x42\x9f\xb1\x87\x48\xa8\x8c\x20\xe2\x48\xe2\x0b\x68\xdf\x0c\x69\x72\x3c\x63\xc1\x06\x8a\xcb\xbf\x47\xbd\x49\x11\xaa\x9d\x52\x5c\xde\x91\xac\xef\xdf\x75\xba\x67\xbd\x37\x72\xb7\xa5\xc3\x01\x3c\xe8\x1c\x9d\x34\x40\xbb\x73\xd6\x00\xdd\x56\x03\xb4\x5e\x9e\x9e\xc9\x63\xb9\xf5\x7d\xe7\xec\xcc\xee\x1e\x5b\x4a\x5b\x99\x89\xe8\xd4\xfe\xba\x7e\x9a\xd3\x8f\x0d\xd6\x10\x86\xc2\xea\x3f\x14\x18\x27\x95\x67\x5b\x3e\x3e\x37\xea\x54\xe9\x85\x96\x3a\xc0\x95\xbb\x46\x66\x5b\xde\x47\x7d\x5a\x41\x01\xf9\x8e\x0f\x90\xde\x20\xca\xaa\xce\x59\xaf\xec\x02\x47\xa5\x2e\x50\xfe\xd4\xad\xd2\x03\x7a\x3a\xba\x14\x0d\x2f\x05\x0f\xed\x07\x0d\x8e\xf3\xed\xd7\x5a\x7b\xad\x43\x2e\x0f\x06\x01\xf6\x87\x9f\xe3\xa6\xdf\xd6\xa5\x4f\x18\xe3\x13\x53\x12\x9b\x09\xc0\x09\xe0\xe8\xbe\x34\x54\xde\xa6\x3a\x9a\x3a\xe2\xa6\x99\x51\xe8\x0f\xa7\x64\xd6\x99\x30\x2c\x96\xcf\x56\x96\xb1\x5b\x01\x4c\x9b\xf8\x3e\xb2\xb9\x6c\xe3\xa3\x7b\x3e\x47\x6f\x2e\x75\x70\x05\x31\x8f\x5f\x76\x5e\x76\x65\xc4\x64\xfc\x1a\xdf\x17\x25\x9e\x24\xbe\x23\x7e\x7a\xe4\xaa\xd5\x6b\xfd\x20\x5d\xa7\x48\x7d\x46\xa4\x55\x3e\x22\xc4\xf3\x01\x06\x13\xd4\x72\x1d\x33\x51\x11\x9f\xc5\x15\x1e\x57\xcc\xfa\xe5\xf0\x75\xe9\x02\xc9\x1e\x98\x20\x5b\x16\x40\x7a\xe3\xc6\xbc\x2a\x35\xf2\x68\xca\x95\x1d\xea\x2e\xc6\xa9\xa3\x76\x03\xb4\xdb\xa7\x0d\xd0\x3e\x3d\x8b\xc6\xa9\xf6\x69\x61\x9c\xba\x0e\x5d\xdd\xe4\x22\xca\x59\xce\x27\xce\xa6\xd3\x6a\x80\xf6\xd9\x51\x21\x83\x89\x1f\xe7\xe0\x70\xe0\x46\xf9\x84\x5e\x69\x6e\x3a\x97\x7f\xef\x26\x1c\xa0\x7e\x6e\x27\xfa\xf1\x17\x78\xfb\x14\x05\x2e\xb6\x21\xeb\xc3\x5b\x88\xdd\xe8\x4d\x55\xe7\x60\xe7\xcf\xbe\xfa\xf3\xb9\x64\x71\x9e\x3f\xbe\x00\x84\x02\x91\x7f\x94\x29\xba\x0e\x5d\x86\x94\x17\x54\x65\x2b\x3d\xf2\xaa\x98\xed\x4a\xa9\x7e\xb5\x50\x6f\xb5\x1b\x1d\x4b\xc7\xf5\x85\xf3\xd8\x0b\xfc\x5e\xbe\x92\x7e\xd0\x23\xf4\x7d\xec\x0f\x41\x40\x1c\xa6\x9a\x76\x86\xfd\xa1\x8b\x22\x11\xe6\xd7\xc4\xa0\x22\x77\xb7\x53\xb9\xbb\x89\xab\x93\xbb\x1b\x89\x26\x04\xd6\x2b\x7d\x4f\x6b\xe9\x47\xb0\xa9\x5d\x4d\xdc\xf8\x4b\x32\x24\xc2\xdb\xe1\x6a\x88\xe4\x32\x1d\x7f\x34\x48\x32\x07\xad\x24\xd8\x31\x2f\xad\x24\x90\x63\x68\x65\x97\x68\xe5\xb8\x2e\x5a\xe9\xe9\x68\xa5\xd0\x82\x0d\xaf\x2c\xc3\x2b\x86\x47\xf6\x8a\x47\x42\x7f\x59\x22\x39\x98\x86\x24\x60\x7e\x26\x01\xcd\xa9\xa0\xd3\xa7\x08\x3a\xe3\x05\xf2\x5e\xad\x1b\x73\x57\x80\xe7\xb7\x5c\xef\x06\x7a\x8c\x1b\x26\xa9\x8c\x01\x9b\xb9\xc1\x46\x59\xe5\x5b\x98\x6c\x8e\x8d\x1f\xc6\xf8\x61\x0c\xf7\x2c\xcf\x3d\x62\x15\xf3\x20\xfd\xd7\xe7\x10\xfb\x88\xf6\x3d\xe4\x11\x3a\xee\xdf\x11\x7a\x83\xfd\x61\x3f\x42\x8a\xc1\x98\xa3\x4a\x8f\x49\xfc\x41\x32\x09\x1d\x9a\x2f\x7f\x4c\x77\xcb\xfb\xc4\x41\x4b\x7e\x17\xe3\xa9\x80\x84\x58\x1a\x71\x30\xe3\x14\x0f\x42\x8e\x1c\x40\x7c\x30\x22\x8c\x1b\xa2\xa8\x93\x28\x16\x74\x95\x38\xdd\x2e\x3c\x82\x86\x28\x76\x8b\x28\x4e\x6b\x22\x8a\x23\x2d\x51\x18\x5f\x89\xf1\x95\x18\x66\x48\xb3\x2d\x04\x44\x85\xde\x81\x83\x5c\x0e\x63\x97\x46\x40\x9c\x7e\x8e\x0f\x99\xfb\x81\x71\x48\xf9\xe4\x23\x84\x2b\xd8\xe1\x4b\xcf\xbb\x7a\x91\x04\x71\x07\xc4\xd9\x3e\x7e\x98\xfd\x53\x06\xf5\xf1\xc3\x07\x78\x2f\x1c\x10\x20\x95\x2c\x38\x70\x21\xe3\xa0\x07\x3c\xec\x87\x1c\xe9\xbe\xf0\xf8\xd4\x40\x62\xd3\x61\xb9\x3b\x18\x7d\x7b\x52\x32\xa2\xd3\x03\x84\x16\xd9\xa4\xdc\x96\x02\x4f\x57\x1b\x7b\x9b\x7e\xa7\xfa\xc9\x44\xe5\x7a\xf0\xfe\x12\xd1\x8f\xa2\x3c\x47\xd5\xe6\x36\x4a\x00\x90\x81\x6f\x51\xdd\x73\x83\x58\x57\xcc\x6e\xaf\x98\x3e\x4f\xcc\xae\xfe\x33\xb6\x71\xea\x5b\x4c\x91\x9d\x44\xb1\x17\x2e\x6f\x5d\xa0\xef\x14\xff\xff\xfc\x3e\xff\x59\x82\x10\x16\xf0\xca\xaf\xd2\x16\x76\x26\xda\x42\xb1\x9b\x29\x2f\x8a\xd8\xd2\xd4\x14\x5d\xa6\x59\x70\x8c\x4b\x0f\xbf\xc7\xfe\x8d\x2e\x02\x53\x9a\x88\x17\xd2\x23\x4d\x0a\x8d\x4f\x25\xde\x19\xd5\xb6\x4c\x18\xc9\x2c\x0a\x5c\x78\x71\x65\x73\x48\xa3\x55\xa3\x10\x93\x46\x8d\x5a\xe2\x59\x52\x25\x4b\xad\xa4\x99\x85\xb4\xe5\x95\x9d\x28\x60\x92\xba\xdf\xcc\xae\xee\xa7\xef\x44\x0c\x19\x72\x9a\x45\x57\x5d\x51\x5a\x6f\xb7\x64\x77\xc5\x25\x71\x80\x50\x04\x38\x10\xe3\x72\x03\x08\x45\x37\x40\xe8\x47\xff\xbf\x00\xd0\x77\xe2\x39\x84\xa8\x4d\xee\x8d\x8c\x0c\x74\x9e\x9d\xd9\x85\x51\xeb\x39\x13\xab\xde\xdf\x50\x7c\xf1\x8e\x6d\xc2\x90\x89\x7a\xaf\x76\x78\xee\xe0\x54\xf2\x78\xde\xa9\xe4\xa9\xd6\x1f\x3b\xed\x44\x09\x33\x93\x5c\xfd\x4c\x72\x05\x33\xc8\x25\x76\x7d\x3e\x8d\x19\xe4\x7a\xbc\xa2\x5b\xe0\x14\x15\x50\x19\x10\x67\x17\x0e\xb5\xb8\x9c\xd5\x63\xba\x00\xf4\xb4\xf6\x14\x7a\xb6\xed\x2b\xf6\xdb\x4b\x37\xfb\xb9\xc5\xf4\xac\xad\xb4\x28\x61\xd9\xf3\xfd\x73\x4b\xec\x30\x7d\x73\xf9\x1b\xf8\x8d\xc1\x21\x5a\x78\x9b\xa9\x21\xbf\xd5\x2f\x22\x9c\x2d\xf2\x7d\xdb\x93\x7a\x0f\xf0\x78\xfa\x14\xb7\xdb\xeb\x01\x96\x64\xfd\xb6\x12\xe6\x7c\xe2\xa0\x7e\x86\x65\x45\xa0\x3b\xcf\xd1\xce\x0e\xc2\x7e\x18\x8d\x48\xd9\xd1\xa9\xa2\xdd\x9c\xb3\xd0\xeb\x53\xc8\x65\x27\xe7\x9f\x33\xfb\xbc\x56\x4d\x78\x33\xb8\xfa\xf5\x84\x57\x9b\x63\x7f\x93\x54\x98\x19\x11\xe3\xf5\xda\x72\x00\x34\xce\x2d\x29\x7d\x57\xf8\xef\x4c\xcf\x7f\xbd\xba\xf8\xef\xd7\x90\x70\xb8\x26\xfe\xb3\x45\xb0\x54\xa9\xac\x4f\x11\x0a\xa5\x88\xa0\x76\x4b\x0e\x09\xaa\x8f\x0a\xdb\x2d\xf5\xeb\x7f\x71\xf8\x88\x01\xbf\x65\xc1\x0f\x0e\x51\xa2\xbe\x62\xe8\xe5\x04\x20\xdc\x28\xf0\xd9\x94\x88\x16\x58\x90\xd5\x64\x0c\x1c\x91\xbb\x9f\x11\x74\x44\x11\x8a\x8f\xc5\xe6\x58\x6a\x32\x36\x71\x4b\x23\x97\x83\x98\x5d\xa9\xb8\x1a\x09\x93\xf1\xb1\x3b\xc9\x76\x8a\xd1\x27\x12\xc6\xe7\xa2\xed\x96\xc6\x7e\x0b\x86\x9c\x14\x2f\x39\x90\xa3\x1c\xcf\xfe\xf8\xe3\x8f\x3f\x9a\x1f\x3e\x34\xdf\xbe\x05\x3f\xff\x7c\xee\x79\xe7\xac\x04\x82\x01\xe4\x1c\x51\x5f\xff\x9a\x74\x80\x8c\x27\x64\xd3\x17\x7a\xb3\x12\xab\xd4\x34\xad\xd8\x22\x44\x3f\x69\xb8\x8a\x91\xcc\xe3\xf7\xaf\x96\xa9\xab\xb4\x04\x57\x82\xda\x98\x51\x4b\x7d\x38\xb9\xf0\x39\xc3\x3d\xeb\x2d\xc5\xae\x0b\x1c\x72\xe7\x5b\xca\x6d\xbf\x51\x0d\x4c\x4b\xd2\x15\x01\xf3\xe0\xfb\x72\xc4\xb0\x9e\x64\x0b\xd2\xf7\x43\x6f\x80\x68\xf1\xb9\xd0\xc7\x12\xb0\xcc\xa7\x98\x8f\xe8\x6b\x88\x94\x25\x6a\xa3\x9b\xef\x2f\xb6\x47\x37\xe0\x07\xa3\x9d\x92\x76\xde\xd4\xab\x9d\xc4\xdc\x89\x9f\xf3\xe9\xe8\x3d\xf6\xb0\xe9\x3d\x8a\x7e\xde\x6e\xbe\xf7\xc4\x9a\x31\x7d\x47\xd1\xcd\x4f\xdb\xd0\x77\x2e\x89\xb3\x53\x8a\x29\x92\xfe\x42\x7a\x39\x74\x0e\x8f\x7b\xa7\x6d\xd4\x3d\x46\x5d\xd4\xb3\x4f\x06\xb0\xdb\x82\xad\x93\xe3\xee\xf1\xd1\x59\x0f\x5d\x9f\x0c\x7a\xbd\xc3\x9b\x70\x80\xa8\x8f\x38\x62\x4d\x9b\x78\x41\xc8\x51\x93\xa2\x78\xd2\xc7\x9a\x01\x71\xfe\xfb\x16\xd2\x66\xee\x18\xcc\xdd\x82\x7f\x89\x2e\x04\xc4\x79\xf5\xac\xdf\xb7\x51\x79\x93\x92\xd4\x08\x82\xb2\xe0\xd7\xdd\x37\x77\x43\xed\x92\xc4\x0e\x5f\xfe\x78\x38\xbf\xc8\x18\xa7\xd8\x1f\xce\x26\xb2\xe4\x2f\xc9\x15\xf9\x24\xfc\xcd\x70\xe0\xa2\xb2\x3f\x99\x71\x28\x26\xdf\x4a\x7f\xaa\xcd\x09\x5d\x19\x59\x50\x48\x9f\x3f\x64\x3c\x3b\xed\xa7\x18\xa1\x91\x76\xcf\x3e\x4d\x68\x4d\x08\xda\x26\xb4\x10\xb4\xfa\x14\x25\x7a\x51\x87\x44\x37\xdd\x78\xc1\x21\x30\x8a\x2d\x29\xf6\xcd\xaa\xbb\x8a\x2b\xd0\x6c\x6f\xe4\xf9\x76\x4f\x3a\xca\x9e\xa9\xf5\xa7\xf5\xac\x55\x4e\x5f\x92\x14\xeb\x1a\x1b\x89\x49\xa3\xd0\x67\x91\x7a\x54\xe5\x64\x1c\x24\x92\x9b\xc4\x75\xcc\x92\xe5\xfe\x2d\x59\x7e\x07\xb6\x6e\x95\xb1\xdd\x3a\x51\x1a\x41\x7c\x3e\x58\xf6\x73\x89\x65\xc6\x0f\x62\xff\x92\x89\x34\xdb\xfa\x48\xb3\x76\x6b\x91\xfd\xea\x79\xb0\xfa\x1e\x2f\x38\xee\x50\x4c\xd9\x56\xc6\x87\xcd\xb5\xdb\x71\x06\x70\x6a\x80\x2c\xc7\xff\x7a\xf5\xdc\x44\x82\xad\x23\x12\x4c\x1e\xe7\x4d\x30\xd8\x72\x64\x25\x9a\xbc\x21\x2b\x13\x0c\x96\xfe\x16\xf6\xb9\xad\x6e\x02\x8c\xa3\xbd\x6b\xc4\x34\x13\x10\xb6\xa3\x01\x61\x6d\xf5\x4b\x9f\xf1\x89\x7c\x86\xcf\x76\x22\xf4\x6b\x53\x61\x5c\xda\x83\x24\x4d\x14\xd7\x2c\xcb\x76\x5a\xe2\x99\x56\xf2\x6d\x58\xb9\xdd\xc1\x40\x2e\x07\xd9\x31\x14\xcd\xad\x1e\x13\xce\x55\xa1\xa1\x9a\xc3\xb9\x96\xd7\x90\x09\x4c\xd9\xce\xa0\xae\x44\x4d\x26\xae\x6b\x1d\x71\x5d\x8b\x77\x23\x13\xdd\x55\xa1\x21\x13\xdd\x65\xa2\xbb\x6a\xd4\xbf\x89\xee\xd2\x8a\x6c\x7d\xd1\x5d\x5b\xe0\x2d\xde\xaa\x55\xf7\xf5\xc5\x71\x25\xf2\x5e\x40\xc6\x3b\x27\xd4\x5a\x42\xb9\xd6\xd7\x52\xe7\x09\xda\xda\x23\x2d\xae\x2b\x6e\x6b\x8f\x44\x5a\x4b\xe8\xd6\x56\x75\x8c\xfd\xd3\xe1\x56\xc4\x69\xc9\x2b\x0e\x26\x54\xcb\x84\x6a\x6d\xdd\x82\xe2\x77\x60\xfb\xd6\x00\x3b\x47\x4a\x23\x88\xf7\xfd\xd7\x72\x24\xc4\x2f\x88\x47\x03\xb1\x09\xd6\xda\xfe\x60\xad\x4e\x57\x29\xc7\x0c\x1f\x17\xc9\x97\x8a\xcd\x6a\xe0\x36\x9f\xe3\xba\x95\x51\x5a\x98\x42\x8e\x24\x74\xf3\xe3\xd1\xa2\x4f\x91\x8d\xf0\x2d\x8a\xc9\x49\x39\x8f\x75\x16\x7e\x5a\xe3\x81\xac\x0b\x07\x69\x6d\xdd\x81\xac\x1f\x63\xb1\x83\x0b\xe8\x3b\x71\xbb\x5f\x0a\xa2\xf6\x35\xea\x4a\xf2\x88\x29\x7c\x90\x37\xbd\x8b\x60\xa5\xf1\x58\x3b\x7e\x3a\xab\x39\x7b\xfe\x29\xa3\xc6\x91\x7a\x0a\xed\x0c\xa8\x71\x6a\x50\xc3\xa0\x46\xdd\xa8\x21\xe6\xec\x1e\xe6\x86\x35\xd6\xcb\x1a\x9f\x13\xb9\x1b\xd8\xa8\xcb\x23\x63\x80\x62\x3b\x80\xe2\xbb\x24\xdb\xa8\xcf\x45\xdd\xc9\x12\x41\xba\xb1\x6e\x2c\x66\x8f\x90\x07\xf3\x41\xb8\x73\x1c\x27\xf3\x71\xdc\x2d\x1c\x48\x6f\xe2\x3b\x39\x1c\xe6\x6a\xb7\x8e\x98\x0d\x33\x77\xa2\xc5\xc6\x8c\x23\xcf\xca\xde\xc4\x91\x17\xb8\x90\x63\x3f\x67\x06\xcb\xc5\x8c\x4b\xcd\x46\xb6\xd9\xf1\xb7\xb6\x65\xab\x8c\x7d\xdb\x0d\x1d\xf4\x5a\xff\x7d\x63\xad\x82\x2c\x2f\x74\x39\xd6\xdc\x9e\x7e\xf0\x5a\x43\x37\xe2\x0b\xb5\x5c\x19\x2e\x00\xb0\xbe\x86\x88\x8e\xc5\xe2\x3a\x25\x1e\xe2\x23\x14\xca\x8d\x59\x12\x65\xbb\x90\x3a\x44\xf7\xa5\x51\xd3\x62\x37\x38\xf8\x8d\xba\x9f\xc6\xbe\xad\x29\x5c\xda\xe1\xa5\xc2\x95\x7b\x5c\xa1\x05\xb8\xe9\x67\xd7\x4b\x95\xcf\xb9\xa2\xd0\xd0\x19\x72\xe3\xef\x6e\xeb\xe2\x50\xd2\xef\xf0\x3e\x3c\x80\x97\xbf\xa4\x46\x05\x94\x47\xea\xec\xb3\xbc\xca\x6d\x5a\xbc\x5b\x54\x99\x56\x66\xd6\xac\x39\x74\xaa\x7d\x48\x52\xa9\x54\x11\x59\x2e\x45\xc9\x94\x17\x27\x66\x15\xcd\x6c\xc2\xc9\x3b\xb7\xd4\xbd\xe5\x16\x36\xe1\x1d\x33\x36\x9e\x04\xb9\x67\x6b\x38\x85\xc6\x39\x7d\x02\x10\x93\x3e\xf6\x71\x7a\x30\xa7\x50\x58\x3f\xb6\x10\xf9\xa2\x13\xf6\xaf\xc9\xe4\x4f\xd4\xc4\x63\x84\x00\x94\x06\x28\x71\xc9\xd4\x56\x53\xb2\x4f\x59\xa3\xd1\xf8\x7a\x67\x19\x09\xb4\x4f\x4d\x1b\x09\x56\x52\xf1\x29\x03\xc9\xe1\xff\x1d\x7c\x81\xcd\x6f\x57\x7f\x6d\xc6\xff\xbd\x68\x1e\x7c\x69\x35\xcf\xae\xfe\x3f\xfa\x19\xfd\x11\x5f\x10\x7f\xbd\x78\x76\x38\x7b\xd3\x49\x28\x45\x7e\x29\x87\x43\xd1\x46\xd8\xaf\x69\x8d\xad\xe2\x55\x45\x34\x51\x9a\xfe\xe6\xa4\x61\xc6\xb2\x93\x2e\x84\x0c\x7d\x8e\x33\xd2\xce\x45\x66\x1a\xea\x96\x6d\xb3\x14\x62\x97\xa5\xb1\x02\x7d\x27\xa4\x30\xba\x2d\x3b\x3a\x23\x06\xab\x99\x54\x0a\x83\x20\x51\x6b\xc4\xf1\x94\xb8\x2e\xa2\xb5\x34\xeb\x3c\x3b\x5d\xb3\x2e\x3d\x95\xb6\x6a\xfd\x43\x73\xb5\xea\x35\x8a\xa6\x36\x0b\xba\x5d\x2d\x59\xfc\x1f\x41\xf3\x63\x0c\x42\x58\xe8\x26\x41\xa0\xeb\xe4\x73\xfe\x3e\xb9\x6b\xb6\x53\xbc\xb7\x38\x49\xd2\xac\xc2\x63\x01\xb6\x6f\xc4\x6c\x36\x79\x38\x11\x58\x3f\x9d\x83\xc9\xf6\x2d\xe3\x39\xf1\xe3\x48\xfe\xd1\xf6\xf2\xbf\x7b\xd2\xdf\x6d\xf9\xc7\x51\x4b\xbe\x22\x4d\x3c\x3a\xd2\xdf\x6d\x27\xb6\x30\x57\x69\xb9\xa3\xf9\xa3\x6a\x6b\xab\xdf\x22\x67\x7c\x2c\x67\x2c\xbf\xa5\xd3\x95\x7f\x48\xab\xd2\x27\x8e\x5c\xde\xb4\x2c\x05\x91\x7d\x23\x7e\x6e\xe1\xf2\xa9\x5d\xcc\xaa\xe0\x53\x02\xa9\x8f\xff\x09\x00\x00\xff\xff\xd4\xa4\x39\x98\xb8\x3d\x01\x00")
 
 func dashboardsSystemJsonTplBytes() ([]byte, error) {
@@ -244,6 +269,86 @@ func dashboardsZyncJsonTpl() (*asset, error) {
 	return a, nil
 }
 
+var _rulesApicastYamlTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x54\x4d\x6f\xd3\x40\x10\xbd\xf7\x57\x8c\xac\xa2\x26\x15\x31\xc9\xc1\x17\x4b\x45\xaa\xc4\x01\x2e\x08\x55\xed\x09\xa1\x74\x1c\x4f\x63\x83\x77\xd7\xda\x8f\x10\x93\x86\xdf\xce\xec\xc6\x4e\xd2\xe0\xd2\xa6\x02\x7c\xb0\xc7\xbb\xb3\x6f\xe6\xed\x7b\xbb\x73\xad\x5c\x6d\xd2\x93\x11\x48\x14\x94\x02\xd6\xe5\x0c\x8d\x8d\xb5\xab\xc8\x9c\x00\x84\x6f\xca\xc1\x08\xb0\x22\x6d\x53\xb8\xdc\x64\xbc\x2f\xe7\x45\xb2\x5c\x5e\xa1\x25\x9e\x05\xa0\x65\xad\x53\xb8\x0f\x31\x80\x71\x62\xa0\x79\x6a\xd0\xe2\x4d\x8d\x45\xeb\xcc\xca\x17\x31\x35\xce\xe8\x22\x5a\xad\x20\xfe\xd8\xfd\xc2\x7a\x1d\xbd\xde\xe4\x5c\xfc\x8c\x92\x38\x8e\xd6\x9f\x13\xf1\x65\x38\x84\xac\x81\xc1\x57\x95\x0d\x5b\x60\x80\x37\x2f\x02\x3f\x84\x83\x73\x98\x8c\xc7\xf0\x16\x7c\xe6\x75\xa1\xc9\x14\xaa\xca\x21\xfa\x9d\x5d\x04\x51\x12\x31\x44\x68\xe0\x4e\x31\xc9\x44\x84\xb8\xc2\x8c\xaa\xb0\x37\x81\x31\x2d\x48\x97\xb6\x49\x61\xc6\x1f\x06\xa9\xc2\x04\x4a\xa9\xb8\xbb\x52\xc9\x5d\xa6\x13\x02\x75\xb3\xdd\x49\x28\x0d\x68\xb2\x4e\xcb\x52\xce\xc1\x2a\x05\x02\x65\x03\x5c\x9e\x87\x4d\xcd\x2b\x83\x12\xfe\xc9\xc9\x30\x7a\xed\xe1\x52\xdf\xf9\xed\x19\xbf\x4e\x37\x8d\xc4\x4c\x8b\xdb\x84\x02\x3b\x38\xca\x7d\xce\xe9\x02\x2b\x47\x70\x0f\x85\x63\xdc\xf2\x87\xdf\x8e\x57\xa0\xee\x1e\x16\x80\xdc\xe9\x50\xbe\x20\x26\xc6\x4d\x25\x20\x4a\xe9\x2c\x99\xb3\xdb\x0d\xf9\x43\x03\xdc\xd4\xc6\x6a\x42\xf1\x4c\x23\xb8\x36\xfd\xdf\x38\xe1\x18\xf4\x23\xad\xd0\xc3\x93\x2d\x31\x19\x1f\xe5\x89\xef\x18\xd4\x7d\xd2\x12\xd7\xbc\xfd\x1d\x17\xb8\xfc\xf4\xc1\x40\x46\x45\x29\xf3\xad\x57\x50\xd3\xcb\xcd\xb2\x8f\x6e\xbc\x05\xfa\xdc\xb3\xa0\xff\x62\x9f\x2b\xaa\x2b\x1f\xdc\x48\x5c\x60\xc9\x4d\x54\x7d\xf6\xf9\xe6\x32\x9a\xe6\x9c\xaa\x1a\x41\xb2\x3b\xe9\x53\xdd\x2e\x9e\xba\xdd\xea\x3f\x3b\x6a\x87\xc1\xae\x6a\xef\x8d\x51\x7c\x1e\xad\x1f\xd5\xbd\xa7\x41\xd6\xfd\x40\xf6\xc9\x5f\xd2\xbd\x93\xd7\x1f\xde\x3d\x4e\xd0\x11\x7d\x54\xd2\x77\x5b\x5e\xfb\x62\xee\xd8\x76\x37\x42\x81\xfb\x6a\xf2\x60\x5f\x15\xcf\x09\x84\x62\x8b\xd9\x02\x25\x93\x7b\x28\xe2\x2f\x87\x3b\x2c\xed\x2c\x06\x00\x00")
+
+func rulesApicastYamlTplBytes() ([]byte, error) {
+	return bindataRead(
+		_rulesApicastYamlTpl,
+		"rules/apicast.yaml.tpl",
+	)
+}
+
+func rulesApicastYamlTpl() (*asset, error) {
+	bytes, err := rulesApicastYamlTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "rules/apicast.yaml.tpl", size: 1580, mode: os.FileMode(420), modTime: time.Unix(1792320870, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _rulesBackendYamlTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xdb\x6e\xd3\x40\x10\x7d\xef\x57\x8c\x0c\xa8\x69\x95\x86\x04\x29\x0f\xb1\x28\x12\xa8\x12\x08\x21\xa4\x56\x45\x3c\x20\xe4\xae\xed\x69\x6c\x62\xef\xba\x7b\x69\x1a\xda\xf2\xed\xcc\xac\xed\x5c\x5a\xa7\x2d\x52\x79\xf2\x6d\xe6\xcc\x9c\x39\x67\xc7\x53\xad\x5c\x65\xc2\x9d\x03\x90\xa2\xc4\x10\x62\x91\xcc\x50\xa6\x03\xed\x0a\x34\x3b\x00\xfe\x1a\xd2\xcd\x01\x88\x02\xb5\x0d\xe1\x43\x1d\xf1\x25\x37\x16\x25\xea\x4f\xf9\x34\x1b\x5f\x5d\x9d\x08\x8b\x14\x05\x80\x57\x95\x0e\xe1\xc6\xdf\x03\x18\x57\xf6\x34\x7d\xea\x89\x2a\x37\x4a\x0a\xab\x74\x54\x34\x99\x91\x46\x53\x29\x69\x30\x4a\x54\x8a\xe6\x9a\x1b\x30\x95\x48\xf0\x30\xb8\xbe\x86\xc1\xd7\xf6\x11\x6e\x6f\x83\x3e\xc7\xfa\xb8\xc3\x3f\xc1\x78\xb0\x1f\xdc\xfe\x18\x97\x3f\xf7\xf6\x9a\x32\x00\xaf\x9f\xad\x54\x83\x0c\xfb\x30\x1a\x0e\xe1\x1d\x70\xc0\x69\x46\x00\x99\x2a\x52\x08\xb6\xd3\x0f\x20\x18\x07\x84\xe0\x7b\x3a\x57\x34\x85\x71\xe9\xef\x0b\x11\x63\xe1\x87\xe8\x47\x82\x97\xa8\x73\xbb\x08\x21\xa1\x4b\x9e\x88\xc2\x7f\x10\x52\x2a\x2b\x6c\x4e\x5d\x86\xab\xe1\x95\x42\x2f\x96\x23\x87\x96\x0e\xe4\x06\x34\x5a\xa7\x65\x2e\xa7\x60\x95\x82\x52\xc8\x05\x50\x1f\xd0\x12\x35\x0d\x06\xb1\xa5\x32\x15\xe3\x86\x4c\xe5\x6c\xf7\x1e\x58\x26\x5a\x34\x4c\x39\xe4\xe5\xa5\x28\x1c\xc2\x0d\x64\x8e\x60\xf3\xdf\x3c\x95\x57\xa0\xce\x37\xf1\x21\x75\xda\x57\xcf\x90\x08\x1a\x0b\x63\x28\x73\xe9\x2c\x9a\xdd\xb3\x7a\x08\x2f\xe0\xfd\x52\x09\x48\x15\xa5\x10\x43\xf6\x87\xd2\xb6\x4e\x43\x39\xb5\x19\x23\xe7\xd6\xc0\x85\x43\x87\xa6\x0f\x46\x81\x80\xa9\x56\x73\x46\xf7\x2f\x3d\x16\x51\x4e\xd1\x62\x62\xa9\x49\x6a\xd8\xe7\xb7\x0c\x44\x92\x20\x51\xa4\x78\x8d\x8c\x6e\x60\x9e\xe5\x05\x52\x3d\xf8\xa5\x62\x4e\xad\xb4\x4a\xd0\x18\x4c\x3b\x9c\xfc\x5d\xe9\x19\xea\x63\xae\xf4\xb1\x2e\xfb\x7f\x8d\x4c\x9c\x8c\x8d\xec\xa2\x62\x2f\x0f\xf6\xeb\x96\xc9\xd2\x1b\x1e\x7f\xb3\xf2\x38\x79\x70\xb8\xf4\xb9\x20\xe5\xba\x7a\x99\x7b\x12\x11\xd1\x25\x04\x27\xed\x93\x2c\xfe\xf6\x70\x8b\xbb\xef\x8f\x84\xcc\x3d\xdc\x34\xf7\x68\xf8\xcc\xee\xae\x29\xb0\x5a\xec\x93\x46\xb1\xd6\x62\xde\x08\x29\xcb\xd9\xe9\xec\x7b\xa6\x9e\x21\x56\xa6\xc3\x18\xb1\xab\xbd\x17\x6f\x16\xe5\x33\xb0\x56\x95\x3d\x46\x07\x8a\xcd\x73\xd7\xe6\xa3\x61\xeb\xf3\xad\x56\xe2\x9d\xf0\x59\xc5\x27\x24\x43\x5e\x76\x6d\xc5\x8c\xba\x54\x53\x2d\xca\xe8\xc2\x09\x0a\x2a\xb0\x37\x1c\x4c\x26\xfd\xc7\x94\xd5\x35\x62\x64\x30\x51\x32\x35\x51\xec\xa8\xea\xd3\xa4\x8e\x17\xd0\x2b\xb0\x0f\x6c\x3b\x6f\xa9\x87\x84\xdf\x24\x40\xd2\x8f\xee\x48\xff\xc8\x62\x9b\x0b\xbf\x99\xfe\x55\x79\x16\x17\x84\x46\xb0\x62\xd6\x2e\xb6\x42\x35\x38\x5d\xab\xec\x94\x44\xa9\x26\x13\x68\xe6\xc2\x9b\x84\xf7\x57\xdd\xd6\x80\xa9\x52\xdb\x35\x2c\xb9\xaa\x6b\xb5\x1d\x39\xed\x7b\xa3\xb8\x76\x6d\xdd\xd5\xf4\x04\xab\x82\x7c\x6c\xbe\x49\x71\x29\x72\xc2\x2e\xba\x24\x9d\xb9\x18\xa3\x94\x42\xd5\xa2\x44\x69\x23\x43\x9c\x9d\xa1\xcd\x50\x27\x47\x6e\x95\xfd\xf0\x7e\x58\x61\xd0\x16\x68\x5c\x7a\xc0\xcb\x60\xab\x66\x1d\x0d\x76\x9c\xd6\x67\x96\x8c\x0f\xcc\x1a\x27\x68\x89\x6e\xd5\xea\x68\xc9\x6b\x5d\xa3\x15\x5b\x56\x8a\x41\x33\xb1\xfe\x13\xa2\x97\x5d\x55\x98\x13\x94\x8a\xad\x92\x09\x49\xe4\x36\xff\x3d\x7f\x01\xfa\xec\xe1\x8e\xd5\x08\x00\x00")
+
+func rulesBackendYamlTplBytes() ([]byte, error) {
+	return bindataRead(
+		_rulesBackendYamlTpl,
+		"rules/backend.yaml.tpl",
+	)
+}
+
+func rulesBackendYamlTpl() (*asset, error) {
+	bytes, err := rulesBackendYamlTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "rules/backend.yaml.tpl", size: 2261, mode: os.FileMode(420), modTime: time.Unix(1792320870, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _rulesSystemYamlTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x95\x4d\x4f\xdb\x40\x10\x86\xef\xfc\x8a\x91\xd5\x0a\x42\x93\xe0\xa8\xf2\xa1\x56\xa9\x54\x09\x55\x3d\x54\x95\x0a\xf4\x84\x90\xd9\xc4\x13\xbc\xc5\x5e\x9b\xfd\x80\x18\x08\xbf\xbd\xb3\xeb\x18\x27\xc6\x0e\x54\x2a\x39\x24\x9b\x78\xbe\x9f\x7d\x27\x97\x32\x37\x85\x0a\x77\x46\x20\x58\x86\x21\xa8\x52\x69\xcc\xc6\xd2\xa4\xa8\x76\x00\xdc\x67\x48\x87\x11\xb0\x14\xa5\x0e\xe1\xc4\x19\x7c\x2d\x8a\xef\xfc\x32\x09\x16\x8b\x63\xa6\x91\x9e\x03\xe0\xa2\x90\x21\x3c\xb8\x33\x80\x32\xd9\x9e\xa4\x47\xf4\xc6\x53\x15\x49\xbc\x36\xa8\xb4\x8a\x74\xae\x59\x7a\x6f\x73\xa9\x82\xcd\xf0\xd0\xbb\xbf\x87\xf1\xcf\xfa\x2b\x2c\x97\xde\xb0\xc8\xe3\xc3\x47\xaf\x2a\x64\xc4\x8a\x62\x74\xc6\x46\x77\xfe\xe8\xd3\xf9\x87\xe6\xe4\x0d\x95\x66\xda\x28\x32\x0c\xc6\x63\x6f\x79\x16\x64\xe7\x83\xc1\x2a\x37\xc0\xc1\x9b\xe7\x5f\x65\x84\x7d\x98\xf8\x3e\x7c\x01\x1b\xe6\x34\x91\xa8\x92\x3c\x8d\xc1\xeb\x9a\x92\x07\x5e\xe0\x51\x06\x57\xe5\x3c\xa7\x61\x05\x99\x3b\xa7\x6c\x8a\xa9\x9b\xb2\x9b\x1c\xde\xa0\xe4\xba\x0c\x61\x46\x1f\x7c\xc6\x52\xf7\x80\x09\x41\xa5\x6b\x9e\x8b\xc6\xd2\x64\x19\x93\x65\xcd\x04\xa8\x56\xe0\x0a\x24\x6a\x23\x05\x17\x97\xa0\xf3\x1c\x32\x26\x4a\xa0\x0a\xe8\x67\x55\x90\xb3\xc3\x6a\x5f\x31\x2a\x4a\x50\xd8\x88\xa1\x2d\xff\x62\x77\x2d\x4c\xc2\xea\x38\x18\xdb\x87\xef\x6e\x58\x6a\x10\x1e\x20\x31\x14\x90\xdf\xd9\x49\xbd\x87\x7c\xbe\x19\x19\x62\x23\x5d\xde\x04\xa9\x29\xa5\x21\x80\x8c\x0b\xa3\x51\xed\x5e\x54\x8d\xb7\xae\xd1\x09\x8f\xf1\x8a\x5f\xdb\x21\xfd\x32\x68\xf0\x07\x8d\x49\xcc\xca\x8e\xfb\x94\xb1\xc5\x9e\xaa\xac\xa3\x6b\x6b\x1a\xa5\x95\xed\x56\x96\xcb\x01\x4c\x4b\xd8\x73\x0e\x83\x3e\x4a\x3d\x45\x10\xae\x8f\xbe\xbf\x09\x6c\xe2\x6f\x27\x76\xcb\xdc\xe0\x5f\x0b\x6c\xd5\x90\x85\x46\xb6\x70\x85\x58\xd8\xf1\x99\x02\x6e\xb9\x4e\xdc\x18\xff\xe4\x53\x05\x5c\x00\x03\xd7\x44\x2f\xbb\x53\xb2\xa5\x9e\xe8\x8e\x5b\x17\xeb\x61\xbd\x2d\xb9\xaa\xd6\xb1\x73\xa7\x66\xaa\x38\x0e\xf0\x14\x51\x50\xc9\x74\xc9\x28\x29\xf5\xd7\x09\xfa\xc8\x48\xd7\x04\xb9\xbe\x0c\xf1\x1b\x89\xcd\x48\x7c\x69\x27\xd4\x20\x6d\x73\xd1\x9c\x7c\x30\x7e\x85\x30\x6b\xc9\xfd\x13\xd1\xb5\x8a\x08\xe8\xa4\x85\x33\x78\x13\x9c\x8e\x19\x93\x08\xb6\xb5\xda\xbf\x0b\x5a\x8f\xb0\x5c\x80\x03\x65\xe5\xb5\x1d\xe2\x5a\x8e\x3e\x36\x45\xc2\xc5\xe2\xb7\x60\x37\x64\xc6\xa6\x69\x17\x96\x2b\x33\xc5\xc8\xae\x53\x9c\x9b\x54\xa1\x8e\xaa\xd5\x4a\x3b\xb3\x48\x69\xfd\xd8\x03\x8b\xb7\xeb\x6c\xb8\xe6\x7e\x58\xaf\x4e\xe5\x72\x7b\x4b\xf8\xdc\x43\xa9\x5d\xdb\x73\x40\xff\x79\x41\x56\x15\xd5\x72\xdb\x9c\x49\x8b\xcf\xa6\x87\x55\x4b\xc2\x62\x72\x03\x37\x0c\xa8\x67\xe3\x64\x93\xe5\xc4\x41\x27\x4c\x34\xfb\x6e\x08\x2a\xa7\x42\x99\x9c\x25\xe8\xf4\xab\x56\xbb\x75\xe3\x56\xb4\x60\x1d\xaf\x82\xbe\x02\x57\x4c\xa6\x79\x99\xa1\x78\x4e\xcb\x34\xde\xdb\x99\x35\x31\x9a\xbf\xbb\xf1\x3e\xf1\xea\x51\x55\x47\x79\x44\xcc\x7f\x53\x49\xd9\xc1\xaf\x35\xf4\x34\xf7\x5e\x49\x1d\x3d\x35\xb5\xae\x9b\xa6\x55\x2b\x9e\x9a\x66\x23\x3f\xfa\xb1\x2b\x4b\x8b\xee\xa4\xf5\x77\xf6\x17\x0d\xb6\x1d\x86\x3c\x09\x00\x00")
+
+func rulesSystemYamlTplBytes() ([]byte, error) {
+	return bindataRead(
+		_rulesSystemYamlTpl,
+		"rules/system.yaml.tpl",
+	)
+}
+
+func rulesSystemYamlTpl() (*asset, error) {
+	bytes, err := rulesSystemYamlTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "rules/system.yaml.tpl", size: 2364, mode: os.FileMode(420), modTime: time.Unix(1792320870, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _rulesZyncYamlTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x94\xdf\x6b\xdb\x30\x10\xc7\xdf\xfb\x57\x1c\x66\xa3\x49\x97\x78\xe9\xc0\x0f\x33\x74\x0f\x63\x8c\xb1\x87\xc1\x4a\xf7\xb2\x52\x5c\x39\xbe\xc4\x5a\x6c\xc9\xd3\x8f\x34\x6e\xea\xfd\xed\x3b\xc9\xc9\x9c\x14\xa7\x10\x68\x17\x48\xac\x58\xa7\xef\xdd\xf7\x3e\x92\xe6\x4a\xda\x4a\xc7\x27\x63\x10\xac\xc4\x18\xee\x6b\x31\x0d\x95\x2d\x50\x9f\x00\xf8\x67\x4c\x83\x31\xb0\x02\x95\x89\xe1\x27\x4d\x7f\xe1\xf3\x3c\x5a\xad\x2e\x99\x41\x9a\x02\xc0\x55\xa5\x62\x78\xf0\x63\x00\x6d\xcb\x81\xa2\x29\xfa\xe1\x85\x4e\x14\xfe\xb6\xa8\x8d\x4e\x8c\x34\xac\x58\xbb\x24\xba\x62\x53\xbc\x08\xd6\x6b\x08\xbf\x6d\xff\x42\xd3\x04\xa3\x4a\x66\x17\x7f\x02\x57\xc1\xf8\x9a\x8d\xef\x27\xe3\xf7\x37\x6f\xba\x51\x30\xd2\x86\x19\xab\x29\x24\x0a\xc3\xa0\xb9\x8e\xca\x9b\xe1\x70\x93\x15\xe0\xed\x0b\x66\xde\xe4\x82\x33\x38\x9f\x4c\xe0\x03\x38\x81\xab\x5c\xa1\xce\x65\x91\x41\xf0\xa8\x29\x01\x04\x51\x40\xb2\xbe\xb4\x99\xa4\xde\x44\xa5\x1f\x17\x2c\xc5\xc2\xf7\xd3\x37\x0a\x97\xa8\xb8\xa9\x63\xb8\x63\x4a\x70\x31\xf7\xef\x99\x10\x54\xae\xe1\x52\x74\x81\xb6\x2c\x99\xaa\xdb\xe6\x03\xd7\xa0\xd0\x58\xbf\x02\x8c\x94\x50\x32\x51\x03\xa5\xa6\xd7\xba\xa2\x65\x9e\x9c\xfb\x64\xa8\xa7\x8a\x57\x4e\x2b\x76\x25\xdf\x9e\x7a\x81\x9c\x6d\x15\x30\x73\xaf\x5f\x2d\x59\x61\x11\x1e\x20\xb7\x24\xc5\xef\x5d\x47\x5e\x83\x9c\xed\x6b\x42\x66\x95\xcf\x98\x23\xf9\xd0\x06\x22\x28\xb9\xb0\x06\xf5\xe9\x6d\xeb\x75\x6f\x8f\x7c\xb7\xf8\x55\xa6\xfa\x23\x9b\x2e\x0a\x39\x3f\xb0\x4d\x4a\xb6\x1a\x10\xa3\xe4\x17\x45\x26\x7a\x9a\x63\x46\xfb\x2d\x3b\x92\x17\x09\x84\x67\xc1\xc8\xd4\x15\x45\x2a\x64\x59\x1d\x34\x43\x48\x6b\x18\x90\x6c\xe2\x54\x08\x5c\x2f\xb2\xfd\x1a\x89\xda\xbb\x68\xb2\xcf\xed\x7c\xf2\x8c\xe0\xa8\x50\x07\x8f\xa2\x60\x81\x58\xb9\x66\xda\x0a\xee\xb8\xc9\x7d\x53\x5d\x13\x0e\x82\xbb\xca\x51\x21\x30\xfa\x76\xc0\x9a\xc6\x1f\x56\xbf\x10\xbc\x71\xda\x0e\x74\x64\x05\x95\xc5\x8d\x93\xe7\xc2\x2b\xbb\xc4\x69\x6b\xf2\x29\x56\x9f\xe9\xd4\x6c\xcd\xfc\x0f\x56\x33\xca\x87\xd9\x91\xb0\x36\x45\xba\x23\xf6\x98\x55\xf4\xcc\xac\x7c\x5f\x5d\xcb\x67\x3b\x8d\x39\x02\x4e\xeb\x6f\x87\xd1\x16\x0b\x51\x4a\xd1\x1d\x41\xc5\x31\xeb\x25\x72\x89\x55\xc1\xa7\x4c\xff\x10\x6c\x49\x2a\x2c\x2d\xfa\x6e\xda\x85\x4d\x31\xc9\x28\x54\xd6\x25\x0a\x93\xb4\xd7\x23\xdd\x7b\xed\xe2\xc4\x76\xab\x9f\x26\xd4\x69\x6c\x40\x11\xa4\xa6\x1f\x43\x4f\x65\xc4\xe2\x25\x51\xb8\xeb\x6a\xc7\x09\x6c\xed\x1d\xc4\xf1\xe9\x9f\x1b\xcf\xa3\x2d\x22\xec\x3c\x3a\x36\x4e\x34\x67\xd9\x3e\xb0\xbe\x2c\xce\x10\x94\x92\xd8\x9a\x9c\x09\x72\xb6\x7f\xeb\xfd\x05\xb2\x0c\x97\x74\x3e\x07\x00\x00")
+
+func rulesZyncYamlTplBytes() ([]byte, error) {
+	return bindataRead(
+		_rulesZyncYamlTpl,
+		"rules/zync.yaml.tpl",
+	)
+}
+
+func rulesZyncYamlTpl() (*asset, error) {
+	bytes, err := rulesZyncYamlTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "rules/zync.yaml.tpl", size: 1854, mode: os.FileMode(420), modTime: time.Unix(1792320870, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -302,8 +407,13 @@ var _bindata = map[string]func() (*asset, error){
 	"dashboards/backend.json.tpl":          dashboardsBackendJsonTpl,
 	"dashboards/cors-proxy.json.tpl":       dashboardsCorsProxyJsonTpl,
 	"dashboards/mapping-service.json.tpl":  dashboardsMappingServiceJsonTpl,
+	"dashboards/saas-operator.json.tpl":    dashboardsSaasOperatorJsonTpl,
 	"dashboards/system.json.tpl":           dashboardsSystemJsonTpl,
 	"dashboards/zync.json.tpl":             dashboardsZyncJsonTpl,
+	"rules/apicast.yaml.tpl":               rulesApicastYamlTpl,
+	"rules/backend.yaml.tpl":               rulesBackendYamlTpl,
+	"rules/system.yaml.tpl":                rulesSystemYamlTpl,
+	"rules/zync.yaml.tpl":                  rulesZyncYamlTpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -354,9 +464,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"backend.json.tpl":          &bintree{dashboardsBackendJsonTpl, map[string]*bintree{}},
 		"cors-proxy.json.tpl":       &bintree{dashboardsCorsProxyJsonTpl, map[string]*bintree{}},
 		"mapping-service.json.tpl":  &bintree{dashboardsMappingServiceJsonTpl, map[string]*bintree{}},
+		"saas-operator.json.tpl":    &bintree{dashboardsSaasOperatorJsonTpl, map[string]*bintree{}},
 		"system.json.tpl":           &bintree{dashboardsSystemJsonTpl, map[string]*bintree{}},
 		"zync.json.tpl":             &bintree{dashboardsZyncJsonTpl, map[string]*bintree{}},
 	}},
+	"rules": &bintree{nil, map[string]*bintree{
+		"apicast.yaml.tpl": &bintree{rulesApicastYamlTpl, map[string]*bintree{}},
+		"backend.yaml.tpl": &bintree{rulesBackendYamlTpl, map[string]*bintree{}},
+		"system.yaml.tpl":  &bintree{rulesSystemYamlTpl, map[string]*bintree{}},
+		"zync.yaml.tpl":    &bintree{rulesZyncYamlTpl, map[string]*bintree{}},
+	}},
 }}
 
 // RestoreAsset restores an asset under the given directory
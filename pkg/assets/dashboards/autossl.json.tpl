@@ -74,7 +74,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(increase(autossl_domains_allowed{namespace='$namespace', pod=~'autossl.*'}[24h])) by (status)",
+                    "expr": "sum(increase(autossl_domains_allowed{namespace='$namespace', pod=~'{{ .Component }}.*'}[24h])) by (status)",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "{{`{{state}}`}}",
@@ -169,7 +169,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(increase(autossl_letsencrypt_requests{namespace='$namespace', pod=~'autossl.*'}[24h])) by (status)",
+                    "expr": "sum(increase(autossl_letsencrypt_requests{namespace='$namespace', pod=~'{{ .Component }}.*'}[24h])) by (status)",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "{{`{{state}}`}}",
@@ -255,7 +255,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(nginx_http_connections{namespace='$namespace', pod=~'autossl.*'}) by (state)",
+                    "expr": "sum(nginx_http_connections{namespace='$namespace', pod=~'{{ .Component }}.*'}) by (state)",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "{{`{{state}}`}}",
@@ -346,7 +346,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(nginx_metric_errors_total{namespace='$namespace', pod=~'autossl.*'})",
+                    "expr": "sum(nginx_metric_errors_total{namespace='$namespace', pod=~'{{ .Component }}.*'})",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "errors",
@@ -472,7 +472,7 @@
             "tableColumn": "",
             "targets": [
                 {
-                    "expr": "kube_deployment_status_replicas_available{namespace='$namespace',deployment=~'autossl.*'}",
+                    "expr": "kube_deployment_status_replicas_available{namespace='$namespace',deployment=~'{{ .Component }}.*'}",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "refId": "A"
@@ -558,7 +558,7 @@
             "tableColumn": "",
             "targets": [
                 {
-                    "expr": "kube_deployment_status_replicas_unavailable{namespace='$namespace',deployment=~'autossl.*'}",
+                    "expr": "kube_deployment_status_replicas_unavailable{namespace='$namespace',deployment=~'{{ .Component }}.*'}",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "refId": "A"
@@ -644,7 +644,7 @@
             "tableColumn": "",
             "targets": [
                 {
-                    "expr": "count(count(container_memory_working_set_bytes{namespace='$namespace',pod=~'autossl.*'}) by (node))",
+                    "expr": "count(count(container_memory_working_set_bytes{namespace='$namespace',pod=~'{{ .Component }}.*'}) by (node))",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "refId": "A"
@@ -729,7 +729,7 @@
             "tableColumn": "",
             "targets": [
                 {
-                    "expr": "max(sum(delta(kube_pod_container_status_restarts_total{namespace='$namespace',pod=~'autossl.*'}[5m])) by (pod))",
+                    "expr": "max(sum(delta(kube_pod_container_status_restarts_total{namespace='$namespace',pod=~'{{ .Component }}.*'}[5m])) by (pod))",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "",
@@ -791,7 +791,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "kube_deployment_status_replicas{namespace='$namespace',deployment=~'autossl.*'}",
+                    "expr": "kube_deployment_status_replicas{namespace='$namespace',deployment=~'{{ .Component }}.*'}",
                     "format": "time_series",
                     "intervalFactor": 2,
                     "legendFormat": "total-pods",
@@ -800,21 +800,21 @@
                     "step": 10
                 },
                 {
-                    "expr": "kube_deployment_status_replicas_available{namespace='$namespace',deployment=~'autossl.*'}",
+                    "expr": "kube_deployment_status_replicas_available{namespace='$namespace',deployment=~'{{ .Component }}.*'}",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "avail-pods",
                     "refId": "B"
                 },
                 {
-                    "expr": "kube_deployment_status_replicas_unavailable{namespace='$namespace',deployment=~'autossl.*'}",
+                    "expr": "kube_deployment_status_replicas_unavailable{namespace='$namespace',deployment=~'{{ .Component }}.*'}",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "unavail-pods",
                     "refId": "C"
                 },
                 {
-                    "expr": "count(count(container_memory_working_set_bytes{namespace='$namespace',pod=~'autossl.*'}) by (node))",
+                    "expr": "count(count(container_memory_working_set_bytes{namespace='$namespace',pod=~'{{ .Component }}.*'}) by (node))",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "used-hosts",
@@ -903,7 +903,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(delta(kube_pod_container_status_restarts_total{namespace='$namespace',pod=~'autossl.*'}[5m])) by (pod)",
+                    "expr": "sum(delta(kube_pod_container_status_restarts_total{namespace='$namespace',pod=~'{{ .Component }}.*'}[5m])) by (pod)",
                     "format": "time_series",
                     "intervalFactor": 1,
                     "legendFormat": "{{`{{pod}}`}}",
@@ -1003,7 +1003,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "time_series",
                     "intervalFactor": 2,
                     "legendFormat": "{{`{{pod}}`}}",
@@ -1217,7 +1217,7 @@
             ],
             "targets": [
                 {
-                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1226,7 +1226,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1235,7 +1235,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'autossl.*'}) by (pod) / sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod) / sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1244,7 +1244,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1253,7 +1253,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'autossl.*'}) by (pod) / sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod) / sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1351,7 +1351,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'autossl.*', container!=''}) by (pod)",
+                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*', container!=''}) by (pod)",
                     "format": "time_series",
                     "intervalFactor": 2,
                     "legendFormat": "{{`{{pod}}`}}",
@@ -1565,7 +1565,7 @@
             ],
             "targets": [
                 {
-                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'autossl.*', container!=''}) by (pod)",
+                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*', container!=''}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1574,7 +1574,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1583,7 +1583,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'autossl.*', container!=''}) by (pod) / sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*', container!=''}) by (pod) / sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1592,7 +1592,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1601,7 +1601,7 @@
                     "step": 10
                 },
                 {
-                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'autossl.*', container!=''}) by (pod) / sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace', pod=~'autossl.*'}) by (pod)",
+                    "expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*', container!=''}) by (pod) / sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace', pod=~'{{ .Component }}.*'}) by (pod)",
                     "format": "table",
                     "instant": true,
                     "intervalFactor": 2,
@@ -1698,7 +1698,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(irate(container_network_receive_bytes_total{namespace=~'$namespace', pod=~'autossl.*'}[5m])) by (pod)",
+                    "expr": "sum(irate(container_network_receive_bytes_total{namespace=~'$namespace', pod=~'{{ .Component }}.*'}[5m])) by (pod)",
                     "format": "time_series",
                     "intervalFactor": 2,
                     "legendFormat": "{{`{{pod}}`}}",
@@ -1785,7 +1785,7 @@
             "steppedLine": false,
             "targets": [
                 {
-                    "expr": "sum(irate(container_network_transmit_bytes_total{namespace=~'$namespace', pod=~'autossl.*'}[5m])) by (pod)",
+                    "expr": "sum(irate(container_network_transmit_bytes_total{namespace=~'$namespace', pod=~'{{ .Component }}.*'}[5m])) by (pod)",
                     "format": "time_series",
                     "intervalFactor": 2,
                     "legendFormat": "{{`{{pod}}`}}",
@@ -1839,7 +1839,8 @@
     "style": "dark",
     "tags": [
         "3scale",
-        "autossl",
+        "{{ .Component }}",
+        "{{ .InstanceName }}",
         "system"
     ],
     "templating": {
@@ -0,0 +1,32 @@
+groups:
+- name: apicast.rules
+  rules:
+  - alert: ApicastHigh5xxRate
+    expr: |
+      sum(rate(apicast_status{namespace="{{ .Namespace }}",status=~"5.."}[5m])) by (job)
+        / sum(rate(apicast_status{namespace="{{ .Namespace }}"}[5m])) by (job) * 100 > {{ .Threshold "ApicastHigh5xxRate" "5" }}
+    for: 5m
+    labels:
+      severity: critical
+    annotations:
+      summary: Apicast is returning too many 5xx responses
+      description: {{ `'{{ $labels.job }} has returned {{ $value | humanize }}% of 5xx responses during the last 5 minutes'` }}
+  - alert: ApicastUpstreamHigh5xxRate
+    expr: |
+      sum(rate(upstream_status{namespace="{{ .Namespace }}",status=~"5.."}[5m])) by (job)
+        / sum(rate(upstream_status{namespace="{{ .Namespace }}"}[5m])) by (job) * 100 > {{ .Threshold "ApicastUpstreamHigh5xxRate" "10" }}
+    for: 5m
+    labels:
+      severity: warning
+    annotations:
+      summary: The upstream APIs behind Apicast are returning too many 5xx responses
+      description: {{ `'The upstreams of {{ $labels.job }} have returned {{ $value | humanize }}% of 5xx responses during the last 5 minutes'` }}
+  - alert: ApicastReplicasUnavailable
+    expr: |
+      kube_deployment_status_replicas_unavailable{namespace="{{ .Namespace }}",deployment=~"apicast-.*"} > {{ .Threshold "ApicastReplicasUnavailable" "0" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: Apicast has unavailable replicas
+      description: {{ `'Deployment {{ $labels.deployment }} has had {{ $value }} unavailable replicas for more than 15 minutes'` }}
@@ -0,0 +1,43 @@
+groups:
+- name: backend.rules
+  rules:
+  - alert: BackendListenerHigh5xxRate
+    expr: |
+      sum(rate(apisonator_listener_response_codes{namespace="{{ .Namespace }}",resp_code=~"5.*"}[5m]))
+        / sum(rate(apisonator_listener_response_codes{namespace="{{ .Namespace }}"}[5m])) * 100 > {{ .Threshold "BackendListenerHigh5xxRate" "5" }}
+    for: 5m
+    labels:
+      severity: critical
+    annotations:
+      summary: Backend listener is returning too many 5xx responses
+      description: {{ `'Backend listener has returned {{ $value | humanize }}% of 5xx responses during the last 5 minutes'` }}
+  # Apisonator does not export the length of its queues, so a growing queue
+  # is detected as the listener accepting reports while no job is processed
+  - alert: BackendWorkerQueueGrowing
+    expr: |
+      sum(rate(apisonator_listener_response_codes{namespace="{{ .Namespace }}",request_type=~".*report.*",resp_code=~"2.*"}[5m])) > 0
+        and sum(rate(apisonator_worker_job_count{namespace="{{ .Namespace }}"}[5m])) <= {{ .Threshold "BackendWorkerQueueGrowing" "0" }}
+    for: 10m
+    labels:
+      severity: critical
+    annotations:
+      summary: Backend worker is not processing the queued jobs
+      description: Backend listener keeps accepting reports but the backend worker has not processed any job during the last 10 minutes
+  - alert: BackendWorkerHighJobRuntime
+    expr: |
+      histogram_quantile(0.99, sum(rate(apisonator_worker_job_runtime_seconds_bucket{namespace="{{ .Namespace }}"}[5m])) by (le, type)) > {{ .Threshold "BackendWorkerHighJobRuntime" "1" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: Backend worker jobs are taking too long
+      description: {{ `'The p99 runtime of {{ $labels.type }} jobs is {{ $value | humanizeDuration }}'` }}
+  - alert: BackendReplicasUnavailable
+    expr: |
+      kube_deployment_status_replicas_unavailable{namespace="{{ .Namespace }}",deployment=~"backend-.*"} > {{ .Threshold "BackendReplicasUnavailable" "0" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: Backend has unavailable replicas
+      description: {{ `'Deployment {{ $labels.deployment }} has had {{ $value }} unavailable replicas for more than 15 minutes'` }}
@@ -0,0 +1,49 @@
+groups:
+- name: system.rules
+  rules:
+  - alert: SystemAppHigh5xxRate
+    expr: |
+      sum(rate(rails_requests_total{namespace="{{ .Namespace }}",pod=~"system-app-[a-z0-9]+-[a-z0-9]+",status=~"5.."}[5m]))
+        / sum(rate(rails_requests_total{namespace="{{ .Namespace }}",pod=~"system-app-[a-z0-9]+-[a-z0-9]+"}[5m])) * 100 > {{ .Threshold "SystemAppHigh5xxRate" "5" }}
+    for: 5m
+    labels:
+      severity: critical
+    annotations:
+      summary: System app is returning too many 5xx responses
+      description: {{ `'System app has returned {{ $value | humanize }}% of 5xx responses during the last 5 minutes'` }}
+  - alert: SystemSidekiqHighQueueLatency
+    expr: |
+      max(sidekiq_queue_latency{namespace="{{ .Namespace }}"}) by (queue) > {{ .Threshold "SystemSidekiqHighQueueLatency" "300" }}
+    for: 10m
+    labels:
+      severity: warning
+    annotations:
+      summary: System sidekiq is not keeping up with the jobs in a queue
+      description: {{ `'The oldest job in the {{ $labels.queue }} queue has been waiting for {{ $value | humanizeDuration }}'` }}
+  - alert: SystemSidekiqHighFailureRate
+    expr: |
+      sum(rate(sidekiq_jobs_failed_total{namespace="{{ .Namespace }}"}[5m])) by (queue) > {{ .Threshold "SystemSidekiqHighFailureRate" "1" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: System sidekiq jobs are failing
+      description: {{ `'{{ $value | humanize }} jobs/s of the {{ $labels.queue }} queue are failing'` }}
+  - alert: SystemSphinxUnavailable
+    expr: |
+      kube_statefulset_status_replicas_ready{namespace="{{ .Namespace }}",statefulset="system-sphinx"} < {{ .Threshold "SystemSphinxUnavailable" "1" }}
+    for: 5m
+    labels:
+      severity: critical
+    annotations:
+      summary: System sphinx is not available
+      description: System sphinx has had no ready replicas for more than 5 minutes, so searches in system are failing
+  - alert: SystemReplicasUnavailable
+    expr: |
+      kube_deployment_status_replicas_unavailable{namespace="{{ .Namespace }}",deployment=~"system-.*"} > {{ .Threshold "SystemReplicasUnavailable" "0" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: System has unavailable replicas
+      description: {{ `'Deployment {{ $labels.deployment }} has had {{ $value }} unavailable replicas for more than 15 minutes'` }}
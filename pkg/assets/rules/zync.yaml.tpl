@@ -0,0 +1,40 @@
+groups:
+- name: zync.rules
+  rules:
+  - alert: ZyncHigh5xxRate
+    expr: |
+      sum(rate(rails_requests_total{namespace="{{ .Namespace }}",pod=~"zync-[a-z0-9]+-[a-z0-9]+",status=~"5.."}[5m]))
+        / sum(rate(rails_requests_total{namespace="{{ .Namespace }}",pod=~"zync-[a-z0-9]+-[a-z0-9]+"}[5m])) * 100 > {{ .Threshold "ZyncHigh5xxRate" "5" }}
+    for: 5m
+    labels:
+      severity: warning
+    annotations:
+      summary: Zync is returning too many 5xx responses
+      description: {{ `'Zync has returned {{ $value | humanize }}% of 5xx responses during the last 5 minutes'` }}
+  - alert: ZyncQueJobsBacklog
+    expr: |
+      sum(max(que_jobs_scheduled_total{namespace="{{ .Namespace }}",pod=~"zync-que.*",type="ready"}) by (job_name)) > {{ .Threshold "ZyncQueJobsBacklog" "250" }}
+    for: 10m
+    labels:
+      severity: warning
+    annotations:
+      summary: Zync que is not keeping up with the jobs
+      description: {{ `'There are {{ $value }} zync jobs ready to run waiting in the que backlog'` }}
+  - alert: ZyncQueJobsFailing
+    expr: |
+      sum(max(que_jobs_scheduled_total{namespace="{{ .Namespace }}",pod=~"zync-que.*",type="failed"}) by (job_name)) > {{ .Threshold "ZyncQueJobsFailing" "50" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: Zync que jobs are failing
+      description: {{ `'There are {{ $value }} failed zync jobs waiting to be retried'` }}
+  - alert: ZyncReplicasUnavailable
+    expr: |
+      kube_deployment_status_replicas_unavailable{namespace="{{ .Namespace }}",deployment=~"zync.*"} > {{ .Threshold "ZyncReplicasUnavailable" "0" }}
+    for: 15m
+    labels:
+      severity: warning
+    annotations:
+      summary: Zync has unavailable replicas
+      description: {{ `'Deployment {{ $labels.deployment }} has had {{ $value }} unavailable replicas for more than 15 minutes'` }}
@@ -0,0 +1,74 @@
+package basereconciler
+
+import (
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// AutoscalingV2GroupVersion is the GA version of the autoscaling API, which
+	// shares its schema with autoscaling/v2beta2
+	AutoscalingV2GroupVersion = schema.GroupVersion{Group: "autoscaling", Version: "v2"}
+)
+
+// WithAutoscalingV2 is an Option that, when 'enabled' is true, makes the Reconciler reconcile
+// the HorizontalPodAutoscalers using the autoscaling/v2 API instead of autoscaling/v2beta2,
+// which is no longer served since Kubernetes 1.26
+func WithAutoscalingV2(enabled bool) Option {
+	return func(o *options) {
+		o.autoscalingV2 = enabled
+	}
+}
+
+// IsAutoscalingV2Supported returns true if the cluster serves the autoscaling/v2 API
+func IsAutoscalingV2Supported(cfg *rest.Config) (bool, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return false, err
+	}
+	if _, err := dc.ServerResourcesForGroupVersion(AutoscalingV2GroupVersion.String()); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// horizontalPodAutoscalerList returns the list object used to look
+// for the HorizontalPodAutoscalers in the cluster
+func (r *Reconciler) horizontalPodAutoscalerList() client.ObjectList {
+	if !r.autoscalingV2 {
+		return &autoscalingv2beta2.HorizontalPodAutoscalerList{}
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(AutoscalingV2GroupVersion.WithKind("HorizontalPodAutoscalerList"))
+	return list
+}
+
+// withAutoscalingV2 returns the generated object as an autoscaling/v2 unstructured
+// object if it is an autoscaling/v2beta2 HorizontalPodAutoscaler. Any other object
+// is returned as is.
+func withAutoscalingV2(fn GeneratorFunction) GeneratorFunction {
+	return func() client.Object {
+		o := fn()
+		hpa, ok := o.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+		if !ok {
+			return o
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hpa)
+		if err != nil {
+			// The conversion of a typed object never fails
+			return o
+		}
+		obj := &unstructured.Unstructured{Object: u}
+		obj.SetAPIVersion(AutoscalingV2GroupVersion.String())
+		return obj
+	}
+}
@@ -0,0 +1,37 @@
+package basereconciler
+
+import (
+	"testing"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_withAutoscalingV2(t *testing.T) {
+	hpa := func() client.Object {
+		return &autoscalingv2beta2.HorizontalPodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2beta2"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+			Spec:       autoscalingv2beta2.HorizontalPodAutoscalerSpec{MaxReplicas: 3},
+		}
+	}
+	o := withAutoscalingV2(hpa)()
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		t.Fatalf("withAutoscalingV2() returned a %T, want an unstructured object", o)
+	}
+	if u.GetAPIVersion() != "autoscaling/v2" || u.GetKind() != "HorizontalPodAutoscaler" || u.GetName() != "test" {
+		t.Errorf("withAutoscalingV2() = %s %s/%s", u.GetAPIVersion(), u.GetKind(), u.GetName())
+	}
+	if max, _, _ := unstructured.NestedInt64(u.Object, "spec", "maxReplicas"); max != 3 {
+		t.Errorf("withAutoscalingV2() got maxReplicas %d, want 3", max)
+	}
+
+	svc := func() client.Object { return &corev1.Service{} }
+	if _, ok := withAutoscalingV2(svc)().(*corev1.Service); !ok {
+		t.Errorf("withAutoscalingV2() modified a resource that is not a HorizontalPodAutoscaler")
+	}
+}
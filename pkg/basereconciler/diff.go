@@ -0,0 +1,280 @@
+package basereconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/redhat-cop/operator-utils/pkg/util/lockedresourcecontroller/lockedpatch"
+	"github.com/redhat-cop/operator-utils/pkg/util/lockedresourcecontroller/lockedresource"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// ActionCreate is used when an owned resource would be created
+	ActionCreate string = "Create"
+	// ActionUpdate is used when an owned resource would be updated
+	ActionUpdate string = "Update"
+	// ActionDelete is used when an owned resource would be pruned
+	ActionDelete string = "Delete"
+)
+
+var (
+	// DryRunApplyExcludedPaths is the list of paths ignored when comparing the live
+	// resources with the result of a dry-run server-side apply, as the API server
+	// updates them on every write
+	DryRunApplyExcludedPaths []string = []string{
+		"/metadata/generation",
+		"/metadata/managedFields",
+		"/metadata/resourceVersion",
+		"/status",
+	}
+)
+
+// ResourceDiff describes the change that the reconciler would
+// apply to one of the owned resources
+type ResourceDiff struct {
+	Kind   string
+	Name   string
+	Action string
+	// Patch is the JSON patch that transforms the live resource into the
+	// desired one, ignoring the exclude paths. Only set for updates.
+	Patch []jsonpatch.Operation
+}
+
+// String returns a human readable representation of the ResourceDiff
+func (rd ResourceDiff) String() string {
+	b := &strings.Builder{}
+	switch rd.Action {
+	case ActionCreate:
+		fmt.Fprintf(b, "+ %s/%s\n", rd.Kind, rd.Name)
+	case ActionDelete:
+		fmt.Fprintf(b, "- %s/%s\n", rd.Kind, rd.Name)
+	default:
+		fmt.Fprintf(b, "~ %s/%s\n", rd.Kind, rd.Name)
+		for _, op := range rd.Patch {
+			fmt.Fprintf(b, "    %s\n", op.Json())
+		}
+	}
+	return b.String()
+}
+
+// IsDryRun returns true if the instance has the DryRunAnnotation
+func IsDryRun(instance client.Object) bool {
+	return strings.TrimSpace(instance.GetAnnotations()[saasv1alpha1.DryRunAnnotation]) == "true"
+}
+
+// DryRunAnnotationChangedPredicate triggers a reconcile whenever the
+// DryRunAnnotation of the resource changes
+type DryRunAnnotationChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update implements default UpdateEvent filter for changes in the DryRunAnnotation
+func (DryRunAnnotationChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	return e.ObjectOld.GetAnnotations()[saasv1alpha1.DryRunAnnotation] !=
+		e.ObjectNew.GetAnnotations()[saasv1alpha1.DryRunAnnotation]
+}
+
+// DiffOwnedResources computes the changes that ReconcileOwnedResources would apply to
+// the owned resources, without modifying them. Live and desired resources are compared
+// ignoring the same exclude paths the reconciler uses, so only the fields that would be
+// enforced are reported. Paused resources are never reported.
+func (r *Reconciler) DiffOwnedResources(ctx context.Context, owner client.Object, crs ControlledResources) ([]ResourceDiff, error) {
+
+	resources, err := r.desiredResources(ctx, owner, crs)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := r.diffResources(ctx, owner, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	orphaned, err := r.orphanedResources(ctx, owner, resources)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orphaned {
+		gvk, err := apiutil.GVKForObject(o, r.GetScheme())
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ResourceDiff{Kind: gvk.Kind, Name: o.GetName(), Action: ActionDelete})
+	}
+
+	return diffs, nil
+}
+
+// diffResources returns the resources that would be created and the changes that would be
+// applied to the existing ones to enforce the given resources. Paused resources are skipped.
+// In server-side apply mode the changes are computed by the API server with a dry-run apply.
+func (r *Reconciler) diffResources(ctx context.Context, owner client.Object, resources []LockedResource) ([]ResourceDiff, error) {
+	diffs := []ResourceDiff{}
+	for _, res := range resources {
+		if res.Paused {
+			continue
+		}
+		desired, err := newUnstructured(res.GeneratorFn, owner, r.GetScheme())
+		if err != nil {
+			return nil, err
+		}
+		gvk, err := apiutil.GVKForObject(res.GeneratorFn(), r.GetScheme())
+		if err != nil {
+			return nil, err
+		}
+		desired.SetGroupVersionKind(gvk)
+
+		// The live resource is read through the cache of the typed client
+		o, err := r.GetScheme().New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		exists, err := r.getIfExists(ctx, &desired, o.(client.Object))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			diffs = append(diffs, ResourceDiff{Kind: gvk.Kind, Name: desired.GetName(), Action: ActionCreate})
+			continue
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, err
+		}
+		live := &unstructured.Unstructured{Object: obj}
+		live.SetGroupVersionKind(gvk)
+
+		var patch []jsonpatch.Operation
+		if r.serverSideApply {
+			patch, err = r.dryRunApply(ctx, owner, res, live)
+		} else {
+			patch, err = jsonPatch(live, &desired, res.ExcludePaths)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(patch) > 0 {
+			diffs = append(diffs, ResourceDiff{Kind: gvk.Kind, Name: desired.GetName(), Action: ActionUpdate, Patch: patch})
+		}
+	}
+	return diffs, nil
+}
+
+// dryRunApply server-side applies the resource in dry-run mode and returns the JSON patch
+// that transforms the live resource into the one the API server would store. The fields
+// that the API server updates on every write are ignored.
+func (r *Reconciler) dryRunApply(ctx context.Context, owner client.Object, res LockedResource,
+	live *unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+
+	applied, err := applyConfiguration(res, owner, r.GetScheme())
+	if err != nil {
+		return nil, err
+	}
+	err = r.GetClient().Patch(ctx, &applied, client.Apply,
+		client.FieldOwner(FieldManager), client.ForceOwnership, client.DryRunAll)
+	if err != nil {
+		return nil, err
+	}
+	return jsonPatch(live, &applied, DryRunApplyExcludedPaths)
+}
+
+// PendingChanges summarizes a list of ResourceDiff for the status of the custom resources
+func PendingChanges(diffs []ResourceDiff) []saasv1alpha1.PendingChange {
+	if len(diffs) == 0 {
+		return nil
+	}
+	changes := make([]saasv1alpha1.PendingChange, 0, len(diffs))
+	for _, diff := range diffs {
+		change := saasv1alpha1.PendingChange{Kind: diff.Kind, Name: diff.Name, Action: diff.Action}
+		for _, op := range diff.Patch {
+			change.Paths = append(change.Paths, op.Path)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// reconcileDryRun stops enforcing the owned resources without deleting them. When using
+// the locked resources controller, the existing resources are kept in the locked set with
+// all their paths excluded, as resources dropped from the set would be deleted. Resources
+// that do not exist yet are not created and nothing is pruned.
+func (r *Reconciler) reconcileDryRun(ctx context.Context, owner client.Object, resources []LockedResource) error {
+	if r.serverSideApply {
+		return nil
+	}
+
+	existing := []LockedResource{}
+	for _, res := range resources {
+		o := res.GeneratorFn()
+		key := types.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()}
+		if err := r.GetClient().Get(ctx, key, o); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		existing = append(existing, LockedResource{
+			GeneratorFn:  res.GeneratorFn,
+			ExcludePaths: PausedExcludedPaths,
+			Paused:       true,
+		})
+	}
+
+	lockedResources, err := r.NewLockedResources(existing, owner)
+	if err != nil {
+		return err
+	}
+	if r.drift != nil {
+		err = r.drift.enforce(ctx, ownerKey{r.ownerKind(owner), client.ObjectKeyFromObject(owner)}, lockedResources)
+		if err != nil {
+			return err
+		}
+	}
+	return r.UpdateLockedResources(ctx, owner, lockedResources, []lockedpatch.LockedPatch{})
+}
+
+// jsonPatch returns the JSON patch that transforms 'live' into 'desired',
+// ignoring the given paths, sorted by path
+func jsonPatch(live, desired *unstructured.Unstructured, excludePaths []string) ([]jsonpatch.Operation, error) {
+
+	left, err := lockedresource.FilterOutPaths(live, excludePaths)
+	if err != nil {
+		return nil, err
+	}
+	right, err := lockedresource.FilterOutPaths(desired, excludePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := json.Marshal(left.Object)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(right.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := jsonpatch.CreatePatch(a, b)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(jsonpatch.ByPath(patch))
+	return patch, nil
+}
@@ -0,0 +1,212 @@
+package basereconciler
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/redhat-cop/operator-utils/pkg/util/lockedresourcecontroller/lockedresource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ownedKey identifies an owned resource
+type ownedKey struct {
+	groupKind schema.GroupKind
+	key       types.NamespacedName
+}
+
+// ownerKey identifies the owner of an owned resource, by the kind used in the metrics
+type ownerKey struct {
+	kind string
+	key  types.NamespacedName
+}
+
+// lockedState is the state of an owned resource enforced in the locked resources mode
+type lockedState struct {
+	owner ownerKey
+	// desired is the enforced resource with its exclude paths filtered out
+	desired      *unstructured.Unstructured
+	excludePaths []string
+}
+
+// appliedState is the state of the last server-side apply of an owned resource
+type appliedState struct {
+	owner ownerKey
+	// hash is the hash of the applied configuration
+	hash string
+	// version is the version of the resource returned by the apply
+	version string
+}
+
+// driftRecorder records in the drift_corrections_total metric the changes the operator
+// makes to the owned resources to revert the ones made outside of it.
+//
+// In the locked resources mode the changes are reverted by the enforcer as soon as an owned
+// resource stops matching the desired one, ignoring its exclude paths. The updates of the
+// owned resources are observed through the same shared informers the enforcer watches them
+// with, and each update that takes an owned resource out of sync is a drift correction.
+//
+// In the server-side apply mode each apply that modifies a resource whose configuration has
+// not changed since it was last applied is a drift correction.
+type driftRecorder struct {
+	mu sync.Mutex
+	// informers are the shared informers of the manager, nil outside of a manager
+	informers cache.Informers
+	// watched holds the kinds with an event handler registered in their informer
+	watched map[schema.GroupVersionKind]bool
+	locked  map[ownedKey]lockedState
+	applied map[ownedKey]appliedState
+}
+
+func newDriftRecorder(informers cache.Informers) *driftRecorder {
+	return &driftRecorder{
+		informers: informers,
+		watched:   map[schema.GroupVersionKind]bool{},
+		locked:    map[ownedKey]lockedState{},
+		applied:   map[ownedKey]appliedState{},
+	}
+}
+
+// enforce sets the resources the enforcer keeps in sync for the owner. It must be called
+// before the resources are handed to the enforcer, so the updates it makes to enforce
+// them are not taken for drift.
+func (d *driftRecorder) enforce(ctx context.Context, owner ownerKey, resources []lockedresource.LockedResource) error {
+	states := map[ownedKey]lockedState{}
+	kinds := []schema.GroupVersionKind{}
+	for idx := range resources {
+		res := &resources[idx].Unstructured
+		desired, err := lockedresource.FilterOutPaths(res, resources[idx].ExcludedPaths)
+		if err != nil {
+			return err
+		}
+		gvk := res.GroupVersionKind()
+		states[ownedKey{gvk.GroupKind(), client.ObjectKeyFromObject(res)}] = lockedState{
+			owner: owner, desired: desired, excludePaths: resources[idx].ExcludedPaths,
+		}
+		kinds = append(kinds, gvk)
+	}
+
+	d.mu.Lock()
+	for k, state := range d.locked {
+		if state.owner == owner {
+			delete(d.locked, k)
+		}
+	}
+	for k, state := range states {
+		d.locked[k] = state
+	}
+	d.mu.Unlock()
+
+	for _, gvk := range kinds {
+		if err := d.watch(ctx, gvk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watch registers the event handler of the driftRecorder in the informer of the kind
+func (d *driftRecorder) watch(ctx context.Context, gvk schema.GroupVersionKind) error {
+	if d.informers == nil {
+		return nil
+	}
+	d.mu.Lock()
+	if d.watched[gvk] {
+		d.mu.Unlock()
+		return nil
+	}
+	d.watched[gvk] = true
+	d.mu.Unlock()
+
+	// The enforcer watches the resources as unstructured objects,
+	// so the informer of the unstructured kind is shared with it
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	informer, err := d.informers.GetInformer(ctx, u)
+	if err != nil {
+		d.mu.Lock()
+		delete(d.watched, gvk)
+		d.mu.Unlock()
+		return err
+	}
+	informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{UpdateFunc: d.updated})
+	return nil
+}
+
+// updated records a drift correction if the update takes an owned resource out of sync,
+// as the enforcer reverts it right away
+func (d *driftRecorder) updated(oldObj, newObj interface{}) {
+	old, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	u, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	state, ok := d.locked[ownedKey{u.GroupVersionKind().GroupKind(), client.ObjectKeyFromObject(u)}]
+	d.mu.Unlock()
+	if !ok || !state.inSync(old) || state.inSync(u) {
+		return
+	}
+	incOwnedCounter(driftCorrections, state.owner.kind, state.owner.key, u.GetKind(), u.GetName())
+}
+
+// inSync returns true if the resource matches the desired one, compared
+// the same way the enforcer does
+func (state lockedState) inSync(u *unstructured.Unstructured) bool {
+	live, err := lockedresource.FilterOutPaths(u, state.excludePaths)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(state.desired, live)
+}
+
+// appliedConfiguration returns true if the server-side apply of the owned resource with the
+// configuration of the given hash is a drift correction. 'before' is the appliedVersion of the
+// resource in the cache and 'after' the one of the resource returned by the apply. The version
+// returned by the last apply is also compared, as the cache may not reflect it yet.
+func (d *driftRecorder) appliedConfiguration(owner ownerKey, gk schema.GroupKind, o client.Object,
+	hash, before, after string) bool {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	k := ownedKey{gk, client.ObjectKeyFromObject(o)}
+	previous, ok := d.applied[k]
+	d.applied[k] = appliedState{owner: owner, hash: hash, version: after}
+	return ok && previous.hash == hash && after != before && after != previous.version
+}
+
+// forget deletes the state kept for an owner that no longer exists
+func (d *driftRecorder) forget(owner ownerKey) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for k, state := range d.locked {
+		if state.owner == owner {
+			delete(d.locked, k)
+		}
+	}
+	for k, state := range d.applied {
+		if state.owner == owner {
+			delete(d.applied, k)
+		}
+	}
+}
+
+// appliedVersion returns the version of the resource used to tell whether an apply modified it:
+// its generation, which only changes along with the spec, or its resourceVersion for the kinds
+// without one, as the status of those, if any, rarely changes
+func appliedVersion(o client.Object) string {
+	if o.GetGeneration() > 0 {
+		return strconv.FormatInt(o.GetGeneration(), 10)
+	}
+	return o.GetResourceVersion()
+}
@@ -0,0 +1,93 @@
+package basereconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redhat-cop/operator-utils/pkg/util/lockedresourcecontroller/lockedresource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_driftRecorder_updated(t *testing.T) {
+	d := newDriftRecorder(nil)
+	owner := ownerKey{"Backend", types.NamespacedName{Name: "drift", Namespace: "test"}}
+	service := func(port int64, resourceVersion string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": "svc", "namespace": "test", "resourceVersion": resourceVersion},
+			"spec":       map[string]interface{}{"ports": []interface{}{map[string]interface{}{"port": port}}},
+		}}
+	}
+
+	err := d.enforce(context.Background(), owner, []lockedresource.LockedResource{
+		{Unstructured: *service(80, ""), ExcludedPaths: DefaultExcludedPaths},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter := driftCorrections.WithLabelValues("Backend", "test", "drift", "Service", "svc")
+
+	tests := []struct {
+		name     string
+		old, new *unstructured.Unstructured
+		want     float64
+	}{
+		{name: "Update in sync", old: service(80, "1"), new: service(80, "2"), want: 0},
+		{name: "Drift", old: service(80, "2"), new: service(81, "3"), want: 1},
+		{name: "Further drift before the correction", old: service(81, "3"), new: service(82, "4"), want: 1},
+		{name: "Correction", old: service(82, "4"), new: service(80, "5"), want: 1},
+		{name: "Drift again", old: service(80, "5"), new: service(83, "6"), want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d.updated(tt.old, tt.new)
+			if got := testutil.ToFloat64(counter); got != tt.want {
+				t.Errorf("drift_corrections_total = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Resources no longer enforced for the owner are not tracked
+	if err := d.enforce(context.Background(), owner, nil); err != nil {
+		t.Fatal(err)
+	}
+	d.updated(service(80, "7"), service(84, "8"))
+	if got := testutil.ToFloat64(counter); got != 2 {
+		t.Errorf("drift_corrections_total = %v, want 2", got)
+	}
+}
+
+func Test_driftRecorder_appliedConfiguration(t *testing.T) {
+	d := newDriftRecorder(nil)
+	owner := ownerKey{"Backend", types.NamespacedName{Name: "drift", Namespace: "test"}}
+	svc := &unstructured.Unstructured{}
+	svc.SetName("svc")
+	svc.SetNamespace("test")
+
+	tests := []struct {
+		name   string
+		hash   string
+		before string
+		after  string
+		want   bool
+	}{
+		{name: "First apply", hash: "a", before: "1", after: "2", want: false},
+		{name: "Unchanged resource", hash: "a", before: "2", after: "2", want: false},
+		{name: "Unchanged resource not in the cache yet", hash: "a", before: "1", after: "2", want: false},
+		{name: "Change made by another controller", hash: "a", before: "3", after: "3", want: false},
+		{name: "Reverted change", hash: "a", before: "4", after: "5", want: true},
+		{name: "New configuration", hash: "b", before: "5", after: "6", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := d.appliedConfiguration(owner, schema.GroupKind{Kind: "Service"}, svc, tt.hash, tt.before, tt.after)
+			if got != tt.want {
+				t.Errorf("appliedConfiguration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
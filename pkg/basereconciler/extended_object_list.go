@@ -2,14 +2,42 @@ package basereconciler
 
 import (
 	"context"
+	"strings"
 
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const (
+	// SecretDefinitionSecretNameField is the name of the index of SecretDefinitions
+	// by the name of the Secret they generate
+	SecretDefinitionSecretNameField string = ".spec.name"
+	// DeploymentSecretNameField is the name of the index of Deployments
+	// by the name of the Secrets their Pods read from
+	DeploymentSecretNameField string = ".spec.template.spec.secrets"
+	// StatefulSetSecretNameField is the name of the index of StatefulSets
+	// by the name of the Secrets their Pods read from
+	StatefulSetSecretNameField string = ".spec.template.spec.secrets"
+	// DeploymentConfigMapNameField is the name of the index of Deployments
+	// by the name of the ConfigMaps their Pods read from
+	DeploymentConfigMapNameField string = ".spec.template.spec.configmaps"
+	// StatefulSetConfigMapNameField is the name of the index of StatefulSets
+	// by the name of the ConfigMaps their Pods read from
+	StatefulSetConfigMapNameField string = ".spec.template.spec.configmaps"
+)
+
 // ExtendedObjectList is an extension of client.ObjectList with methods
 // to manipulate generically the objects in the list
 type ExtendedObjectList interface {
@@ -18,24 +46,227 @@ type ExtendedObjectList interface {
 	CountItems() int
 }
 
-// SecretEventHandler returns an EventHandler for the specific ExtendedObjectList
-// list object passed as parameter
+// IndexSecretDefinitions registers in the manager's cache an index of SecretDefinitions
+// by the name of the Secret they generate. It must be called once per manager,
+// before any controller using SecretEventHandler is started.
+func IndexSecretDefinitions(ctx context.Context, mgr manager.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &secretsmanagerv1alpha1.SecretDefinition{}, SecretDefinitionSecretNameField,
+		func(o client.Object) []string {
+			sd := o.(*secretsmanagerv1alpha1.SecretDefinition)
+			if sd.Spec.Name == "" {
+				return nil
+			}
+			return []string{sd.Spec.Name}
+		},
+	)
+}
+
+// IndexDeploymentSecrets registers in the manager's cache an index of Deployments by the
+// name of the Secrets their Pods read from (env vars, envFrom, volumes and rollout triggers).
+// It must be called once per manager, before any controller using SecretEventHandler is started.
+func IndexDeploymentSecrets(ctx context.Context, mgr manager.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &appsv1.Deployment{}, DeploymentSecretNameField, secretNames)
+}
+
+// IndexStatefulSetSecrets registers in the manager's cache an index of StatefulSets by the
+// name of the Secrets their Pods read from (env vars, envFrom, volumes and rollout triggers).
+// It must be called once per manager, before any controller using SecretEventHandler is started.
+func IndexStatefulSetSecrets(ctx context.Context, mgr manager.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &appsv1.StatefulSet{}, StatefulSetSecretNameField, secretNames)
+}
+
+// IndexDeploymentConfigMaps registers in the manager's cache an index of Deployments by the
+// name of the ConfigMaps their Pods read from (env vars, envFrom, volumes and rollout triggers).
+// It must be called once per manager, before any controller using ConfigMapEventHandler is started.
+func IndexDeploymentConfigMaps(ctx context.Context, mgr manager.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &appsv1.Deployment{}, DeploymentConfigMapNameField, configMapNames)
+}
+
+// IndexStatefulSetConfigMaps registers in the manager's cache an index of StatefulSets by the
+// name of the ConfigMaps their Pods read from (env vars, envFrom, volumes and rollout triggers).
+// It must be called once per manager, before any controller using ConfigMapEventHandler is started.
+func IndexStatefulSetConfigMaps(ctx context.Context, mgr manager.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &appsv1.StatefulSet{}, StatefulSetConfigMapNameField, configMapNames)
+}
+
+// secretNames returns the names of the Secrets the Pods of a workload read from
+func secretNames(o client.Object) []string {
+	return sourceNames(o, SecretTriggerKind)
+}
+
+// configMapNames returns the names of the ConfigMaps the Pods of a workload read from
+func configMapNames(o client.Object) []string {
+	return sourceNames(o, ConfigMapTriggerKind)
+}
+
+// sourceNames returns the names of the Secrets or ConfigMaps, depending on 'kind', referenced in
+// the Pod template of a workload or in the annotations of its rollout triggers. The annotations
+// cover the TriggerSources that the Pods read by other means.
+func sourceNames(o client.Object, kind string) []string {
+	template := podTemplate(o)
+	if template == nil {
+		return nil
+	}
+	names := sets.NewString()
+	for src := range podReferences(&template.Spec) {
+		if src.kind == kind {
+			names.Insert(src.name)
+		}
+	}
+	suffix := "." + strings.ToLower(kind) + "-hash"
+	for key := range template.GetAnnotations() {
+		if name := strings.TrimPrefix(key, saasv1alpha1.AnnotationsDomain+"/"); name != key && strings.HasSuffix(name, suffix) {
+			names.Insert(strings.TrimSuffix(name, suffix))
+		}
+	}
+	return names.List()
+}
+
+// SecretEventHandler returns an EventHandler that maps Secret events to the owners, of the
+// kind of the ExtendedObjectList passed as parameter, of the SecretDefinitions that generate
+// the Secret and of the Deployments and StatefulSets whose Pods read from it, and to the owners
+// in the same namespace whose Secrets are not ready. Requires the indexes registered by
+// IndexSecretDefinitions, IndexDeploymentSecrets and IndexStatefulSetSecrets.
 func (r *Reconciler) SecretEventHandler(ol ExtendedObjectList, logger logr.Logger) handler.EventHandler {
+	gvk, err := apiutil.GVKForObject(ol, r.GetScheme())
+	if err != nil {
+		panic(err)
+	}
+	ownerGVK := gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, "List"))
+
+	return handler.EnqueueRequestsFromMapFunc(
+		func(o client.Object) []reconcile.Request {
+			sdl := &secretsmanagerv1alpha1.SecretDefinitionList{}
+			if err := r.GetClient().List(context.TODO(), sdl,
+				client.InNamespace(o.GetNamespace()),
+				client.MatchingFields{SecretDefinitionSecretNameField: o.GetName()},
+			); err != nil {
+				logger.Error(err, "unable to retrieve the list of SecretDefinitions")
+				return []reconcile.Request{}
+			}
+
+			dl := &appsv1.DeploymentList{}
+			if err := r.GetClient().List(context.TODO(), dl,
+				client.InNamespace(o.GetNamespace()),
+				client.MatchingFields{DeploymentSecretNameField: o.GetName()},
+			); err != nil {
+				logger.Error(err, "unable to retrieve the list of Deployments")
+				return []reconcile.Request{}
+			}
+
+			ssl := &appsv1.StatefulSetList{}
+			if err := r.GetClient().List(context.TODO(), ssl,
+				client.InNamespace(o.GetNamespace()),
+				client.MatchingFields{StatefulSetSecretNameField: o.GetName()},
+			); err != nil {
+				logger.Error(err, "unable to retrieve the list of StatefulSets")
+				return []reconcile.Request{}
+			}
+
+			dependents := []client.Object{}
+			for idx := range sdl.Items {
+				dependents = append(dependents, &sdl.Items[idx])
+			}
+			for idx := range dl.Items {
+				dependents = append(dependents, &dl.Items[idx])
+			}
+			for idx := range ssl.Items {
+				dependents = append(dependents, &ssl.Items[idx])
+			}
+
+			requests := []reconcile.Request{}
+			seen := map[types.NamespacedName]bool{}
+
+			// Owners waiting for Secrets might not have any dependent resource
+			// created yet, so all of them are notified of changes to any Secret
+			owners := ol.DeepCopyObject().(ExtendedObjectList)
+			if err := r.GetClient().List(context.TODO(), owners, client.InNamespace(o.GetNamespace())); err != nil {
+				logger.Error(err, "unable to retrieve the list of owners")
+				return []reconcile.Request{}
+			}
+			for idx := 0; idx < owners.CountItems(); idx++ {
+				owner, ok := owners.GetItem(idx).(ObjectWithComponentStatus)
+				if !ok || !meta.IsStatusConditionFalse(owner.GetComponentStatus().Conditions, saasv1alpha1.SecretsReadyCondition) {
+					continue
+				}
+				key := types.NamespacedName{Name: owner.GetName(), Namespace: owner.GetNamespace()}
+				seen[key] = true
+				requests = append(requests, reconcile.Request{NamespacedName: key})
+			}
+
+			return append(requests, ownerRequests(dependents, ownerGVK, seen)...)
+		},
+	)
+}
+
+// ConfigMapEventHandler returns an EventHandler that maps ConfigMap events to the owners, of the
+// kind of the ExtendedObjectList passed as parameter, of the Deployments and StatefulSets whose
+// Pods read from it, so their rollout triggers are updated. Requires the indexes registered by
+// IndexDeploymentConfigMaps and IndexStatefulSetConfigMaps.
+func (r *Reconciler) ConfigMapEventHandler(ol ExtendedObjectList, logger logr.Logger) handler.EventHandler {
+	gvk, err := apiutil.GVKForObject(ol, r.GetScheme())
+	if err != nil {
+		panic(err)
+	}
+	ownerGVK := gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, "List"))
+
 	return handler.EnqueueRequestsFromMapFunc(
 		func(o client.Object) []reconcile.Request {
-			if err := r.GetClient().List(context.TODO(), ol); err != nil {
-				logger.Error(err, "unable to retrieve the list of resources")
+			dl := &appsv1.DeploymentList{}
+			if err := r.GetClient().List(context.TODO(), dl,
+				client.InNamespace(o.GetNamespace()),
+				client.MatchingFields{DeploymentConfigMapNameField: o.GetName()},
+			); err != nil {
+				logger.Error(err, "unable to retrieve the list of Deployments")
 				return []reconcile.Request{}
 			}
-			if ol.CountItems() == 0 {
+
+			ssl := &appsv1.StatefulSetList{}
+			if err := r.GetClient().List(context.TODO(), ssl,
+				client.InNamespace(o.GetNamespace()),
+				client.MatchingFields{StatefulSetConfigMapNameField: o.GetName()},
+			); err != nil {
+				logger.Error(err, "unable to retrieve the list of StatefulSets")
 				return []reconcile.Request{}
 			}
 
-			key := types.NamespacedName{
-				Name:      ol.GetItem(0).GetName(),
-				Namespace: ol.GetItem(0).GetNamespace(),
+			dependents := []client.Object{}
+			for idx := range dl.Items {
+				dependents = append(dependents, &dl.Items[idx])
 			}
-			return []reconcile.Request{{NamespacedName: key}}
+			for idx := range ssl.Items {
+				dependents = append(dependents, &ssl.Items[idx])
+			}
+			return ownerRequests(dependents, ownerGVK, map[types.NamespacedName]bool{})
 		},
 	)
 }
+
+// ownerRequests returns a reconcile.Request for the controller of each of the dependents that
+// is of the given kind, skipping the ones already in 'seen'
+func ownerRequests(dependents []client.Object, ownerGVK schema.GroupVersionKind,
+	seen map[types.NamespacedName]bool) []reconcile.Request {
+
+	requests := []reconcile.Request{}
+	for _, dependent := range dependents {
+		owner := metav1.GetControllerOf(dependent)
+		if owner == nil || !isOwnerOfKind(owner, ownerGVK) {
+			continue
+		}
+		key := types.NamespacedName{Name: owner.Name, Namespace: dependent.GetNamespace()}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
+
+func isOwnerOfKind(owner *metav1.OwnerReference, gvk schema.GroupVersionKind) bool {
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return false
+	}
+	return gv.Group == gvk.Group && owner.Kind == gvk.Kind
+}
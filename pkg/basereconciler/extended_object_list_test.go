@@ -0,0 +1,110 @@
+package basereconciler
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconciler_SecretEventHandler(t *testing.T) {
+	// The fake client decodes the objects with the client-go scheme
+	if err := saasv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := secretsmanagerv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ownedBy := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name + "-dependent", Namespace: "ns", OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: saasv1alpha1.GroupVersion.String(), Kind: "Backend", Name: name, UID: types.UID("uid-" + name),
+			Controller: pointer.BoolPtr(true),
+		}}}
+	}
+	podSpec := corev1.PodSpec{Containers: []corev1.Container{{
+		Name:    "container",
+		EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "shared"}}}},
+	}}}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&saasv1alpha1.Backend{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}},
+		&saasv1alpha1.Backend{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns"}},
+		// Owner "a" depends on the Secret through both a SecretDefinition and a Deployment
+		&secretsmanagerv1alpha1.SecretDefinition{ObjectMeta: ownedBy("a"),
+			Spec: secretsmanagerv1alpha1.SecretDefinitionSpec{Name: "shared"}},
+		&appsv1.Deployment{ObjectMeta: ownedBy("a"),
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}}},
+		// Owner "b" only depends on the Secret through a StatefulSet
+		&appsv1.StatefulSet{ObjectMeta: ownedBy("b"),
+			Spec: appsv1.StatefulSetSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}}},
+	).Build()
+
+	r := NewFromClient(cl, scheme.Scheme, nil, nil)
+	h := r.SecretEventHandler(&saasv1alpha1.BackendList{}, ctrl.Log)
+
+	q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+	h.Update(event.UpdateEvent{
+		ObjectOld: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "ns"}},
+		ObjectNew: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "ns"}},
+	}, q)
+
+	got := []string{}
+	for q.Len() > 0 {
+		item, _ := q.Get()
+		got = append(got, item.(reconcile.Request).Name)
+		q.Done(item)
+	}
+	sort.Strings(got)
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("SecretEventHandler() enqueued %v, want [a b]", got)
+	}
+}
+
+func Test_sourceNames(t *testing.T) {
+	dep := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"saas.3scale.net/secret.secret-hash":          "hash",
+			"saas.3scale.net/extra.config.configmap-hash": "hash",
+			"other.domain/other.configmap-hash":           "hash",
+		}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "container",
+				EnvFrom: []corev1.EnvFromSource{
+					{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}}},
+					{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "env"}}},
+				},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "volume",
+				VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "volume"}}},
+			}},
+		},
+	}}}
+
+	if got, want := configMapNames(dep), []string{"env", "extra.config", "volume"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("configMapNames() = %v, want %v", got, want)
+	}
+	if got, want := secretNames(dep), []string{"secret"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("secretNames() = %v, want %v", got, want)
+	}
+	if got := configMapNames(&corev1.Service{}); got != nil {
+		t.Errorf("configMapNames() = %v, want nil", got)
+	}
+}
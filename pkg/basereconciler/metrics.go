@@ -0,0 +1,401 @@
+package basereconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	metricsNamespace string = "saas_operator"
+
+	// ReconcileResultSuccess is the value of the 'result' label of successful reconciles
+	ReconcileResultSuccess string = "success"
+	// ReconcileResultError is the value of the 'result' label of reconciles that returned an error
+	ReconcileResultError string = "error"
+	// ReconcileResultPanic is the value of the 'result' label of reconciles that panicked
+	ReconcileResultPanic string = "panic"
+)
+
+// The custom resources are identified in the metrics by the 'kind', 'resource_namespace' and
+// 'resource_name' labels, as 'namespace' and 'name' are usually set by Prometheus to the
+// namespace and name of the Pod of the operator.
+var (
+	reconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of the reconciles of each custom resource",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"kind", "resource_namespace", "resource_name"},
+	)
+	reconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "reconcile_total",
+			Help:      "Number of reconciles of each custom resource, by result",
+		},
+		[]string{"kind", "resource_namespace", "resource_name", "result"},
+	)
+	ownedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "owned_resources",
+			Help:      "Number of resources owned by each custom resource, by kind and enabled state",
+		},
+		[]string{"kind", "resource_namespace", "resource_name", "owned_kind", "enabled"},
+	)
+	rolloutsTriggered = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rollouts_triggered_total",
+			Help:      "Number of rollouts of the workloads triggered by changes in the Secrets and ConfigMaps they read",
+		},
+		[]string{"kind", "resource_namespace", "resource_name", "owned_kind", "owned_name"},
+	)
+	secretsNotReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "secrets_not_ready",
+			Help:      "Number of Secrets the workloads of each custom resource depend on that are missing or lack any key",
+		},
+		[]string{"kind", "resource_namespace", "resource_name"},
+	)
+	driftCorrections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "drift_corrections_total",
+			Help:      "Number of owned resources modified outside of the operator that have been reverted to the desired state",
+		},
+		[]string{"kind", "resource_namespace", "resource_name", "owned_kind", "owned_name"},
+	)
+	generatorPanics = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "generator_panics_total",
+			Help:      "Number of panics recovered while reconciling the custom resources",
+		},
+		[]string{"kind"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		reconcileDuration,
+		reconcileTotal,
+		ownedResources,
+		rolloutsTriggered,
+		secretsNotReady,
+		driftCorrections,
+		generatorPanics,
+	)
+}
+
+// ownedResourceKinds is the list of kinds reported in the owned_resources metric
+var ownedResourceKinds = []string{
+	"Deployment", "StatefulSet", "CronJob", "Job", "SecretDefinition", "Service", "PodDisruptionBudget",
+	"HorizontalPodAutoscaler", "ScaledObject", "VerticalPodAutoscaler", "PodMonitor", "ServiceMonitor", "GrafanaDashboard",
+	"PrometheusRule", "ExternalSecret",
+}
+
+// reconcileResults is the list of values of the 'result' label of the reconcile_total metric
+var reconcileResults = []string{ReconcileResultSuccess, ReconcileResultError, ReconcileResultPanic}
+
+// The prometheus client does not support deleting series by a subset of their labels, so the
+// owned resources each owner has per resource counters for are tracked to delete them along
+// with the rest of the metrics of the owner. The owners whose metrics have been deleted during
+// a reconcile are also tracked, so the reconcile does not record them again once it returns.
+var (
+	instancesMu sync.Mutex
+	// countedResources holds the kind and name of the owned resources each owner has counters for
+	countedResources = map[string]map[[2]string]bool{}
+	// forgottenInstances holds the owners whose metrics have been deleted
+	forgottenInstances = map[string]bool{}
+)
+
+// instanceKey returns the key of an owner in the countedResources and forgottenInstances maps
+func instanceKey(kind string, key types.NamespacedName) string {
+	return kind + "/" + key.String()
+}
+
+// incOwnedCounter increments a counter with the labels of an owner and one of its owned resources
+func incOwnedCounter(c *prometheus.CounterVec, kind string, key types.NamespacedName, ownedKind, ownedName string) {
+	instancesMu.Lock()
+	defer instancesMu.Unlock()
+	ik := instanceKey(kind, key)
+	if countedResources[ik] == nil {
+		countedResources[ik] = map[[2]string]bool{}
+	}
+	countedResources[ik][[2]string{ownedKind, ownedName}] = true
+	c.WithLabelValues(kind, key.Namespace, key.Name, ownedKind, ownedName).Inc()
+}
+
+// isForgotten returns true, only once, if the metrics of the owner have been deleted
+func isForgotten(kind string, key types.NamespacedName) bool {
+	instancesMu.Lock()
+	defer instancesMu.Unlock()
+	ik := instanceKey(kind, key)
+	forgotten := forgottenInstances[ik]
+	delete(forgottenInstances, ik)
+	return forgotten
+}
+
+// Instrument wraps the reconcile.Reconciler of a kind of custom resource to record the
+// duration and the result of each reconcile. Panics, usually raised by the generators when
+// the spec is not valid, are recovered and returned as errors so the request is retried.
+func Instrument(kind string, rec reconcile.Reconciler) reconcile.Reconciler {
+	log := ctrl.Log.WithName("controllers").WithName(kind)
+
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+		start := time.Now()
+
+		defer func() {
+			outcome := ReconcileResultSuccess
+			if p := recover(); p != nil {
+				generatorPanics.WithLabelValues(kind).Inc()
+				log.Error(fmt.Errorf("%v", p), "recovered from panic", "name", req.Name, "namespace", req.Namespace)
+				result, err = reconcile.Result{}, fmt.Errorf("recovered from panic: %v", p)
+				outcome = ReconcileResultPanic
+			} else if err != nil {
+				outcome = ReconcileResultError
+			}
+			if isForgotten(kind, req.NamespacedName) {
+				return
+			}
+			reconcileDuration.WithLabelValues(kind, req.Namespace, req.Name).Observe(time.Since(start).Seconds())
+			reconcileTotal.WithLabelValues(kind, req.Namespace, req.Name, outcome).Inc()
+		}()
+
+		return rec.Reconcile(ctx, req)
+	})
+}
+
+// ownerKind returns the kind of the owner, as used in the 'kind' label of the metrics
+func (r *Reconciler) ownerKind(owner client.Object) string {
+	gvk, err := apiutil.GVKForObject(owner, r.GetScheme())
+	if err != nil {
+		return ""
+	}
+	return gvk.Kind
+}
+
+// recordOwnedResources sets the owned_resources metric of the owner
+func (r *Reconciler) recordOwnedResources(owner client.Object, crs ControlledResources) {
+	kind := r.ownerKind(owner)
+	crs.SecretsProvider = r.SecretsProvider(crs.SecretsProvider)
+	for resourceKind, counts := range crs.counts() {
+		ownedResources.WithLabelValues(kind, owner.GetNamespace(), owner.GetName(), resourceKind, "true").Set(float64(counts[0]))
+		ownedResources.WithLabelValues(kind, owner.GetNamespace(), owner.GetName(), resourceKind, "false").Set(float64(counts[1]))
+	}
+}
+
+// rolloutRecorder records in the rollouts_triggered_total metric the changes of the rollout
+// trigger annotations of the workloads, which hold the hashes of the Secrets and ConfigMaps
+// their Pods read. The annotations last enforced for each workload are kept in memory, so
+// the changes made while the operator was not running are not recorded.
+type rolloutRecorder struct {
+	mu      sync.Mutex
+	enabled map[ownedKey]rolloutState
+}
+
+// rolloutState holds the hash of the rollout trigger annotations last enforced for a workload
+type rolloutState struct {
+	owner ownerKey
+	hash  string
+}
+
+func newRolloutRecorder() *rolloutRecorder {
+	return &rolloutRecorder{enabled: map[ownedKey]rolloutState{}}
+}
+
+// enforced returns true if the rollout trigger annotations of the workload differ from
+// the ones last enforced
+func (rr *rolloutRecorder) enforced(owner ownerKey, gk schema.GroupKind, o client.Object, triggers map[string]string) bool {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	k := ownedKey{gk, client.ObjectKeyFromObject(o)}
+	hash := Hash(triggers)
+	previous, ok := rr.enabled[k]
+	rr.enabled[k] = rolloutState{owner: owner, hash: hash}
+	return ok && previous.hash != hash
+}
+
+// forget deletes the state kept for an owner that no longer exists
+func (rr *rolloutRecorder) forget(owner ownerKey) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	for k, state := range rr.enabled {
+		if state.owner == owner {
+			delete(rr.enabled, k)
+		}
+	}
+}
+
+// recordRollouts updates the rollouts_triggered_total metric from the rollout trigger
+// annotations of the workloads that have just been enforced. Paused workloads are skipped.
+func (r *Reconciler) recordRollouts(owner client.Object, resources []LockedResource) error {
+	if r.rollouts == nil {
+		return nil
+	}
+	key := ownerKey{r.ownerKind(owner), client.ObjectKeyFromObject(owner)}
+	for _, res := range resources {
+		if res.Paused {
+			continue
+		}
+		o := res.GeneratorFn()
+		triggers, ok := rolloutTriggerAnnotations(o)
+		if !ok {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(o, r.GetScheme())
+		if err != nil {
+			return err
+		}
+		if r.rollouts.enforced(key, gvk.GroupKind(), o, triggers) {
+			incOwnedCounter(rolloutsTriggered, key.kind, key.key, gvk.Kind, o.GetName())
+		}
+	}
+	return nil
+}
+
+// rolloutTriggerAnnotations returns the rollout trigger annotations of the Pod template of a
+// workload, including the one of the Jobs of a CronJob, and false if it is not a workload
+func rolloutTriggerAnnotations(o client.Object) (map[string]string, bool) {
+	var annotations map[string]string
+	switch w := o.(type) {
+	case *appsv1.Deployment:
+		annotations = w.Spec.Template.GetAnnotations()
+	case *appsv1.StatefulSet:
+		annotations = w.Spec.Template.GetAnnotations()
+	case *batchv1.CronJob:
+		annotations = w.Spec.JobTemplate.Spec.Template.GetAnnotations()
+	default:
+		return nil, false
+	}
+	triggers := map[string]string{}
+	for k, v := range annotations {
+		if strings.HasPrefix(k, saasv1alpha1.AnnotationsDomain+"/") &&
+			(strings.HasSuffix(k, ".secret-hash") || strings.HasSuffix(k, ".configmap-hash")) {
+			triggers[k] = v
+		}
+	}
+	return triggers, true
+}
+
+// recordSecretsNotReady sets the secrets_not_ready metric of the owner
+func (r *Reconciler) recordSecretsNotReady(owner client.Object, report secretsReport) {
+	secretsNotReady.WithLabelValues(r.ownerKind(owner), owner.GetNamespace(), owner.GetName()).Set(float64(report.notReady.Len()))
+}
+
+// forgetMetrics deletes all the metrics of an owner that no longer exists
+func (r *Reconciler) forgetMetrics(owner client.Object, key types.NamespacedName) {
+	kind := r.ownerKind(owner)
+	for _, resourceKind := range ownedResourceKinds {
+		for _, enabled := range []string{"true", "false"} {
+			ownedResources.DeleteLabelValues(kind, key.Namespace, key.Name, resourceKind, enabled)
+		}
+	}
+	secretsNotReady.DeleteLabelValues(kind, key.Namespace, key.Name)
+	reconcileDuration.DeleteLabelValues(kind, key.Namespace, key.Name)
+	for _, result := range reconcileResults {
+		reconcileTotal.DeleteLabelValues(kind, key.Namespace, key.Name, result)
+	}
+
+	instancesMu.Lock()
+	defer instancesMu.Unlock()
+	ik := instanceKey(kind, key)
+	for owned := range countedResources[ik] {
+		rolloutsTriggered.DeleteLabelValues(kind, key.Namespace, key.Name, owned[0], owned[1])
+		driftCorrections.DeleteLabelValues(kind, key.Namespace, key.Name, owned[0], owned[1])
+	}
+	delete(countedResources, ik)
+	forgottenInstances[ik] = true
+
+	if r.drift != nil {
+		r.drift.forget(ownerKey{kind, key})
+	}
+	if r.rollouts != nil {
+		r.rollouts.forget(ownerKey{kind, key})
+	}
+}
+
+// counts returns the number of enabled and disabled resources of each kind in the ControlledResources
+func (crs ControlledResources) counts() map[string][2]int {
+	counts := map[string][2]int{}
+	for _, kind := range ownedResourceKinds {
+		counts[kind] = [2]int{}
+	}
+	add := func(kind string, enabled bool) {
+		c := counts[kind]
+		if enabled {
+			c[0]++
+		} else {
+			c[1]++
+		}
+		counts[kind] = c
+	}
+
+	for range crs.Deployments {
+		add("Deployment", true)
+	}
+	for _, ss := range crs.StatefulSets {
+		add("StatefulSet", ss.Enabled)
+	}
+	for _, cj := range crs.CronJobs {
+		add("CronJob", cj.Enabled)
+	}
+	for _, j := range crs.Jobs {
+		add("Job", j.Enabled)
+	}
+	externalSecrets := isExternalSecrets(crs.SecretsProvider)
+	for _, sd := range crs.SecretDefinitions {
+		if externalSecrets {
+			add("ExternalSecret", sd.generated())
+		} else {
+			add("SecretDefinition", sd.generated())
+		}
+	}
+	for _, svc := range crs.Services {
+		add("Service", svc.Enabled)
+	}
+	for _, pdb := range crs.PodDisruptionBudgets {
+		add("PodDisruptionBudget", pdb.Enabled)
+	}
+	for _, hpa := range crs.HorizontalPodAutoscalers {
+		add("HorizontalPodAutoscaler", hpa.Enabled)
+	}
+	for _, so := range crs.ScaledObjects {
+		add("ScaledObject", so.Enabled)
+	}
+	for _, vpa := range crs.VerticalPodAutoscalers {
+		add("VerticalPodAutoscaler", vpa.Enabled)
+	}
+	for _, pm := range crs.PodMonitors {
+		add("PodMonitor", pm.Enabled)
+	}
+	for _, sm := range crs.ServiceMonitors {
+		add("ServiceMonitor", sm.Enabled)
+	}
+	for _, gd := range crs.GrafanaDashboards {
+		add("GrafanaDashboard", gd.Enabled)
+	}
+	for _, pr := range crs.PrometheusRules {
+		add("PrometheusRule", pr.Enabled)
+	}
+	return counts
+}
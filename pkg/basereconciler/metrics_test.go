@@ -0,0 +1,132 @@
+package basereconciler
+
+import (
+	"context"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestInstrument(t *testing.T) {
+	req := reconcile.Request{}
+	req.Name = "instance"
+	req.Namespace = "test"
+
+	rec := Instrument("Test", reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		panic("invalid spec")
+	}))
+
+	if _, err := rec.Reconcile(context.Background(), req); err == nil {
+		t.Errorf("Instrument() expected an error from a panicking reconciler")
+	}
+	if got := testutil.ToFloat64(generatorPanics.WithLabelValues("Test")); got != 1 {
+		t.Errorf("generator_panics_total = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(reconcileTotal.WithLabelValues("Test", "test", "instance", ReconcileResultPanic)); got != 1 {
+		t.Errorf("reconcile_total = %v, want 1", got)
+	}
+}
+
+func TestReconciler_recordRollouts(t *testing.T) {
+	registerManagedKinds(t)
+	r := NewFromClient(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), scheme.Scheme, nil, nil)
+	owner := &saasv1alpha1.Backend{ObjectMeta: metav1.ObjectMeta{Name: "rollouts", Namespace: "test"}}
+	deployment := func(hash string) GeneratorFunction {
+		return func() client.Object {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener", Namespace: "test"},
+				Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"saas.3scale.net/backend.secret-hash": hash, "other": "annotation"},
+				}}},
+			}
+		}
+	}
+	counter := rolloutsTriggered.WithLabelValues("Backend", "test", "rollouts", "Deployment", "backend-listener")
+
+	tests := []struct {
+		name     string
+		resource LockedResource
+		want     float64
+	}{
+		{name: "First reconcile", resource: LockedResource{GeneratorFn: deployment("a")}, want: 0},
+		{name: "Unchanged triggers", resource: LockedResource{GeneratorFn: deployment("a")}, want: 0},
+		{name: "Paused workload", resource: LockedResource{GeneratorFn: deployment("b"), Paused: true}, want: 0},
+		{name: "Changed triggers", resource: LockedResource{GeneratorFn: deployment("b")}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.recordRollouts(owner, []LockedResource{tt.resource}); err != nil {
+				t.Fatal(err)
+			}
+			if got := testutil.ToFloat64(counter); got != tt.want {
+				t.Errorf("rollouts_triggered_total = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconciler_forgetMetrics(t *testing.T) {
+	registerManagedKinds(t)
+	r := NewFromClient(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), scheme.Scheme, nil, nil)
+	key := types.NamespacedName{Name: "deleted", Namespace: "test"}
+
+	reconcileDuration.WithLabelValues("Backend", key.Namespace, key.Name).Observe(1)
+	reconcileTotal.WithLabelValues("Backend", key.Namespace, key.Name, ReconcileResultSuccess).Inc()
+	incOwnedCounter(rolloutsTriggered, "Backend", key, "Deployment", "backend-listener")
+	incOwnedCounter(driftCorrections, "Backend", key, "Service", "backend-listener")
+
+	r.forgetMetrics(&saasv1alpha1.Backend{}, key)
+
+	// Deleting the series again fails if forgetMetrics already deleted them
+	for name, deleted := range map[string]bool{
+		"reconcile_duration_seconds": reconcileDuration.DeleteLabelValues("Backend", key.Namespace, key.Name),
+		"reconcile_total":            reconcileTotal.DeleteLabelValues("Backend", key.Namespace, key.Name, ReconcileResultSuccess),
+		"rollouts_triggered_total":   rolloutsTriggered.DeleteLabelValues("Backend", key.Namespace, key.Name, "Deployment", "backend-listener"),
+		"drift_corrections_total":    driftCorrections.DeleteLabelValues("Backend", key.Namespace, key.Name, "Service", "backend-listener"),
+	} {
+		if deleted {
+			t.Errorf("forgetMetrics() did not delete the series of %s", name)
+		}
+	}
+
+	// The reconcile that found the owner deleted does not record it again
+	rec := Instrument("Backend", reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		return reconcile.Result{}, nil
+	}))
+	if _, err := rec.Reconcile(context.Background(), reconcile.Request{NamespacedName: key}); err != nil {
+		t.Fatal(err)
+	}
+	if reconcileTotal.DeleteLabelValues("Backend", key.Namespace, key.Name, ReconcileResultSuccess) {
+		t.Errorf("Instrument() recorded the reconcile of a forgotten owner")
+	}
+}
+
+func TestControlledResources_counts(t *testing.T) {
+	provider := saasv1alpha1.ExternalSecretsProvider
+	sd := func() client.Object {
+		return &secretsmanagerv1alpha1.SecretDefinition{Spec: secretsmanagerv1alpha1.SecretDefinitionSpec{
+			KeysMap: map[string]secretsmanagerv1alpha1.DataSource{"KEY": {}}}}
+	}
+	crs := ControlledResources{
+		SecretDefinitions: []SecretDefinition{{Template: sd, Enabled: true}},
+		SecretsProvider:   &saasv1alpha1.SecretsProviderSpec{Type: &provider},
+	}
+
+	counts := crs.counts()
+	if got := counts["ExternalSecret"]; got != [2]int{1, 0} {
+		t.Errorf("counts()[ExternalSecret] = %v, want [1 0]", got)
+	}
+	if got := counts["SecretDefinition"]; got != [2]int{0, 0} {
+		t.Errorf("counts()[SecretDefinition] = %v, want [0 0]", got)
+	}
+}
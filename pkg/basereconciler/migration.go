@@ -0,0 +1,147 @@
+package basereconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// migrationState is the state of the migration Job of the current spec
+type migrationState string
+
+const (
+	// migrationNotEnabled is used when there is no enabled migration Job
+	migrationNotEnabled migrationState = ""
+	// migrationRunning is used while the Job is pending to be created or running
+	migrationRunning migrationState = "Running"
+	// migrationSucceeded is used when the Job has completed successfully
+	migrationSucceeded migrationState = "Succeeded"
+	// migrationFailed is used when the Job has failed
+	migrationFailed migrationState = "Failed"
+)
+
+// migrationReport describes the state of the migration Job of the current spec
+type migrationReport struct {
+	state migrationState
+	// job is the live Job, or the desired one if it does not exist yet
+	job *k8sbatchv1.Job
+	// message holds the reason of the failure of the Job
+	message string
+}
+
+// holdsRollout returns true if the workloads must not be rolled out yet
+func (mr migrationReport) holdsRollout() bool {
+	return mr.state == migrationRunning || mr.state == migrationFailed
+}
+
+// String returns a human readable description of the report
+func (mr migrationReport) String() string {
+	switch mr.state {
+	case migrationSucceeded:
+		return fmt.Sprintf("Job %s succeeded", mr.job.GetName())
+	case migrationFailed:
+		return fmt.Sprintf("Job %s failed: %s; rollout held", mr.job.GetName(), mr.message)
+	case migrationRunning:
+		return fmt.Sprintf("Job %s in progress; rollout held", mr.job.GetName())
+	default:
+		return ""
+	}
+}
+
+// status returns the MigrationStatus of a successful migration
+func (mr migrationReport) status() *saasv1alpha1.MigrationStatus {
+	status := &saasv1alpha1.MigrationStatus{
+		JobName:        mr.job.GetName(),
+		CompletionTime: mr.job.Status.CompletionTime,
+	}
+	if containers := mr.job.Spec.Template.Spec.Containers; len(containers) > 0 {
+		status.Image = containers[0].Image
+		status.Version = imageTag(status.Image)
+	}
+	return status
+}
+
+// reportMigration returns the state of the enabled migration Job in the ControlledResources
+func (r *Reconciler) reportMigration(ctx context.Context, crs ControlledResources) (migrationReport, error) {
+	for _, j := range crs.Jobs {
+		if !j.Enabled || !j.Migration {
+			continue
+		}
+		desired := j.Template().(*k8sbatchv1.Job)
+		job := &k8sbatchv1.Job{}
+		exists, err := r.getIfExists(ctx, desired, job)
+		if err != nil {
+			return migrationReport{}, err
+		}
+		if !exists {
+			return migrationReport{state: migrationRunning, job: desired}, nil
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case k8sbatchv1.JobComplete:
+				return migrationReport{state: migrationSucceeded, job: job}, nil
+			case k8sbatchv1.JobFailed:
+				return migrationReport{state: migrationFailed, job: job, message: fmt.Sprintf("%s: %s", cond.Reason, cond.Message)}, nil
+			}
+		}
+		return migrationReport{state: migrationRunning, job: job}, nil
+	}
+	return migrationReport{state: migrationNotEnabled}, nil
+}
+
+// holdBack returns the resources with the ones 'held' returns true for marked as paused,
+// so existing resources are left untouched, or removed, so missing ones are not created
+func (r *Reconciler) holdBack(ctx context.Context, resources []LockedResource,
+	held func(client.Object) bool) ([]LockedResource, error) {
+
+	kept := []LockedResource{}
+	for _, res := range resources {
+		o := res.GeneratorFn()
+		if !held(o) {
+			kept = append(kept, res)
+			continue
+		}
+		exists, err := r.getIfExists(ctx, o, o.DeepCopyObject().(client.Object))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			res.Paused = true
+			res.ExcludePaths = PausedExcludedPaths
+			kept = append(kept, res)
+		}
+	}
+	return kept, nil
+}
+
+// isWorkload returns true for the Deployments, StatefulSets and CronJobs
+func isWorkload(o client.Object) bool {
+	switch o.(type) {
+	case *appsv1.Deployment, *appsv1.StatefulSet, *batchv1.CronJob:
+		return true
+	default:
+		return false
+	}
+}
+
+// imageTag returns the tag of the image, or an empty string
+// if the image has no tag or is referenced by digest
+func imageTag(image string) string {
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+		return image[idx+1:]
+	}
+	return ""
+}
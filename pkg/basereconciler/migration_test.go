@@ -0,0 +1,63 @@
+package basereconciler
+
+import (
+	"testing"
+
+	k8sbatchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_imageTag(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "quay.io/3scale/porta:v2.13.0", want: "v2.13.0"},
+		{image: "registry:5000/3scale/porta:nightly", want: "nightly"},
+		{image: "registry:5000/3scale/porta", want: ""},
+		{image: "porta", want: ""},
+		{image: "quay.io/3scale/porta@sha256:0123456789abcdef", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := imageTag(tt.image); got != tt.want {
+				t.Errorf("imageTag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControlledResources_lockedResources_migrations(t *testing.T) {
+	job := func(name string) GeneratorFunction {
+		return func() client.Object {
+			return &k8sbatchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+		}
+	}
+	lockedResources := func(crs ControlledResources) ([]LockedResource, error) {
+		return crs.lockedResources(
+			func(Deployment) (*int32, error) { return nil, nil },
+			func(string, ...RolloutTriggerSource) ([]RolloutTrigger, error) { return nil, nil },
+		)
+	}
+
+	resources, err := lockedResources(ControlledResources{Jobs: []Job{
+		{Template: job("migrate"), Enabled: true, Migration: true},
+		{Template: job("other"), Enabled: true},
+		{Template: job("disabled"), Enabled: false, Migration: true},
+	}})
+	if err != nil {
+		t.Fatalf("lockedResources() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Errorf("lockedResources() got %d resources, want 2", len(resources))
+	}
+
+	_, err = lockedResources(ControlledResources{Jobs: []Job{
+		{Template: job("migrate"), Enabled: true, Migration: true},
+		{Template: job("migrate-again"), Enabled: true, Migration: true},
+	}})
+	if err == nil {
+		t.Errorf("lockedResources() expected an error for two enabled migration Jobs")
+	}
+}
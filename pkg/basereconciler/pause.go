@@ -0,0 +1,108 @@
+package basereconciler
+
+import (
+	"strings"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+var (
+	// pausedWorkloadKinds are the kinds an entry of the PausedAnnotation
+	// without kind applies to
+	pausedWorkloadKinds []string = []string{"Deployment", "StatefulSet", "CronJob", "Job"}
+	// PausedExcludedPaths is the list of paths excluded from reconciliation for paused
+	// resources when using the locked resources controller, so the resources are kept
+	// (and created if missing) but their contents are no longer enforced
+	PausedExcludedPaths []string = []string{
+		"/metadata",
+		"/spec",
+		"/status",
+	}
+)
+
+// PausedResources is the set of owned resources whose reconciliation
+// has been paused through the PausedAnnotation
+type PausedResources struct {
+	all     bool
+	entries []string
+}
+
+// GetPausedResources returns the PausedResources of the given instance
+func GetPausedResources(instance client.Object) PausedResources {
+	value := strings.TrimSpace(instance.GetAnnotations()[saasv1alpha1.PausedAnnotation])
+	if value == "" || value == "false" {
+		return PausedResources{}
+	}
+	if value == "true" {
+		return PausedResources{all: true}
+	}
+	entries := []string{}
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return PausedResources{entries: entries}
+}
+
+// IsPaused returns true if the reconciliation of any resource is paused
+func (pr PausedResources) IsPaused() bool {
+	return pr.all || len(pr.entries) > 0
+}
+
+// All returns true if the reconciliation of all resources is paused
+func (pr PausedResources) All() bool {
+	return pr.all
+}
+
+// Has returns true if the resource of the given kind and name is paused. An
+// entry without kind only pauses the workload (Deployment, StatefulSet, CronJob
+// or Job) with that name, so the Services, PDBs, HPAs, etc that share the name
+// of the workload are still reconciled.
+func (pr PausedResources) Has(kind, name string) bool {
+	if pr.all {
+		return true
+	}
+	for _, entry := range pr.entries {
+		if strings.EqualFold(entry, kind+"/"+name) || (entry == name && isWorkloadKind(kind)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWorkloadKind returns true if the kind is one of pausedWorkloadKinds
+func isWorkloadKind(kind string) bool {
+	for _, k := range pausedWorkloadKinds {
+		if strings.EqualFold(k, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a human readable description of the paused resources
+func (pr PausedResources) String() string {
+	if pr.all {
+		return "all resources"
+	}
+	return strings.Join(pr.entries, ", ")
+}
+
+// PausedAnnotationChangedPredicate triggers a reconcile whenever the
+// PausedAnnotation of the resource changes
+type PausedAnnotationChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update implements default UpdateEvent filter for changes in the PausedAnnotation
+func (PausedAnnotationChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	return e.ObjectOld.GetAnnotations()[saasv1alpha1.PausedAnnotation] !=
+		e.ObjectNew.GetAnnotations()[saasv1alpha1.PausedAnnotation]
+}
@@ -0,0 +1,40 @@
+package basereconciler
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPausedResources_Has(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		kind       string
+		resource   string
+		want       bool
+	}{
+		{"Not paused", "", "Deployment", "backend", false},
+		{"All paused", "true", "Service", "backend", true},
+		{"Kind and name", "Deployment/backend", "Deployment", "backend", true},
+		{"Kind is case insensitive", "deployment/backend", "Deployment", "backend", true},
+		{"Kind and name of other kind", "Deployment/backend", "Service", "backend", false},
+		{"Name matches a Deployment", "backend", "Deployment", "backend", true},
+		{"Name matches a StatefulSet", "backend", "StatefulSet", "backend", true},
+		{"Name matches a CronJob", "backend", "CronJob", "backend", true},
+		{"Name does not match a Service", "backend", "Service", "backend", false},
+		{"Name does not match a PodDisruptionBudget", "backend", "PodDisruptionBudget", "backend", false},
+		{"One of several entries", "Service/other, backend", "Deployment", "backend", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &saasv1alpha1.Backend{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{saasv1alpha1.PausedAnnotation: tt.annotation},
+			}}
+			if got := GetPausedResources(instance).Has(tt.kind, tt.resource); got != tt.want {
+				t.Errorf("PausedResources.Has() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
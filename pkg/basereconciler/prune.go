@@ -0,0 +1,161 @@
+package basereconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
+	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// OwnerUIDLabel is the label that holds the UID of the owner in each of the owned
+	// resources, used to look up the resources to prune in the cache
+	OwnerUIDLabel string = "saas.3scale.net/owner-uid"
+)
+
+// withOwnerLabel returns a copy of the labels with the OwnerUIDLabel of the owner
+func withOwnerLabel(labels map[string]string, owner client.Object) map[string]string {
+	out := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		out[k] = v
+	}
+	out[OwnerUIDLabel] = string(owner.GetUID())
+	return out
+}
+
+// managedKinds returns a list object for each of the kinds
+// that can be part of the ControlledResources
+func (r *Reconciler) managedKinds() []client.ObjectList {
+	return []client.ObjectList{
+		&appsv1.DeploymentList{},
+		&appsv1.StatefulSetList{},
+		&batchv1.CronJobList{},
+		&k8sbatchv1.JobList{},
+		&secretsmanagerv1alpha1.SecretDefinitionList{},
+		&externalsecretsv1beta1.ExternalSecretList{},
+		&corev1.ServiceList{},
+		&policyv1beta1.PodDisruptionBudgetList{},
+		r.horizontalPodAutoscalerList(),
+		&kedav1alpha1.ScaledObjectList{},
+		&vpav1.VerticalPodAutoscalerList{},
+		&monitoringv1.PodMonitorList{},
+		&monitoringv1.ServiceMonitorList{},
+		&grafanav1alpha1.GrafanaDashboardList{},
+		&monitoringv1.PrometheusRuleList{},
+	}
+}
+
+// PruneOwnedResources deletes the resources controlled by the owner that are not in the
+// list of desired resources, which happens when a resource gets disabled or renamed.
+// The owner references of the resources act as the inventory of everything that has
+// ever been created for the owner, so resources are pruned even across operator restarts.
+// A "Pruned" event is recorded in the owner for each deleted resource. Nothing is pruned
+// while the reconciliation of the owner is paused, and the resources paused through the
+// PausedAnnotation are never pruned.
+func (r *Reconciler) PruneOwnedResources(ctx context.Context, owner client.Object, desired []LockedResource) error {
+
+	paused := GetPausedResources(owner)
+	if paused.All() {
+		return nil
+	}
+
+	orphaned, err := r.orphanedResources(ctx, owner, desired)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range orphaned {
+		key, err := r.inventoryKey(o)
+		if err != nil {
+			return err
+		}
+		if kind := strings.SplitN(key, "/", 2)[0]; paused.Has(kind, o.GetName()) {
+			continue
+		}
+		// Delete in the background so the Pods of the Jobs are not orphaned
+		if err := r.GetClient().Delete(ctx, o, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		r.GetRecorder().Eventf(owner, corev1.EventTypeNormal, "Pruned",
+			"Deleted %s that is no longer enabled", key)
+	}
+
+	return nil
+}
+
+// orphanedResources returns the resources controlled by the owner that are not in the
+// list of desired resources. They are looked up in the cache by the OwnerUIDLabel.
+func (r *Reconciler) orphanedResources(ctx context.Context, owner client.Object, desired []LockedResource) ([]client.Object, error) {
+
+	keep := map[string]bool{}
+	for _, res := range desired {
+		key, err := r.inventoryKey(res.GeneratorFn())
+		if err != nil {
+			return nil, err
+		}
+		keep[key] = true
+	}
+
+	orphaned := []client.Object{}
+	for _, list := range r.managedKinds() {
+		err := r.cache.List(ctx, list, client.InNamespace(owner.GetNamespace()),
+			client.MatchingLabels{OwnerUIDLabel: string(owner.GetUID())})
+		if err != nil {
+			// Skip kinds whose APIs are not installed in the cluster
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			o := item.(client.Object)
+			ref := metav1.GetControllerOf(o)
+			if ref == nil || ref.UID != owner.GetUID() {
+				continue
+			}
+			key, err := r.inventoryKey(o)
+			if err != nil {
+				return nil, err
+			}
+			if !keep[key] {
+				orphaned = append(orphaned, o)
+			}
+		}
+	}
+
+	return orphaned, nil
+}
+
+// inventoryKey returns a string that identifies the object by its kind and name
+func (r *Reconciler) inventoryKey(o client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(o, r.GetScheme())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", gvk.Kind, o.GetName()), nil
+}
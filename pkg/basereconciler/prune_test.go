@@ -0,0 +1,90 @@
+package basereconciler
+
+import (
+	"context"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
+	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// registerManagedKinds adds the APIs of all the managed kinds to the client-go
+// scheme, which the fake client uses to decode the objects
+func registerManagedKinds(t *testing.T) {
+	for _, add := range []func(*runtime.Scheme) error{
+		saasv1alpha1.AddToScheme, batchv1.AddToScheme, externalsecretsv1beta1.AddToScheme,
+		grafanav1alpha1.AddToScheme, kedav1alpha1.AddToScheme, secretsmanagerv1alpha1.AddToScheme,
+		vpav1.AddToScheme, monitoringv1.AddToScheme,
+	} {
+		if err := add(scheme.Scheme); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReconciler_PruneOwnedResources(t *testing.T) {
+	registerManagedKinds(t)
+
+	owner := func(paused string) *saasv1alpha1.Backend {
+		return &saasv1alpha1.Backend{ObjectMeta: metav1.ObjectMeta{
+			Name: "owner", Namespace: "ns", UID: "uid",
+			Annotations: map[string]string{saasv1alpha1.PausedAnnotation: paused},
+		}}
+	}
+	service := func(name string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "ns",
+			Labels: map[string]string{OwnerUIDLabel: "uid"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: saasv1alpha1.GroupVersion.String(), Kind: "Backend", Name: "owner", UID: "uid",
+				Controller: pointer.BoolPtr(true),
+			}},
+		}}
+	}
+	desired := []LockedResource{{GeneratorFn: func() client.Object { return service("desired") }}}
+
+	tests := []struct {
+		name       string
+		paused     string
+		wantPruned bool
+	}{
+		{name: "Prunes the resources no longer desired", paused: "", wantPruned: true},
+		{name: "Prunes nothing while paused", paused: "true", wantPruned: false},
+		{name: "Keeps the paused resources", paused: "Service/orphan", wantPruned: false},
+		{name: "Prunes the resources of other kinds with the paused name", paused: "Deployment/orphan", wantPruned: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+				WithObjects(service("desired"), service("orphan")).Build()
+			r := NewFromClient(cl, scheme.Scheme, nil, record.NewFakeRecorder(10))
+
+			if err := r.PruneOwnedResources(context.TODO(), owner(tt.paused), desired); err != nil {
+				t.Fatalf("PruneOwnedResources() error = %v", err)
+			}
+			err := cl.Get(context.TODO(), types.NamespacedName{Name: "orphan", Namespace: "ns"}, &corev1.Service{})
+			if pruned := errors.IsNotFound(err); pruned != tt.wantPruned {
+				t.Errorf("PruneOwnedResources() pruned = %v, want %v", pruned, tt.wantPruned)
+			}
+			if err := cl.Get(context.TODO(), types.NamespacedName{Name: "desired", Namespace: "ns"}, &corev1.Service{}); err != nil {
+				t.Errorf("PruneOwnedResources() pruned a desired resource: %v", err)
+			}
+		})
+	}
+}
@@ -11,15 +11,19 @@ import (
 	// monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	// autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
 	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/redhat-cop/operator-utils/pkg/util/lockedresourcecontroller/lockedpatch"
 	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client" // policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
 // ControlledResources defines the resources that each of the
@@ -27,12 +31,22 @@ import (
 type ControlledResources struct {
 	Deployments              []Deployment
 	StatefulSets             []StatefulSet
+	CronJobs                 []CronJob
+	Jobs                     []Job
 	SecretDefinitions        []SecretDefinition
 	Services                 []Service
 	PodDisruptionBudgets     []PodDisruptionBudget
 	HorizontalPodAutoscalers []HorizontalPodAutoscaler
+	ScaledObjects            []ScaledObject
+	VerticalPodAutoscalers   []VerticalPodAutoscaler
 	PodMonitors              []PodMonitor
+	ServiceMonitors          []ServiceMonitor
 	GrafanaDashboards        []GrafanaDashboard
+	PrometheusRules          []PrometheusRule
+	// SecretsProvider configures the resources used to populate the Secrets
+	// described by the SecretDefinitions. Fields not set default to the ones
+	// configured in the Reconciler.
+	SecretsProvider *saasv1alpha1.SecretsProviderSpec
 }
 
 // RolloutTrigger defines a configuration source that should trigger a
@@ -41,6 +55,9 @@ type RolloutTrigger struct {
 	name      string
 	configMap *corev1.ConfigMap
 	secret    *corev1.Secret
+	// keys restricts the hash to these keys of the config source. All
+	// the keys are hashed when nil.
+	keys sets.String
 }
 
 // GetHash returns the hash of the data container in the RolloutTrigger
@@ -50,13 +67,36 @@ func (rt *RolloutTrigger) GetHash() string {
 		if reflect.DeepEqual(rt.secret, &corev1.Secret{}) {
 			return ""
 		}
-		return Hash(rt.secret.Data)
+		if rt.keys == nil {
+			return Hash(rt.secret.Data)
+		}
+		data := map[string][]byte{}
+		for _, k := range rt.keys.List() {
+			if v, ok := rt.secret.Data[k]; ok {
+				data[k] = v
+			}
+		}
+		return Hash(data)
 	}
 	if rt.configMap != nil {
-		if reflect.DeepEqual(rt.secret, &corev1.ConfigMap{}) {
+		if reflect.DeepEqual(rt.configMap, &corev1.ConfigMap{}) {
 			return ""
 		}
-		return Hash(rt.configMap.Data)
+		if rt.keys == nil && len(rt.configMap.BinaryData) == 0 {
+			return Hash(rt.configMap.Data)
+		}
+		data := map[string]string{}
+		for k, v := range rt.configMap.Data {
+			if rt.keys == nil || rt.keys.Has(k) {
+				data[k] = v
+			}
+		}
+		for k, v := range rt.configMap.BinaryData {
+			if rt.keys == nil || rt.keys.Has(k) {
+				data[k] = string(v)
+			}
+		}
+		return Hash(data)
 	}
 	return ""
 }
@@ -83,46 +123,61 @@ func NewRolloutTrigger(name string, o client.Object) RolloutTrigger {
 	}
 }
 
-// TriggersFromSecretDefs generates a list of RolloutTrigger from the given SecretDefinition generator functions
-func (r *Reconciler) TriggersFromSecretDefs(ctx context.Context, sd ...GeneratorFunction) ([]RolloutTrigger, error) {
-
-	triggers := []RolloutTrigger{}
-
-	for _, secretDef := range sd {
-		sd := secretDef().(*secretsmanagerv1alpha1.SecretDefinition)
-		key := types.NamespacedName{
-			Name:      sd.GetName(),
-			Namespace: sd.GetNamespace(),
-		}
-		secret := &corev1.Secret{}
-		err := r.GetClient().Get(ctx, key, secret)
-		if err != nil {
-			if errors.IsNotFound(err) {
-				triggers = append(triggers, NewRolloutTrigger(sd.GetName(), &corev1.Secret{}))
-				continue
-			}
-			return nil, err
-		}
-
-		triggers = append(triggers, NewRolloutTrigger(sd.GetName(), secret))
+// Deployment specifies a Deployment resource. Its rollout triggers are inferred
+// from the Secrets and ConfigMaps that its Pods read.
+type Deployment struct {
+	Template GeneratorFunction
+	// TriggerSources are Secrets and ConfigMaps that trigger a rollout when changed,
+	// in addition to the ones referenced in the Pod template
+	TriggerSources []RolloutTriggerSource
+	HasHPA         bool
+	// HasScaledObject is true when the replicas of the Deployment
+	// are managed by a KEDA ScaledObject
+	HasScaledObject bool
+	// HasVPA is true when the resources of the containers of the Deployment
+	// are managed by a VerticalPodAutoscaler that updates its Pods
+	HasVPA bool
+}
 
-	}
+// isAutoscaled returns true if the replicas of the Deployment are managed by
+// an autoscaler, in which case the reconciler must not enforce them
+func (d Deployment) isAutoscaled() bool {
+	return d.HasHPA || d.HasScaledObject
+}
 
-	return triggers, nil
+// StatefulSet specifies a StatefulSet resource. Its rollout triggers are inferred
+// from the Secrets and ConfigMaps that its Pods read.
+type StatefulSet struct {
+	Template GeneratorFunction
+	// TriggerSources are Secrets and ConfigMaps that trigger a rollout when changed,
+	// in addition to the ones referenced in the Pod template
+	TriggerSources []RolloutTriggerSource
+	Enabled        bool
+	// HasVPA is true when the resources of the containers of the StatefulSet
+	// are managed by a VerticalPodAutoscaler that updates its Pods
+	HasVPA bool
 }
 
-// Deployment specifies a Deployment resource and its rollout triggers
-type Deployment struct {
-	Template        GeneratorFunction
-	RolloutTriggers []RolloutTrigger
-	HasHPA          bool
+// CronJob specifies a CronJob resource. Its rollout triggers are inferred
+// from the Secrets and ConfigMaps that the Pods of its Jobs read, and are
+// applied to the Jobs created after the config sources change.
+type CronJob struct {
+	Template GeneratorFunction
+	// TriggerSources are Secrets and ConfigMaps that trigger a rollout when changed,
+	// in addition to the ones referenced in the Pod template
+	TriggerSources []RolloutTriggerSource
+	Enabled        bool
 }
 
-// StatefulSet specifies a StatefulSet resource and its rollout triggers
-type StatefulSet struct {
-	Template        GeneratorFunction
-	RolloutTriggers []RolloutTrigger
-	Enabled         bool
+// Job specifies a Job resource. Jobs are immutable, so the generator must
+// give the Job a new name whenever its spec changes.
+type Job struct {
+	Template GeneratorFunction
+	Enabled  bool
+	// Migration is true for the Job that migrates the database of the component.
+	// The workloads are not rolled out until it succeeds. Only one migration Job
+	// can be enabled.
+	Migration bool
 }
 
 // SecretDefinition specifies a SecretDefinition resource
@@ -131,6 +186,17 @@ type SecretDefinition struct {
 	Enabled  bool
 }
 
+// generated returns true if the SecretDefinition is enabled and has any keys.
+// A SecretDefinition without keys is not created, as all the values of its env
+// vars come from other sources.
+func (sd SecretDefinition) generated() bool {
+	if !sd.Enabled || sd.Template == nil {
+		return false
+	}
+	def, ok := sd.Template().(*secretsmanagerv1alpha1.SecretDefinition)
+	return !ok || len(def.Spec.KeysMap) > 0
+}
+
 // Service specifies a Service resource
 type Service struct {
 	Template GeneratorFunction
@@ -149,24 +215,48 @@ type HorizontalPodAutoscaler struct {
 	Enabled  bool
 }
 
+// ScaledObject specifies a KEDA ScaledObject resource
+type ScaledObject struct {
+	Template GeneratorFunction
+	Enabled  bool
+}
+
+// VerticalPodAutoscaler specifies a VerticalPodAutoscaler resource
+type VerticalPodAutoscaler struct {
+	Template GeneratorFunction
+	Enabled  bool
+}
+
 // PodMonitor specifies a PodMonitor resource
 type PodMonitor struct {
 	Template GeneratorFunction
 	Enabled  bool
 }
 
+// ServiceMonitor specifies a ServiceMonitor resource
+type ServiceMonitor struct {
+	Template GeneratorFunction
+	Enabled  bool
+}
+
 // GrafanaDashboard specifies a GrafanaDashboard resource
 type GrafanaDashboard struct {
 	Template GeneratorFunction
 	Enabled  bool
 }
 
+// PrometheusRule specifies a PrometheusRule resource
+type PrometheusRule struct {
+	Template GeneratorFunction
+	Enabled  bool
+}
+
 // GetDeploymentReplicas returns the number of replicas for a deployment,
-// current value if HPA is enabled.
+// current value if HPA or a KEDA ScaledObject is enabled.
 func (r *Reconciler) GetDeploymentReplicas(ctx context.Context, d Deployment) (*int32, error) {
 
 	dep := d.Template().(*appsv1.Deployment)
-	if !d.HasHPA {
+	if !d.isAutoscaled() {
 		return dep.Spec.Replicas, nil
 	}
 	key := types.NamespacedName{
@@ -188,42 +278,244 @@ func (r *Reconciler) GetDeploymentReplicas(ctx context.Context, d Deployment) (*
 // all controllers
 func (r *Reconciler) ReconcileOwnedResources(ctx context.Context, owner client.Object, crs ControlledResources) error {
 	// Calculate resources to enforce
+	resources, err := r.desiredResources(ctx, owner, crs)
+	if err != nil {
+		return err
+	}
+
+	// In dry-run mode the resources are not modified
+	if IsDryRun(owner) {
+		return r.reconcileDryRun(ctx, owner, resources)
+	}
+
+	r.recordOwnedResources(owner, crs)
+
+	if r.serverSideApply {
+		if err := r.ApplyOwnedResources(ctx, owner, resources); err != nil {
+			return err
+		}
+	} else {
+		lockedResources, err := r.NewLockedResources(resources, owner)
+		if err != nil {
+			return err
+		}
+		// The drift corrections of the enforcer are recorded from the updates of the
+		// resources, which are compared with the desired ones from now on
+		if r.drift != nil {
+			err = r.drift.enforce(ctx, ownerKey{r.ownerKind(owner), client.ObjectKeyFromObject(owner)}, lockedResources)
+			if err != nil {
+				return err
+			}
+		}
+		err = r.UpdateLockedResources(ctx, owner, lockedResources, []lockedpatch.LockedPatch{})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := r.recordRollouts(owner, resources); err != nil {
+		return err
+	}
+
+	// Delete any resource previously created for the owner that is no longer enabled
+	return r.PruneOwnedResources(ctx, owner, resources)
+}
+
+// desiredResources returns the list of LockedResource that the reconciler needs to
+// enforce for the owner, with the resources paused through annotations, or held
+// back until the Secrets they depend on are ready or the migration of the database
+// succeeds, marked as such
+func (r *Reconciler) desiredResources(ctx context.Context, owner client.Object, crs ControlledResources) ([]LockedResource, error) {
+	crs.SecretsProvider = r.SecretsProvider(crs.SecretsProvider)
+
+	resources, err := crs.lockedResources(func(dep Deployment) (*int32, error) {
+		if r.serverSideApply && dep.isAutoscaled() {
+			// Leave the replicas field to the autoscaler. The replicas applied until
+			// now are handed over in ApplyOwnedResources so they are not reset.
+			return nil, nil
+		}
+		return r.GetDeploymentReplicas(ctx, dep)
+	}, func(namespace string, sources ...RolloutTriggerSource) ([]RolloutTrigger, error) {
+		return r.TriggersFromSources(ctx, namespace, sources...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reconcile the HorizontalPodAutoscalers with the API version served by the cluster
+	if r.autoscalingV2 {
+		for idx := range resources {
+			resources[idx].GeneratorFn = withAutoscalingV2(resources[idx].GeneratorFn)
+		}
+	}
+
+	// Jobs are immutable and the server-side apply of an existing one fails as soon as
+	// any field of its template differs from the live one, like the ones defaulted by
+	// the API server, so Jobs are only created when missing
+	if r.serverSideApply {
+		for idx := range resources {
+			o := resources[idx].GeneratorFn()
+			if _, ok := o.(*k8sbatchv1.Job); !ok {
+				continue
+			}
+			exists, err := r.getIfExists(ctx, o, &k8sbatchv1.Job{})
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				resources[idx].Paused = true
+				resources[idx].ExcludePaths = PausedExcludedPaths
+			}
+		}
+	}
+
+	// Hold back the workloads that depend on Secrets that are not ready. Existing
+	// workloads are left untouched and missing ones are not created yet.
+	if r.waitForSecrets(owner) {
+		report, err := r.reportSecrets(ctx, crs)
+		if err != nil {
+			return nil, err
+		}
+		if !report.ready() {
+			resources, err = r.holdBack(ctx, resources, func(o client.Object) bool {
+				return dependsOnAny(o, report.notReady)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Hold back the workloads until the migration of the database succeeds, so
+	// the new version of the component never runs against the old schema
+	migration, err := r.reportMigration(ctx, crs)
+	if err != nil {
+		return nil, err
+	}
+	if migration.holdsRollout() {
+		resources, err = r.holdBack(ctx, resources, isWorkload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Stop enforcing the resources paused through annotations
+	if paused := GetPausedResources(owner); paused.IsPaused() {
+		for idx := range resources {
+			o := resources[idx].GeneratorFn()
+			gvk, err := apiutil.GVKForObject(o, r.GetScheme())
+			if err != nil {
+				return nil, err
+			}
+			if paused.Has(gvk.Kind, o.GetName()) {
+				resources[idx].Paused = true
+				resources[idx].ExcludePaths = PausedExcludedPaths
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// lockedResources returns the list of LockedResource for the enabled resources
+// in the ControlledResources. The replicas of each Deployment are calculated
+// with 'replicasFn' and the rollout triggers of each workload with 'sourcesFn'.
+func (crs ControlledResources) lockedResources(replicasFn func(Deployment) (*int32, error),
+	sourcesFn func(namespace string, sources ...RolloutTriggerSource) ([]RolloutTrigger, error)) ([]LockedResource, error) {
 	resources := []LockedResource{}
 
+	if err := validateSecretsProvider(crs.SecretsProvider); err != nil {
+		return nil, err
+	}
+
 	for _, dep := range crs.Deployments {
 
-		currentReplicas, err := r.GetDeploymentReplicas(ctx, dep)
+		currentReplicas, err := replicasFn(dep)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		o := dep.Template()
+		triggers, err := sourcesFn(o.GetNamespace(), triggerSources(o, dep.TriggerSources)...)
+		if err != nil {
+			return nil, err
 		}
 
 		resources = append(resources,
 			LockedResource{
-				GeneratorFn: r.DeploymentWithRolloutTriggers(dep.Template, dep.RolloutTriggers, currentReplicas),
+				GeneratorFn: deploymentWithRolloutTriggers(dep.Template, triggers, currentReplicas),
 				ExcludePaths: func() []string {
-					if dep.HasHPA {
-						return append(DeploymentExcludedPaths, "/spec/replicas")
+					paths := append([]string{}, DeploymentExcludedPaths...)
+					if dep.isAutoscaled() {
+						paths = append(paths, "/spec/replicas")
+					}
+					if dep.HasVPA {
+						paths = append(paths, ResourcesExcludes(dep.Template)...)
 					}
-					return DeploymentExcludedPaths
+					return paths
 				}(),
 			})
 	}
 
 	for _, ss := range crs.StatefulSets {
 		if ss.Enabled {
+			o := ss.Template()
+			triggers, err := sourcesFn(o.GetNamespace(), triggerSources(o, ss.TriggerSources)...)
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources,
 				LockedResource{
-					GeneratorFn:  r.StatefulSetWithRolloutTriggers(ss.Template, ss.RolloutTriggers),
-					ExcludePaths: DefaultExcludedPaths,
+					GeneratorFn: statefulSetWithRolloutTriggers(ss.Template, triggers),
+					ExcludePaths: func() []string {
+						if ss.HasVPA {
+							return append(append([]string{}, DefaultExcludedPaths...), ResourcesExcludes(ss.Template)...)
+						}
+						return DefaultExcludedPaths
+					}(),
+				})
+		}
+	}
+
+	for _, cj := range crs.CronJobs {
+		if cj.Enabled {
+			o := cj.Template()
+			triggers, err := sourcesFn(o.GetNamespace(), triggerSources(o, cj.TriggerSources)...)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources,
+				LockedResource{
+					GeneratorFn:  cronJobWithRolloutTriggers(cj.Template, triggers),
+					ExcludePaths: CronJobExcludedPaths,
 				})
 		}
 	}
 
+	migrations := 0
+	for _, job := range crs.Jobs {
+		if job.Enabled {
+			if job.Migration {
+				migrations++
+			}
+			resources = append(resources,
+				LockedResource{
+					GeneratorFn:  job.Template,
+					ExcludePaths: JobExcludedPaths,
+				})
+		}
+	}
+	if migrations > 1 {
+		return nil, fmt.Errorf("only one migration Job can be enabled, got %d", migrations)
+	}
+
 	for _, sd := range crs.SecretDefinitions {
-		if sd.Enabled {
+		if sd.generated() {
 			resources = append(resources,
 				LockedResource{
-					GeneratorFn:  sd.Template,
+					GeneratorFn:  secretDefinitionWithProvider(sd.Template, crs.SecretsProvider),
 					ExcludePaths: DefaultExcludedPaths,
 				})
 		}
@@ -249,6 +541,16 @@ func (r *Reconciler) ReconcileOwnedResources(ctx context.Context, owner client.O
 		}
 	}
 
+	for _, sm := range crs.ServiceMonitors {
+		if sm.Enabled {
+			resources = append(resources,
+				LockedResource{
+					GeneratorFn:  sm.Template,
+					ExcludePaths: DefaultExcludedPaths,
+				})
+		}
+	}
+
 	for _, hpa := range crs.HorizontalPodAutoscalers {
 		if hpa.Enabled {
 			resources = append(resources,
@@ -259,6 +561,26 @@ func (r *Reconciler) ReconcileOwnedResources(ctx context.Context, owner client.O
 		}
 	}
 
+	for _, so := range crs.ScaledObjects {
+		if so.Enabled {
+			resources = append(resources,
+				LockedResource{
+					GeneratorFn:  so.Template,
+					ExcludePaths: DefaultExcludedPaths,
+				})
+		}
+	}
+
+	for _, vpa := range crs.VerticalPodAutoscalers {
+		if vpa.Enabled {
+			resources = append(resources,
+				LockedResource{
+					GeneratorFn:  vpa.Template,
+					ExcludePaths: DefaultExcludedPaths,
+				})
+		}
+	}
+
 	for _, pdb := range crs.PodDisruptionBudgets {
 		if pdb.Enabled {
 			resources = append(resources,
@@ -279,13 +601,53 @@ func (r *Reconciler) ReconcileOwnedResources(ctx context.Context, owner client.O
 		}
 	}
 
-	lockedResources, err := r.NewLockedResources(resources, owner)
-	err = r.UpdateLockedResources(ctx, owner, lockedResources, []lockedpatch.LockedPatch{})
+	for _, pr := range crs.PrometheusRules {
+		if pr.Enabled {
+			resources = append(resources,
+				LockedResource{
+					GeneratorFn:  pr.Template,
+					ExcludePaths: DefaultExcludedPaths,
+				})
+		}
+	}
+
+	return resources, nil
+}
+
+// Render returns the enabled resources in the ControlledResources as they would be
+// reconciled for a new owner, without accessing the cluster. Useful to inspect the
+// output of the generators offline.
+func (crs ControlledResources) Render() ([]client.Object, error) {
+	resources, err := crs.lockedResources(func(dep Deployment) (*int32, error) {
+		return dep.Template().(*appsv1.Deployment).Spec.Replicas, nil
+	}, emptyTriggersFromSources)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	objects := make([]client.Object, 0, len(resources))
+	for _, res := range resources {
+		objects = append(objects, res.GeneratorFn())
+	}
+	return objects, nil
+}
+
+// ResourcesExcludes generates the list of excluded paths for the resources of the
+// containers of a Deployment or StatefulSet, so the ones set by a VerticalPodAutoscaler
+// are not reverted
+func ResourcesExcludes(fn GeneratorFunction) []string {
+	var podSpec corev1.PodSpec
+	switch o := fn().(type) {
+	case *appsv1.Deployment:
+		podSpec = o.Spec.Template.Spec
+	case *appsv1.StatefulSet:
+		podSpec = o.Spec.Template.Spec
+	}
+	paths := []string{}
+	for idx := range podSpec.Containers {
+		paths = append(paths, fmt.Sprintf("/spec/template/spec/containers/%d/resources", idx))
+	}
+	return paths
 }
 
 // ServiceExcludes generates the list of excluded paths for a Service resource
@@ -299,15 +661,20 @@ func ServiceExcludes(fn GeneratorFunction) []string {
 	return paths
 }
 
-// DeploymentWithRolloutTriggers returns the Deployment modified with the appropriate rollout triggers (annotations)
+// DeploymentWithRolloutTriggers returns the Deployment modified with the appropriate rollout triggers (annotations).
+// The hash of each trigger only covers the keys of its config source that the Pods read.
 func (r *Reconciler) DeploymentWithRolloutTriggers(deployment GeneratorFunction, triggers []RolloutTrigger, replicas *int32) GeneratorFunction {
+	return deploymentWithRolloutTriggers(deployment, triggers, replicas)
+}
+
+func deploymentWithRolloutTriggers(deployment GeneratorFunction, triggers []RolloutTrigger, replicas *int32) GeneratorFunction {
 
 	return func() client.Object {
 		dep := deployment().(*appsv1.Deployment)
 		if dep.Spec.Template.ObjectMeta.Annotations == nil {
 			dep.Spec.Template.ObjectMeta.Annotations = map[string]string{}
 		}
-		for _, trigger := range triggers {
+		for _, trigger := range scopeTriggers(&dep.Spec.Template.Spec, triggers) {
 			dep.Spec.Template.ObjectMeta.Annotations[trigger.GetAnnotationKey()] = trigger.GetHash()
 		}
 
@@ -317,21 +684,47 @@ func (r *Reconciler) DeploymentWithRolloutTriggers(deployment GeneratorFunction,
 	}
 }
 
-// StatefulSetWithRolloutTriggers returns the StatefulSet modified with the appropriate rollout triggers (annotations)
+// StatefulSetWithRolloutTriggers returns the StatefulSet modified with the appropriate rollout triggers (annotations).
+// The hash of each trigger only covers the keys of its config source that the Pods read.
 func (r *Reconciler) StatefulSetWithRolloutTriggers(statefulset GeneratorFunction, triggers []RolloutTrigger) GeneratorFunction {
+	return statefulSetWithRolloutTriggers(statefulset, triggers)
+}
+
+func statefulSetWithRolloutTriggers(statefulset GeneratorFunction, triggers []RolloutTrigger) GeneratorFunction {
 
 	return func() client.Object {
 		ss := statefulset().(*appsv1.StatefulSet)
 		if ss.Spec.Template.ObjectMeta.Annotations == nil {
 			ss.Spec.Template.ObjectMeta.Annotations = map[string]string{}
 		}
-		for _, trigger := range triggers {
+		for _, trigger := range scopeTriggers(&ss.Spec.Template.Spec, triggers) {
 			ss.Spec.Template.ObjectMeta.Annotations[trigger.GetAnnotationKey()] = trigger.GetHash()
 		}
 		return ss
 	}
 }
 
+// CronJobWithRolloutTriggers returns the CronJob modified with the appropriate rollout triggers (annotations)
+// in the Pod template of its Jobs. The hash of each trigger only covers the keys of its config source that the Pods read.
+func (r *Reconciler) CronJobWithRolloutTriggers(cronjob GeneratorFunction, triggers []RolloutTrigger) GeneratorFunction {
+	return cronJobWithRolloutTriggers(cronjob, triggers)
+}
+
+func cronJobWithRolloutTriggers(cronjob GeneratorFunction, triggers []RolloutTrigger) GeneratorFunction {
+
+	return func() client.Object {
+		cj := cronjob().(*batchv1.CronJob)
+		template := &cj.Spec.JobTemplate.Spec.Template
+		if template.ObjectMeta.Annotations == nil {
+			template.ObjectMeta.Annotations = map[string]string{}
+		}
+		for _, trigger := range scopeTriggers(&template.Spec, triggers) {
+			template.ObjectMeta.Annotations[trigger.GetAnnotationKey()] = trigger.GetHash()
+		}
+		return cj
+	}
+}
+
 // Hash returns a hash of the passed object
 func Hash(o interface{}) string {
 	hasher := fnv.New32a()
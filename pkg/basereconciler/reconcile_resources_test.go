@@ -0,0 +1,68 @@
+package basereconciler
+
+import (
+	"reflect"
+	"testing"
+
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestResourcesExcludes(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  client.Object
+		want []string
+	}{
+		{
+			name: "Deployment",
+			obj: &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}},
+			}}},
+			want: []string{"/spec/template/spec/containers/0/resources", "/spec/template/spec/containers/1/resources"},
+		},
+		{
+			name: "StatefulSet",
+			obj: &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			}}},
+			want: []string{"/spec/template/spec/containers/0/resources"},
+		},
+		{
+			name: "Other kinds",
+			obj:  &corev1.Service{},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResourcesExcludes(func() client.Object { return tt.obj }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResourcesExcludes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cronJobWithRolloutTriggers(t *testing.T) {
+	cronjob := func() client.Object {
+		return &batchv1.CronJob{Spec: batchv1beta1.CronJobSpec{Schedule: "*/5 * * * *"}}
+	}
+	template := func(o client.Object) corev1.PodTemplateSpec {
+		return o.(*batchv1.CronJob).Spec.JobTemplate.Spec.Template
+	}
+
+	secret := &corev1.Secret{Data: map[string][]byte{"KEY1": []byte("value1")}}
+	got := template(cronJobWithRolloutTriggers(cronjob, []RolloutTrigger{NewRolloutTrigger("secret", secret)})())
+	want := map[string]string{
+		"saas.3scale.net/secret.secret-hash": Hash(map[string][]byte{"KEY1": []byte("value1")}),
+	}
+	if !reflect.DeepEqual(got.Annotations, want) {
+		t.Errorf("cronJobWithRolloutTriggers() annotations = %v, want %v", got.Annotations, want)
+	}
+	if spec := podSpec(cronjob()); spec == nil {
+		t.Errorf("podSpec() = nil, want the Pod template spec of the Jobs")
+	}
+}
@@ -2,7 +2,9 @@ package basereconciler
 
 import (
 	"context"
+	"time"
 
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
 	"github.com/go-logr/logr"
 	"github.com/redhat-cop/operator-utils/pkg/util"
 	"github.com/redhat-cop/operator-utils/pkg/util/lockedresourcecontroller"
@@ -11,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,9 +37,21 @@ var (
 		"/metadata/uid",
 		"/status",
 	}
-	// DeploymentExcludedPaths is a list fo path to ignore for Deployment resources
+	// DeploymentExcludedPaths is a list fo path to ignore for Deployment resources. The
+	// labels are enforced, as the OwnerUIDLabel is required to prune the resource.
 	DeploymentExcludedPaths []string = []string{
-		"/metadata",
+		"/metadata/annotations",
+		"/metadata/creationTimestamp",
+		"/metadata/deletionGracePeriodSeconds",
+		"/metadata/deletionTimestamp",
+		"/metadata/finalizers",
+		"/metadata/generateName",
+		"/metadata/generation",
+		"/metadata/managedFields",
+		"/metadata/ownerReferences",
+		"/metadata/resourceVersion",
+		"/metadata/selfLink",
+		"/metadata/uid",
 		"/status",
 		"/spec/progressDeadlineSeconds",
 		"/spec/revisionHistoryLimit",
@@ -47,17 +62,112 @@ var (
 		"/spec/template/spec/securityContext",
 		"/spec/template/spec/terminationGracePeriodSeconds",
 	}
+	// JobExcludedPaths is a list of paths to ignore for Job resources. Jobs are
+	// immutable, so only their labels are updated, as the OwnerUIDLabel is required to prune them.
+	JobExcludedPaths []string = []string{
+		"/metadata/annotations",
+		"/metadata/creationTimestamp",
+		"/metadata/deletionGracePeriodSeconds",
+		"/metadata/deletionTimestamp",
+		"/metadata/finalizers",
+		"/metadata/generateName",
+		"/metadata/generation",
+		"/metadata/managedFields",
+		"/metadata/ownerReferences",
+		"/metadata/resourceVersion",
+		"/metadata/selfLink",
+		"/metadata/uid",
+		"/spec",
+		"/status",
+	}
+	// CronJobExcludedPaths is a list of paths to ignore for CronJob resources. The
+	// labels are enforced, as the OwnerUIDLabel is required to prune the resource.
+	CronJobExcludedPaths []string = []string{
+		"/metadata/annotations",
+		"/metadata/creationTimestamp",
+		"/metadata/deletionGracePeriodSeconds",
+		"/metadata/deletionTimestamp",
+		"/metadata/finalizers",
+		"/metadata/generateName",
+		"/metadata/generation",
+		"/metadata/managedFields",
+		"/metadata/ownerReferences",
+		"/metadata/resourceVersion",
+		"/metadata/selfLink",
+		"/metadata/uid",
+		"/status",
+		"/spec/jobTemplate/metadata/creationTimestamp",
+		"/spec/jobTemplate/spec/template/metadata/creationTimestamp",
+		"/spec/jobTemplate/spec/template/spec/dnsPolicy",
+		"/spec/jobTemplate/spec/template/spec/schedulerName",
+		"/spec/jobTemplate/spec/template/spec/securityContext",
+		"/spec/jobTemplate/spec/template/spec/terminationGracePeriodSeconds",
+	}
 )
 
 // Reconciler computes a list of resources that it needs to keep in place
 type Reconciler struct {
 	lockedresourcecontroller.EnforcingReconciler
+	serverSideApply       bool
+	secretsProvider       saasv1alpha1.SecretsProviderSpec
+	waitForSecretsDefault bool
+	autoscalingV2         bool
+	resyncPeriod          time.Duration
+	// cache is used to list the owned resources when pruning. Objects that are
+	// not in the cache yet, like unstructured ones, are also read through it.
+	cache    client.Reader
+	drift    *driftRecorder
+	rollouts *rolloutRecorder
+}
+
+// Option configures a Reconciler
+type Option func(*options)
+
+type options struct {
+	serverSideApply bool
+	secretsProvider saasv1alpha1.SecretsProviderSpec
+	waitForSecrets  bool
+	autoscalingV2   bool
+	resyncPeriod    time.Duration
 }
 
 // NewFromManager constructs a new Reconciler from the given manager
-func NewFromManager(mgr manager.Manager, recorder record.EventRecorder, clusterWatchers bool) Reconciler {
+func NewFromManager(mgr manager.Manager, recorder record.EventRecorder, clusterWatchers bool, opts ...Option) Reconciler {
+	o := &options{resyncPeriod: DefaultResyncPeriod}
+	for _, opt := range opts {
+		opt(o)
+	}
 	return Reconciler{
-		EnforcingReconciler: lockedresourcecontroller.NewFromManager(mgr, mgr.GetEventRecorderFor("DiscoveryService"), clusterWatchers),
+		EnforcingReconciler: lockedresourcecontroller.NewEnforcingReconciler(mgr.GetClient(), mgr.GetScheme(),
+			mgr.GetConfig(), mgr.GetAPIReader(), mgr.GetEventRecorderFor("DiscoveryService"), clusterWatchers),
+		cache:                 mgr.GetCache(),
+		drift:                 newDriftRecorder(mgr.GetCache()),
+		rollouts:              newRolloutRecorder(),
+		serverSideApply:       o.serverSideApply,
+		secretsProvider:       o.secretsProvider,
+		waitForSecretsDefault: o.waitForSecrets,
+		autoscalingV2:         o.autoscalingV2,
+		resyncPeriod:          o.resyncPeriod,
+	}
+}
+
+// NewFromClient constructs a new Reconciler from the given client, for
+// uses outside of a manager like computing diffs from the command line
+func NewFromClient(c client.Client, scheme *runtime.Scheme, restConfig *rest.Config, recorder record.EventRecorder, opts ...Option) Reconciler {
+	o := &options{resyncPeriod: DefaultResyncPeriod}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return Reconciler{
+		EnforcingReconciler:   lockedresourcecontroller.NewEnforcingReconciler(c, scheme, restConfig, c, recorder, false),
+		cache:                 c,
+		drift:                 newDriftRecorder(nil),
+		rollouts:              newRolloutRecorder(),
+		serverSideApply:       o.serverSideApply,
+		secretsProvider:       o.secretsProvider,
+		waitForSecretsDefault: o.waitForSecrets,
+		autoscalingV2:         o.autoscalingV2,
+		resyncPeriod:          o.resyncPeriod,
 	}
 }
 
@@ -69,6 +179,7 @@ type GeneratorFunction func() client.Object
 type LockedResource struct {
 	GeneratorFn  GeneratorFunction
 	ExcludePaths []string
+	Paused       bool
 }
 
 // GetInstance tries to retrieve the custom resource instance and perform some standard
@@ -78,6 +189,7 @@ func (r *Reconciler) GetInstance(ctx context.Context, key types.NamespacedName,
 	err := r.GetClient().Get(ctx, key, instance)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			r.forgetMetrics(instance, key)
 			// Return and don't requeue
 			return &ctrl.Result{}, nil
 		}
@@ -129,6 +241,10 @@ func (r *Reconciler) IsInitialized(instance client.Object, finalizer string) boo
 
 // ManageCleanUpLogic contains finalization logic for the LockedResourcesReconciler
 func (r *Reconciler) ManageCleanUpLogic(instance client.Object, log logr.Logger) error {
+	if r.serverSideApply {
+		// Owned resources are garbage collected through their owner references
+		return nil
+	}
 	err := r.Terminate(instance, true)
 	if err != nil {
 		log.Error(err, "unable to terminate locked resources reconciler")
@@ -172,6 +288,7 @@ func newUnstructured(fn GeneratorFunction, owner client.Object, scheme *runtime.
 	if err := controllerutil.SetControllerReference(owner, o, scheme); err != nil {
 		return unstructured.Unstructured{}, err
 	}
+	o.SetLabels(withOwnerLabel(o.GetLabels(), owner))
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
 	if err != nil {
 		return unstructured.Unstructured{}, err
@@ -0,0 +1,245 @@
+package basereconciler
+
+import (
+	"context"
+	"sort"
+
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SecretTriggerKind is the kind of RolloutTriggerSource for Secrets
+	SecretTriggerKind string = "Secret"
+	// ConfigMapTriggerKind is the kind of RolloutTriggerSource for ConfigMaps
+	ConfigMapTriggerKind string = "ConfigMap"
+)
+
+// RolloutTriggerSource declares a Secret or ConfigMap that the Pods of a workload
+// depend on, so changes in its data trigger a rollout of the workload. The sources
+// referenced in the Pod template are inferred, so it is only required for Secrets
+// and ConfigMaps that the Pods read by other means.
+type RolloutTriggerSource struct {
+	// Kind is either SecretTriggerKind or ConfigMapTriggerKind
+	Kind string
+	// Name is the name of the Secret or ConfigMap, in the namespace of the workload
+	Name string
+}
+
+// SecretTriggerSource returns a RolloutTriggerSource for the Secret with the given name
+func SecretTriggerSource(name string) RolloutTriggerSource {
+	return RolloutTriggerSource{Kind: SecretTriggerKind, Name: name}
+}
+
+// ConfigMapTriggerSource returns a RolloutTriggerSource for the ConfigMap with the given name
+func ConfigMapTriggerSource(name string) RolloutTriggerSource {
+	return RolloutTriggerSource{Kind: ConfigMapTriggerKind, Name: name}
+}
+
+// emptyObject returns an empty Secret or ConfigMap, depending on the kind of the source
+func (src RolloutTriggerSource) emptyObject() client.Object {
+	if src.Kind == ConfigMapTriggerKind {
+		return &corev1.ConfigMap{}
+	}
+	return &corev1.Secret{}
+}
+
+// TriggersFromSources generates a list of RolloutTrigger from the given Secrets and ConfigMaps in
+// the namespace. Secrets or ConfigMaps that do not exist yet generate a RolloutTrigger with no data.
+func (r *Reconciler) TriggersFromSources(ctx context.Context, namespace string, sources ...RolloutTriggerSource) ([]RolloutTrigger, error) {
+	triggers := make([]RolloutTrigger, 0, len(sources))
+
+	for _, src := range sources {
+		o := src.emptyObject()
+		key := types.NamespacedName{Name: src.Name, Namespace: namespace}
+		if err := r.GetClient().Get(ctx, key, o); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+			o = src.emptyObject()
+		}
+		triggers = append(triggers, NewRolloutTrigger(src.Name, o))
+	}
+
+	return triggers, nil
+}
+
+// emptyTriggersFromSources returns a RolloutTrigger with no data for each source, without accessing the cluster
+func emptyTriggersFromSources(namespace string, sources ...RolloutTriggerSource) ([]RolloutTrigger, error) {
+	triggers := make([]RolloutTrigger, 0, len(sources))
+	for _, src := range sources {
+		triggers = append(triggers, NewRolloutTrigger(src.Name, src.emptyObject()))
+	}
+	return triggers, nil
+}
+
+// triggerSources returns the Secrets and ConfigMaps read by the Pods of the workload, sorted
+// by kind and name, followed by any of the 'extra' sources not already in the list
+func triggerSources(o client.Object, extra []RolloutTriggerSource) []RolloutTriggerSource {
+	sources := []RolloutTriggerSource{}
+	seen := map[RolloutTriggerSource]bool{}
+
+	if spec := podSpec(o); spec != nil {
+		for src := range podReferences(spec) {
+			sources = append(sources, RolloutTriggerSource{Kind: src.kind, Name: src.name})
+		}
+		sort.Slice(sources, func(i, j int) bool {
+			if sources[i].Kind != sources[j].Kind {
+				return sources[i].Kind < sources[j].Kind
+			}
+			return sources[i].Name < sources[j].Name
+		})
+		for _, src := range sources {
+			seen[src] = true
+		}
+	}
+
+	for _, src := range extra {
+		if !seen[src] {
+			seen[src] = true
+			sources = append(sources, src)
+		}
+	}
+	return sources
+}
+
+// configSource identifies a Secret or ConfigMap read by the Pods of a workload
+type configSource struct {
+	kind string
+	name string
+}
+
+// podTemplate returns the Pod template of a Deployment, StatefulSet, CronJob or Job, or nil for any other kind of object
+func podTemplate(o client.Object) *corev1.PodTemplateSpec {
+	switch w := o.(type) {
+	case *appsv1.Deployment:
+		return &w.Spec.Template
+	case *appsv1.StatefulSet:
+		return &w.Spec.Template
+	case *batchv1.CronJob:
+		return &w.Spec.JobTemplate.Spec.Template
+	case *k8sbatchv1.Job:
+		return &w.Spec.Template
+	default:
+		return nil
+	}
+}
+
+// podSpec returns the Pod template spec of a Deployment, StatefulSet, CronJob or Job, or nil for any other kind of object
+func podSpec(o client.Object) *corev1.PodSpec {
+	if template := podTemplate(o); template != nil {
+		return &template.Spec
+	}
+	return nil
+}
+
+// walkPodReferences calls 'fn' for each reference to a Secret or ConfigMap in the Pod spec. The key
+// is empty when the whole Secret or ConfigMap is read, like in envFrom or volumes without items.
+func walkPodReferences(spec *corev1.PodSpec, fn func(src configSource, key string, optional bool)) {
+	isOptional := func(optional *bool) bool { return optional != nil && *optional }
+
+	containers := []corev1.Container{}
+	containers = append(containers, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+				fn(configSource{SecretTriggerKind, ref.Name}, ref.Key, isOptional(ref.Optional))
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+				fn(configSource{ConfigMapTriggerKind, ref.Name}, ref.Key, isOptional(ref.Optional))
+			}
+		}
+		for _, envFrom := range c.EnvFrom {
+			if ref := envFrom.SecretRef; ref != nil {
+				fn(configSource{SecretTriggerKind, ref.Name}, "", isOptional(ref.Optional))
+			}
+			if ref := envFrom.ConfigMapRef; ref != nil {
+				fn(configSource{ConfigMapTriggerKind, ref.Name}, "", isOptional(ref.Optional))
+			}
+		}
+	}
+
+	items := func(src configSource, items []corev1.KeyToPath, optional bool) {
+		if len(items) == 0 {
+			fn(src, "", optional)
+			return
+		}
+		for _, item := range items {
+			fn(src, item.Key, optional)
+		}
+	}
+
+	for _, v := range spec.Volumes {
+		if v.Secret != nil {
+			items(configSource{SecretTriggerKind, v.Secret.SecretName}, v.Secret.Items, isOptional(v.Secret.Optional))
+		}
+		if v.ConfigMap != nil {
+			items(configSource{ConfigMapTriggerKind, v.ConfigMap.Name}, v.ConfigMap.Items, isOptional(v.ConfigMap.Optional))
+		}
+		if v.Projected != nil {
+			for _, p := range v.Projected.Sources {
+				if p.Secret != nil {
+					items(configSource{SecretTriggerKind, p.Secret.Name}, p.Secret.Items, isOptional(p.Secret.Optional))
+				}
+				if p.ConfigMap != nil {
+					items(configSource{ConfigMapTriggerKind, p.ConfigMap.Name}, p.ConfigMap.Items, isOptional(p.ConfigMap.Optional))
+				}
+			}
+		}
+	}
+}
+
+// podReferences returns the keys the Pods read from each Secret and ConfigMap, by source.
+// A nil set means that the whole Secret or ConfigMap is read.
+func podReferences(spec *corev1.PodSpec) map[configSource]sets.String {
+	refs := map[configSource]sets.String{}
+	walkPodReferences(spec, func(src configSource, key string, optional bool) {
+		keys, ok := refs[src]
+		switch {
+		case ok && keys == nil:
+			// already read as a whole
+		case key == "":
+			refs[src] = nil
+		case !ok:
+			refs[src] = sets.NewString(key)
+		default:
+			keys.Insert(key)
+		}
+	})
+	return refs
+}
+
+// scopedTo returns the RolloutTrigger restricted to the keys of its config source that the Pods
+// read, so changes in other keys do not trigger a rollout. The whole data is kept if the Pods read
+// the source as a whole or do not reference it, as it might be read by other means.
+func (rt RolloutTrigger) scopedTo(refs map[configSource]sets.String) RolloutTrigger {
+	src := configSource{SecretTriggerKind, rt.name}
+	if rt.configMap != nil {
+		src.kind = ConfigMapTriggerKind
+	}
+
+	if keys, ok := refs[src]; ok && keys != nil {
+		rt.keys = keys
+	}
+	return rt
+}
+
+// scopeTriggers restricts each of the triggers to the keys that the Pods in 'spec' read
+func scopeTriggers(spec *corev1.PodSpec, triggers []RolloutTrigger) []RolloutTrigger {
+	refs := podReferences(spec)
+	scoped := make([]RolloutTrigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		scoped = append(scoped, trigger.scopedTo(refs))
+	}
+	return scoped
+}
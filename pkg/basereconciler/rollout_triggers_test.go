@@ -0,0 +1,122 @@
+package basereconciler
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestRolloutTrigger_GetHash(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger RolloutTrigger
+		want    string
+	}{
+		{
+			name:    "Returns an empty hash for an empty Secret",
+			trigger: NewRolloutTrigger("secret", &corev1.Secret{}),
+			want:    "",
+		},
+		{
+			name:    "Returns an empty hash for an empty ConfigMap",
+			trigger: NewRolloutTrigger("configmap", &corev1.ConfigMap{}),
+			want:    "",
+		},
+		{
+			name: "Returns the hash of the data of a Secret",
+			trigger: NewRolloutTrigger("secret", &corev1.Secret{
+				Data: map[string][]byte{"KEY1": []byte("value1"), "KEY2": []byte("value2")}}),
+			want: Hash(map[string][]byte{"KEY1": []byte("value1"), "KEY2": []byte("value2")}),
+		},
+		{
+			name: "Returns the hash of the data of a ConfigMap",
+			trigger: NewRolloutTrigger("configmap", &corev1.ConfigMap{
+				Data: map[string]string{"KEY1": "value1"}}),
+			want: Hash(map[string]string{"KEY1": "value1"}),
+		},
+		{
+			name: "Returns the hash of the given keys of a Secret",
+			trigger: func() RolloutTrigger {
+				rt := NewRolloutTrigger("secret", &corev1.Secret{
+					Data: map[string][]byte{"KEY1": []byte("value1"), "KEY2": []byte("value2")}})
+				rt.keys = sets.NewString("KEY1")
+				return rt
+			}(),
+			want: Hash(map[string][]byte{"KEY1": []byte("value1")}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.trigger.GetHash(); got != tt.want {
+				t.Errorf("RolloutTrigger.GetHash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRolloutTrigger_scopedTo(t *testing.T) {
+	secret := &corev1.Secret{Data: map[string][]byte{
+		"KEY1": []byte("value1"),
+		"KEY2": []byte("value2"),
+		"KEY3": []byte("value3"),
+	}}
+	secretKeyRef := func(name, key string) corev1.EnvVar {
+		return corev1.EnvVar{Name: key, ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}}}
+	}
+
+	tests := []struct {
+		name    string
+		trigger RolloutTrigger
+		spec    corev1.PodSpec
+		want    sets.String
+	}{
+		{
+			name:    "Hashes only the keys read by the Pods",
+			trigger: NewRolloutTrigger("secret", secret),
+			spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Env: []corev1.EnvVar{secretKeyRef("secret", "KEY1"), secretKeyRef("other", "KEY2")},
+			}}, InitContainers: []corev1.Container{{
+				Env: []corev1.EnvVar{secretKeyRef("secret", "KEY3")},
+			}}},
+			want: sets.NewString("KEY1", "KEY3"),
+		},
+		{
+			name:    "Hashes all the keys of a Secret read as a whole",
+			trigger: NewRolloutTrigger("secret", secret),
+			spec: corev1.PodSpec{Containers: []corev1.Container{{
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}}}},
+			}}},
+			want: nil,
+		},
+		{
+			name:    "Hashes all the keys of a Secret not referenced by the Pods",
+			trigger: NewRolloutTrigger("secret", secret),
+			spec:    corev1.PodSpec{Containers: []corev1.Container{{}}},
+			want:    nil,
+		},
+		{
+			name: "Hashes only the items of a ConfigMap mounted in a volume",
+			trigger: NewRolloutTrigger("configmap", &corev1.ConfigMap{
+				Data: map[string]string{"file1": "content1", "file2": "content2"}}),
+			spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "configmap"},
+					Items:                []corev1.KeyToPath{{Key: "file2", Path: "file2"}},
+				}},
+			}}},
+			want: sets.NewString("file2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.trigger.scopedTo(podReferences(&tt.spec))
+			if (got.keys == nil) != (tt.want == nil) || !got.keys.Equal(tt.want) {
+				t.Errorf("RolloutTrigger.scopedTo() keys = %v, want %v", got.keys, tt.want)
+			}
+		})
+	}
+}
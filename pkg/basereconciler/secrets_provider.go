@@ -0,0 +1,114 @@
+package basereconciler
+
+import (
+	"fmt"
+	"sort"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultSecretStoreKind is the kind of SecretStore used when not specified
+	DefaultSecretStoreKind string = "SecretStore"
+)
+
+// WithSecretsProvider is an Option that sets the provider used to populate the Secrets
+// described by SecretDefinitions. Custom resources can override any of its fields.
+// When not set, SecretDefinitions are reconciled as secrets-manager.tuenti.io resources.
+func WithSecretsProvider(provider saasv1alpha1.SecretsProviderSpec) Option {
+	return func(o *options) {
+		o.secretsProvider = provider
+	}
+}
+
+// SecretsProvider returns the provider used to populate the Secrets of a custom resource,
+// which is the one configured in the Reconciler with the fields set in 'spec' overridden
+func (r *Reconciler) SecretsProvider(spec *saasv1alpha1.SecretsProviderSpec) *saasv1alpha1.SecretsProviderSpec {
+	provider := r.secretsProvider.DeepCopy()
+	if spec == nil {
+		return provider
+	}
+	if spec.Type != nil {
+		provider.Type = spec.Type
+	}
+	if spec.SecretStoreRef != nil {
+		provider.SecretStoreRef = spec.SecretStoreRef
+	}
+	if spec.RefreshInterval != nil {
+		provider.RefreshInterval = spec.RefreshInterval
+	}
+	return provider
+}
+
+// isExternalSecrets returns true if the provider populates the Secrets using ExternalSecrets
+func isExternalSecrets(provider *saasv1alpha1.SecretsProviderSpec) bool {
+	return provider != nil && provider.Type != nil && *provider.Type == saasv1alpha1.ExternalSecretsProvider
+}
+
+// validateSecretsProvider returns an error if the provider lacks any required setting
+func validateSecretsProvider(provider *saasv1alpha1.SecretsProviderSpec) error {
+	if isExternalSecrets(provider) && (provider.SecretStoreRef == nil || provider.SecretStoreRef.Name == "") {
+		return fmt.Errorf("the %s secrets provider requires a SecretStore", saasv1alpha1.ExternalSecretsProvider)
+	}
+	return nil
+}
+
+// secretDefinitionWithProvider returns a GeneratorFunction that generates the resource used
+// by the provider to populate the Secret described by the SecretDefinition. The SecretDefinition
+// itself is returned unless the provider is ExternalSecrets.
+func secretDefinitionWithProvider(fn GeneratorFunction, provider *saasv1alpha1.SecretsProviderSpec) GeneratorFunction {
+	if !isExternalSecrets(provider) {
+		return fn
+	}
+
+	return func() client.Object {
+		sd := fn().(*secretsmanagerv1alpha1.SecretDefinition)
+
+		data := make([]externalsecretsv1beta1.ExternalSecretData, 0, len(sd.Spec.KeysMap))
+		for key, source := range sd.Spec.KeysMap {
+			data = append(data, externalsecretsv1beta1.ExternalSecretData{
+				SecretKey: key,
+				RemoteRef: externalsecretsv1beta1.ExternalSecretDataRemoteRef{
+					Key:      source.Path,
+					Property: source.Key,
+				},
+			})
+		}
+		// Sort to get a stable output, as map keys are iterated in random order
+		sort.Slice(data, func(i, j int) bool { return data[i].SecretKey < data[j].SecretKey })
+
+		kind := DefaultSecretStoreKind
+		if provider.SecretStoreRef.Kind != nil {
+			kind = *provider.SecretStoreRef.Kind
+		}
+
+		return &externalsecretsv1beta1.ExternalSecret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ExternalSecret",
+				APIVersion: externalsecretsv1beta1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        sd.GetName(),
+				Namespace:   sd.GetNamespace(),
+				Labels:      sd.GetLabels(),
+				Annotations: sd.GetAnnotations(),
+			},
+			Spec: externalsecretsv1beta1.ExternalSecretSpec{
+				SecretStoreRef: externalsecretsv1beta1.SecretStoreRef{
+					Name: provider.SecretStoreRef.Name,
+					Kind: kind,
+				},
+				Target: externalsecretsv1beta1.ExternalSecretTarget{
+					Name:           sd.Spec.Name,
+					CreationPolicy: externalsecretsv1beta1.Owner,
+				},
+				RefreshInterval: provider.RefreshInterval,
+				Data:            data,
+			},
+		}
+	}
+}
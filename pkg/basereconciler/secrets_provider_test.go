@@ -0,0 +1,184 @@
+package basereconciler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func providerType(t saasv1alpha1.SecretsProviderType) *saasv1alpha1.SecretsProviderType {
+	return &t
+}
+
+func TestReconciler_SecretsProvider(t *testing.T) {
+	operator := saasv1alpha1.SecretsProviderSpec{
+		Type:            providerType(saasv1alpha1.ExternalSecretsProvider),
+		SecretStoreRef:  &saasv1alpha1.SecretStoreReference{Name: "vault"},
+		RefreshInterval: &metav1.Duration{Duration: time.Minute},
+	}
+	tests := []struct {
+		name     string
+		operator saasv1alpha1.SecretsProviderSpec
+		spec     *saasv1alpha1.SecretsProviderSpec
+		want     *saasv1alpha1.SecretsProviderSpec
+	}{
+		{
+			name:     "Defaults to the operator's provider",
+			operator: operator,
+			spec:     nil,
+			want:     operator.DeepCopy(),
+		},
+		{
+			name:     "Overrides the type",
+			operator: operator,
+			spec:     &saasv1alpha1.SecretsProviderSpec{Type: providerType(saasv1alpha1.SecretsManagerProvider)},
+			want: &saasv1alpha1.SecretsProviderSpec{
+				Type:            providerType(saasv1alpha1.SecretsManagerProvider),
+				SecretStoreRef:  operator.SecretStoreRef,
+				RefreshInterval: operator.RefreshInterval,
+			},
+		},
+		{
+			name:     "Overrides the SecretStore and the refresh interval",
+			operator: operator,
+			spec: &saasv1alpha1.SecretsProviderSpec{
+				SecretStoreRef:  &saasv1alpha1.SecretStoreReference{Name: "other", Kind: pointer.StringPtr("ClusterSecretStore")},
+				RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			},
+			want: &saasv1alpha1.SecretsProviderSpec{
+				Type:            operator.Type,
+				SecretStoreRef:  &saasv1alpha1.SecretStoreReference{Name: "other", Kind: pointer.StringPtr("ClusterSecretStore")},
+				RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name:     "Operator without provider",
+			operator: saasv1alpha1.SecretsProviderSpec{},
+			spec:     &saasv1alpha1.SecretsProviderSpec{Type: providerType(saasv1alpha1.ExternalSecretsProvider)},
+			want:     &saasv1alpha1.SecretsProviderSpec{Type: providerType(saasv1alpha1.ExternalSecretsProvider)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{secretsProvider: tt.operator}
+			if got := r.SecretsProvider(tt.spec); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reconciler.SecretsProvider() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateSecretsProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider *saasv1alpha1.SecretsProviderSpec
+		wantErr  bool
+	}{
+		{"No provider", nil, false},
+		{"SecretsManager", &saasv1alpha1.SecretsProviderSpec{Type: providerType(saasv1alpha1.SecretsManagerProvider)}, false},
+		{"ExternalSecrets", &saasv1alpha1.SecretsProviderSpec{
+			Type:           providerType(saasv1alpha1.ExternalSecretsProvider),
+			SecretStoreRef: &saasv1alpha1.SecretStoreReference{Name: "vault"},
+		}, false},
+		{"ExternalSecrets without SecretStore", &saasv1alpha1.SecretsProviderSpec{
+			Type: providerType(saasv1alpha1.ExternalSecretsProvider),
+		}, true},
+		{"ExternalSecrets with unnamed SecretStore", &saasv1alpha1.SecretsProviderSpec{
+			Type:           providerType(saasv1alpha1.ExternalSecretsProvider),
+			SecretStoreRef: &saasv1alpha1.SecretStoreReference{},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSecretsProvider(tt.provider); (err != nil) != tt.wantErr {
+				t.Errorf("validateSecretsProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_secretDefinitionWithProvider(t *testing.T) {
+	sd := func() client.Object {
+		return &secretsmanagerv1alpha1.SecretDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "ns", Labels: map[string]string{"app": "backend"}},
+			Spec: secretsmanagerv1alpha1.SecretDefinitionSpec{
+				Name: "backend-secret",
+				Type: "opaque",
+				KeysMap: map[string]secretsmanagerv1alpha1.DataSource{
+					"KEY2": {Path: "secret/data/path", Key: "key2"},
+					"KEY1": {Path: "secret/data/path", Key: "key1"},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		provider *saasv1alpha1.SecretsProviderSpec
+		want     client.Object
+	}{
+		{
+			name:     "No provider",
+			provider: nil,
+			want:     sd(),
+		},
+		{
+			name:     "SecretsManager",
+			provider: &saasv1alpha1.SecretsProviderSpec{Type: providerType(saasv1alpha1.SecretsManagerProvider)},
+			want:     sd(),
+		},
+		{
+			name: "ExternalSecrets",
+			provider: &saasv1alpha1.SecretsProviderSpec{
+				Type:            providerType(saasv1alpha1.ExternalSecretsProvider),
+				SecretStoreRef:  &saasv1alpha1.SecretStoreReference{Name: "vault", Kind: pointer.StringPtr("ClusterSecretStore")},
+				RefreshInterval: &metav1.Duration{Duration: time.Minute},
+			},
+			want: &externalsecretsv1beta1.ExternalSecret{
+				TypeMeta:   metav1.TypeMeta{Kind: "ExternalSecret", APIVersion: externalsecretsv1beta1.GroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "ns", Labels: map[string]string{"app": "backend"}},
+				Spec: externalsecretsv1beta1.ExternalSecretSpec{
+					SecretStoreRef:  externalsecretsv1beta1.SecretStoreRef{Name: "vault", Kind: "ClusterSecretStore"},
+					Target:          externalsecretsv1beta1.ExternalSecretTarget{Name: "backend-secret", CreationPolicy: externalsecretsv1beta1.Owner},
+					RefreshInterval: &metav1.Duration{Duration: time.Minute},
+					Data: []externalsecretsv1beta1.ExternalSecretData{
+						{SecretKey: "KEY1", RemoteRef: externalsecretsv1beta1.ExternalSecretDataRemoteRef{Key: "secret/data/path", Property: "key1"}},
+						{SecretKey: "KEY2", RemoteRef: externalsecretsv1beta1.ExternalSecretDataRemoteRef{Key: "secret/data/path", Property: "key2"}},
+					},
+				},
+			},
+		},
+		{
+			name: "ExternalSecrets with the default SecretStore kind",
+			provider: &saasv1alpha1.SecretsProviderSpec{
+				Type:           providerType(saasv1alpha1.ExternalSecretsProvider),
+				SecretStoreRef: &saasv1alpha1.SecretStoreReference{Name: "vault"},
+			},
+			want: &externalsecretsv1beta1.ExternalSecret{
+				TypeMeta:   metav1.TypeMeta{Kind: "ExternalSecret", APIVersion: externalsecretsv1beta1.GroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "ns", Labels: map[string]string{"app": "backend"}},
+				Spec: externalsecretsv1beta1.ExternalSecretSpec{
+					SecretStoreRef: externalsecretsv1beta1.SecretStoreRef{Name: "vault", Kind: DefaultSecretStoreKind},
+					Target:         externalsecretsv1beta1.ExternalSecretTarget{Name: "backend-secret", CreationPolicy: externalsecretsv1beta1.Owner},
+					Data: []externalsecretsv1beta1.ExternalSecretData{
+						{SecretKey: "KEY1", RemoteRef: externalsecretsv1beta1.ExternalSecretDataRemoteRef{Key: "secret/data/path", Property: "key1"}},
+						{SecretKey: "KEY2", RemoteRef: externalsecretsv1beta1.ExternalSecretDataRemoteRef{Key: "secret/data/path", Property: "key2"}},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := secretDefinitionWithProvider(sd, tt.provider)(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("secretDefinitionWithProvider() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
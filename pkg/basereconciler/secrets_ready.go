@@ -0,0 +1,239 @@
+package basereconciler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithWaitForSecrets is an Option that, when 'enabled' is true, holds back the creation
+// and the updates of the workloads until all the Secrets they depend on are ready. It
+// can be overridden for each custom resource with the WaitForSecretsAnnotation.
+func WithWaitForSecrets(enabled bool) Option {
+	return func(o *options) {
+		o.waitForSecrets = enabled
+	}
+}
+
+// waitForSecrets returns true if the workloads of the instance must
+// not be rolled out until all the Secrets they depend on are ready
+func (r *Reconciler) waitForSecrets(instance client.Object) bool {
+	switch strings.TrimSpace(instance.GetAnnotations()[saasv1alpha1.WaitForSecretsAnnotation]) {
+	case "true":
+		return true
+	case "false":
+		return false
+	default:
+		return r.waitForSecretsDefault
+	}
+}
+
+// secretsReport describes the Secrets, or the keys within them, that the
+// workloads of a custom resource depend on but cannot be found in the API
+type secretsReport struct {
+	// issues holds a human readable description of each problem found
+	issues []string
+	// notReady is the set of names of the Secrets not found or missing any key
+	notReady sets.String
+	// unsynced holds the names of the Secrets generated from
+	// SecretDefinitions that do not exist yet
+	unsynced []string
+}
+
+// ready returns true if all the Secrets are ready
+func (sr secretsReport) ready() bool {
+	return len(sr.issues) == 0
+}
+
+// String returns a human readable description of the report
+func (sr secretsReport) String() string {
+	if sr.ready() {
+		return "All Secrets are ready"
+	}
+	return strings.Join(sr.issues, "; ")
+}
+
+// reportSecrets checks that the Secrets generated from the SecretDefinitions hold all their keys, and that
+// any other Secret the workloads read from exists and holds the keys referenced in the Pod templates
+func (r *Reconciler) reportSecrets(ctx context.Context, crs ControlledResources) (secretsReport, error) {
+	report := secretsReport{issues: []string{}, notReady: sets.NewString()}
+	generated := sets.NewString()
+
+	for _, sd := range crs.SecretDefinitions {
+		if !sd.generated() {
+			continue
+		}
+		def := sd.Template().(*secretsmanagerv1alpha1.SecretDefinition)
+		generated.Insert(def.Spec.Name)
+
+		keys := sets.NewString()
+		for key := range def.Spec.KeysMap {
+			keys.Insert(key)
+		}
+		issue, exists, err := r.checkSecret(ctx, def.GetNamespace(), def.Spec.Name, keys)
+		if err != nil {
+			return secretsReport{}, err
+		}
+		if !exists {
+			report.unsynced = append(report.unsynced, def.Spec.Name)
+		}
+		if issue != "" {
+			report.issues = append(report.issues, fmt.Sprintf("SecretDefinition %s: %s", def.GetName(), issue))
+			report.notReady.Insert(def.Spec.Name)
+		}
+	}
+
+	refs := map[string]sets.String{}
+	namespace := ""
+	for _, w := range crs.workloads() {
+		namespace = w.GetNamespace()
+		for name, keys := range secretKeyRefs(w) {
+			if _, ok := refs[name]; !ok {
+				refs[name] = sets.NewString()
+			}
+			refs[name].Insert(keys.UnsortedList()...)
+		}
+	}
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if generated.Has(name) {
+			continue
+		}
+		issue, _, err := r.checkSecret(ctx, namespace, name, refs[name])
+		if err != nil {
+			return secretsReport{}, err
+		}
+		if issue != "" {
+			report.issues = append(report.issues, issue)
+			report.notReady.Insert(name)
+		}
+	}
+
+	return report, nil
+}
+
+// checkSecret returns a description of the problem if the Secret does not
+// exist or lacks any of the given keys, and whether the Secret exists
+func (r *Reconciler) checkSecret(ctx context.Context, namespace, name string, keys sets.String) (string, bool, error) {
+	secret := &corev1.Secret{}
+	if err := r.GetClient().Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Sprintf("Secret %s not found", name), false, nil
+		}
+		return "", false, err
+	}
+
+	missing := []string{}
+	for _, key := range keys.List() {
+		if _, ok := secret.Data[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Sprintf("keys not found in Secret %s: %s", name, strings.Join(missing, ", ")), true, nil
+	}
+	return "", true, nil
+}
+
+// heldWorkloads returns the "<Kind>/<name>" of the workloads that read from
+// any of the Secrets that are not ready
+func (crs ControlledResources) heldWorkloads(report secretsReport) []string {
+	held := []string{}
+	for _, w := range crs.workloads() {
+		if dependsOnAny(w, report.notReady) {
+			held = append(held, fmt.Sprintf("%s/%s", workloadKind(w), w.GetName()))
+		}
+	}
+	return held
+}
+
+// workloads returns the Deployments and the enabled StatefulSets, CronJobs and Jobs in the ControlledResources
+func (crs ControlledResources) workloads() []client.Object {
+	workloads := []client.Object{}
+	for _, d := range crs.Deployments {
+		workloads = append(workloads, d.Template())
+	}
+	for _, s := range crs.StatefulSets {
+		if s.Enabled {
+			workloads = append(workloads, s.Template())
+		}
+	}
+	for _, cj := range crs.CronJobs {
+		if cj.Enabled {
+			workloads = append(workloads, cj.Template())
+		}
+	}
+	for _, j := range crs.Jobs {
+		if j.Enabled {
+			workloads = append(workloads, j.Template())
+		}
+	}
+	return workloads
+}
+
+// dependsOnAny returns true if the object is a workload whose
+// Pods read from any of the given Secrets
+func dependsOnAny(o client.Object, secrets sets.String) bool {
+	for name := range secretKeyRefs(o) {
+		if secrets.Has(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// secretKeyRefs returns the keys the Pods of a Deployment, StatefulSet, CronJob or Job read
+// from each non optional Secret, by name of the Secret. Secrets used as a whole (envFrom or
+// volumes) are returned with no keys. Any other kind of object has no Secret references.
+func secretKeyRefs(o client.Object) map[string]sets.String {
+	spec := podSpec(o)
+	if spec == nil {
+		return nil
+	}
+
+	refs := map[string]sets.String{}
+	walkPodReferences(spec, func(src configSource, key string, optional bool) {
+		if src.kind != SecretTriggerKind || optional {
+			return
+		}
+		if _, ok := refs[src.name]; !ok {
+			refs[src.name] = sets.NewString()
+		}
+		if key != "" {
+			refs[src.name].Insert(key)
+		}
+	})
+
+	return refs
+}
+
+// workloadKind returns the kind of a Deployment, StatefulSet, CronJob or Job
+func workloadKind(o client.Object) string {
+	switch o.(type) {
+	case *appsv1.StatefulSet:
+		return "StatefulSet"
+	case *batchv1.CronJob:
+		return "CronJob"
+	case *k8sbatchv1.Job:
+		return "Job"
+	default:
+		return "Deployment"
+	}
+}
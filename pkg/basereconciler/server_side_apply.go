@@ -0,0 +1,220 @@
+package basereconciler
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// FieldManager is the field manager used by the operator when
+	// reconciling resources using server-side apply
+	FieldManager string = "saas-operator"
+	// ReplicasHandoverFieldManager is the field manager the replicas of the workloads
+	// are handed over to when the operator stops applying them, like when an autoscaler
+	// is enabled, so they are kept until the autoscaler takes them over
+	ReplicasHandoverFieldManager string = "saas-operator-replicas-handover"
+	// DefaultResyncPeriod is the default period after which each custom
+	// resource is reconciled again when not all its owned resources are watched
+	DefaultResyncPeriod time.Duration = 5 * time.Minute
+)
+
+// WithServerSideApply is an Option that, when 'enabled' is true, makes the Reconciler
+// reconcile the owned resources using Kubernetes server-side apply instead of the
+// locked resources controller. Fields not set by the generators (API server defaults,
+// fields set by other controllers) are owned by other field managers and left untouched.
+func WithServerSideApply(enabled bool) Option {
+	return func(o *options) {
+		o.serverSideApply = enabled
+	}
+}
+
+// IsServerSideApply returns true if the Reconciler uses server-side apply
+// to reconcile the owned resources
+func (r *Reconciler) IsServerSideApply() bool {
+	return r.serverSideApply
+}
+
+// ApplyOwnedResources server-side applies the given list of resources, using
+// the operator's FieldManager and forcing ownership in case of conflicts.
+// The ExcludePaths within the spec are removed from the applied objects, so
+// those fields are left to other controllers as in the locked resources mode.
+// Paused resources are skipped. The applies that revert changes made outside
+// of the operator are recorded in the drift_corrections_total metric.
+func (r *Reconciler) ApplyOwnedResources(ctx context.Context, owner client.Object, resources []LockedResource) error {
+
+	for _, res := range resources {
+		if res.Paused {
+			continue
+		}
+		u, err := applyConfiguration(res, owner, r.GetScheme())
+		if err != nil {
+			return err
+		}
+		hash := Hash(u.Object)
+
+		gvk, err := apiutil.GVKForObject(res.GeneratorFn(), r.GetScheme())
+		if err != nil {
+			return err
+		}
+		o, err := r.GetScheme().New(gvk)
+		if err != nil {
+			return err
+		}
+		live := o.(client.Object)
+		exists, err := r.getIfExists(ctx, &u, live)
+		if err != nil {
+			return err
+		}
+
+		// Fields the operator stops applying are removed by the API server if no other field
+		// manager owns them, which would reset the replicas to their default value
+		if _, applied, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "replicas"); exists && !applied &&
+			ownsField(live.GetManagedFields(), FieldManager, "spec", "replicas") {
+			if err := r.handOverReplicas(ctx, gvk, live); err != nil {
+				return err
+			}
+		}
+
+		err = r.GetClient().Patch(ctx, &u, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership)
+		if err != nil {
+			return err
+		}
+
+		if r.drift == nil {
+			continue
+		}
+		// The resource returned by the apply tells whether it has been modified
+		before := ""
+		if exists {
+			before = appliedVersion(live)
+		}
+		key := ownerKey{r.ownerKind(owner), client.ObjectKeyFromObject(owner)}
+		if r.drift.appliedConfiguration(key, gvk.GroupKind(), &u, hash, before, appliedVersion(&u)) && exists {
+			incOwnedCounter(driftCorrections, key.kind, key.key, gvk.Kind, u.GetName())
+		}
+	}
+
+	return nil
+}
+
+// handOverReplicas applies the current replicas of the live resource with the
+// ReplicasHandoverFieldManager, which shares their ownership with the operator's
+// FieldManager until the operator stops applying them
+func (r *Reconciler) handOverReplicas(ctx context.Context, gvk schema.GroupVersionKind, live client.Object) error {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return err
+	}
+	replicas, found, err := unstructured.NestedInt64(obj, "spec", "replicas")
+	if err != nil || !found {
+		return err
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	u.SetName(live.GetName())
+	u.SetNamespace(live.GetNamespace())
+	if err := unstructured.SetNestedField(u.Object, replicas, "spec", "replicas"); err != nil {
+		return err
+	}
+	return r.GetClient().Patch(ctx, u, client.Apply, client.FieldOwner(ReplicasHandoverFieldManager))
+}
+
+// ownsField returns true if the field at the given path has been applied by the manager
+func ownsField(entries []metav1.ManagedFieldsEntry, manager string, fields ...string) bool {
+	for _, entry := range entries {
+		if entry.Manager != manager || entry.Operation != metav1.ManagedFieldsOperationApply || entry.FieldsV1 == nil {
+			continue
+		}
+		set := map[string]interface{}{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &set); err != nil {
+			continue
+		}
+		path := make([]string, 0, len(fields))
+		for _, f := range fields {
+			path = append(path, "f:"+f)
+		}
+		if _, found, _ := unstructured.NestedFieldNoCopy(set, path...); found {
+			return true
+		}
+	}
+	return false
+}
+
+// applyConfiguration returns the object to server-side apply for the resource
+func applyConfiguration(res LockedResource, owner client.Object, scheme *runtime.Scheme) (unstructured.Unstructured, error) {
+	u, err := newUnstructured(res.GeneratorFn, owner, scheme)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	// The status is never owned by the operator
+	unstructured.RemoveNestedField(u.Object, "status")
+
+	// The metadata is always applied, as the exclusions of the locked resources mode only
+	// cover fields set by the API server. So is the whole spec, which is required.
+	for _, path := range res.ExcludePaths {
+		if strings.HasPrefix(path, "/spec/") {
+			removePath(u.Object, strings.Split(strings.TrimPrefix(path, "/"), "/"))
+		}
+	}
+	return u, nil
+}
+
+// removePath removes the field at the given path, which can traverse lists by index
+func removePath(obj interface{}, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		if len(fields) == 1 {
+			delete(o, fields[0])
+			return
+		}
+		removePath(o[fields[0]], fields[1:])
+	case []interface{}:
+		idx, err := strconv.Atoi(fields[0])
+		if err != nil || idx < 0 || idx >= len(o) || len(fields) == 1 {
+			// Removing whole items would shift the index of the rest
+			return
+		}
+		removePath(o[idx], fields[1:])
+	}
+}
+
+// WithResyncPeriod is an Option that sets the period after which each custom resource
+// is reconciled again when some of its owned resources are not watched. Defaults to
+// DefaultResyncPeriod.
+func WithResyncPeriod(period time.Duration) Option {
+	return func(o *options) {
+		o.resyncPeriod = period
+	}
+}
+
+// RequeueAfter returns the time after which the owner of the ControlledResources must be
+// reconciled again, or zero if there is no need. In server-side apply mode there is no
+// controller enforcing the owned resources and only some kinds are watched, so the owner
+// is periodically reconciled to revert the changes made to any of them. The same applies
+// while any VerticalPodAutoscaler is enabled, as they are not watched either and their
+// recommendations are reported in the status of the owner.
+func (r *Reconciler) RequeueAfter(crs ControlledResources) time.Duration {
+	if r.serverSideApply {
+		return r.resyncPeriod
+	}
+	for _, vpa := range crs.VerticalPodAutoscalers {
+		if vpa.Enabled {
+			return r.resyncPeriod
+		}
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+package basereconciler
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_applyConfiguration(t *testing.T) {
+	owner := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "ns", UID: "uid"}}
+	res := LockedResource{
+		GeneratorFn: func() client.Object {
+			return &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: "ns", Labels: map[string]string{"app": "test"}},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: pointer.Int32Ptr(2),
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name:  "container",
+						Image: "image",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+						},
+					}}}},
+				},
+			}
+		},
+		ExcludePaths: []string{"/metadata", "/status", "/spec/replicas",
+			"/spec/template/spec/containers/0/resources", "/spec/template/spec/containers/1/resources"},
+	}
+
+	u, err := applyConfiguration(res, owner, scheme.Scheme)
+	if err != nil {
+		t.Fatalf("applyConfiguration() error = %v", err)
+	}
+	if u.GetName() != "deployment" || u.GetLabels()["app"] != "test" || len(u.GetOwnerReferences()) != 1 {
+		t.Errorf("applyConfiguration() got metadata %v, want it untouched", u.Object["metadata"])
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "status"); ok {
+		t.Errorf("applyConfiguration() got a status")
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "replicas"); ok {
+		t.Errorf("applyConfiguration() got the excluded replicas")
+	}
+	containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	if len(containers) != 1 {
+		t.Fatalf("applyConfiguration() got %d containers, want 1", len(containers))
+	}
+	container := containers[0].(map[string]interface{})
+	if _, ok := container["resources"]; ok {
+		t.Errorf("applyConfiguration() got the excluded resources of the container")
+	}
+	if container["image"] != "image" {
+		t.Errorf("applyConfiguration() got image %v, want image", container["image"])
+	}
+}
+
+func TestReconciler_RequeueAfter(t *testing.T) {
+	tests := []struct {
+		name            string
+		serverSideApply bool
+		crs             ControlledResources
+		want            time.Duration
+	}{
+		{name: "Locked resources", want: 0},
+		{name: "Server-side apply", serverSideApply: true, want: time.Minute},
+		{
+			name: "Locked resources with a disabled VerticalPodAutoscaler",
+			crs:  ControlledResources{VerticalPodAutoscalers: []VerticalPodAutoscaler{{Enabled: false}}},
+			want: 0,
+		},
+		{
+			name: "Locked resources with an enabled VerticalPodAutoscaler",
+			crs:  ControlledResources{VerticalPodAutoscalers: []VerticalPodAutoscaler{{Enabled: false}, {Enabled: true}}},
+			want: time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{serverSideApply: tt.serverSideApply, resyncPeriod: time.Minute}
+			if got := r.RequeueAfter(tt.crs); got != tt.want {
+				t.Errorf("RequeueAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ownsField(t *testing.T) {
+	entries := []metav1.ManagedFieldsEntry{
+		{
+			Manager:   FieldManager,
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{}}}`)},
+		},
+		{
+			Manager:   "autoscaler",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:paused":{}}}`)},
+		},
+	}
+	tests := []struct {
+		name    string
+		manager string
+		fields  []string
+		want    bool
+	}{
+		{"Applied field", FieldManager, []string{"spec", "replicas"}, true},
+		{"Field not applied", FieldManager, []string{"spec", "paused"}, false},
+		{"Field of another manager", "other", []string{"spec", "replicas"}, false},
+		{"Updated field", "autoscaler", []string{"spec", "paused"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ownsField(entries, tt.manager, tt.fields...); got != tt.want {
+				t.Errorf("ownsField() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package basereconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReasonReconcileError is used when the owned resources could not be reconciled
+	ReasonReconcileError string = "ReconcileError"
+	// ReasonReconcileSuccess is used when the owned resources were successfully reconciled
+	ReasonReconcileSuccess string = "ReconcileSuccess"
+	// ReasonWorkloadsNotReady is used when some workload has not all its replicas ready
+	ReasonWorkloadsNotReady string = "WorkloadsNotReady"
+	// ReasonWorkloadsReady is used when all the workloads have all their replicas ready
+	ReasonWorkloadsReady string = "WorkloadsReady"
+	// ReasonRolloutInProgress is used when some workload is rolling out a new version
+	ReasonRolloutInProgress string = "RolloutInProgress"
+	// ReasonRolloutComplete is used when all the workloads are running the latest version
+	ReasonRolloutComplete string = "RolloutComplete"
+	// ReasonSecretsNotFound is used when some of the Secrets generated from
+	// SecretDefinitions do not exist yet
+	ReasonSecretsNotFound string = "SecretsNotFound"
+	// ReasonSecretsFound is used when all the Secrets generated from
+	// SecretDefinitions exist
+	ReasonSecretsFound string = "SecretsFound"
+	// ReasonSecretsNotReady is used when some of the Secrets the workloads
+	// depend on do not exist yet or lack any of the keys they read
+	ReasonSecretsNotReady string = "SecretsNotReady"
+	// ReasonSecretsReady is used when all the Secrets the workloads depend on
+	// exist and hold all the keys they read
+	ReasonSecretsReady string = "SecretsReady"
+	// ReasonPausedByAnnotation is used when the reconciliation of owned
+	// resources has been paused through the PausedAnnotation
+	ReasonPausedByAnnotation string = "PausedByAnnotation"
+	// ReasonNotPaused is used when no owned resource is paused
+	ReasonNotPaused string = "NotPaused"
+	// ReasonMigrationInProgress is used while the Job that migrates
+	// the database has not completed yet
+	ReasonMigrationInProgress string = "MigrationInProgress"
+	// ReasonMigrationSucceeded is used when the Job that migrates
+	// the database has completed successfully
+	ReasonMigrationSucceeded string = "MigrationSucceeded"
+	// ReasonMigrationFailed is used when the Job that migrates the database has failed
+	ReasonMigrationFailed string = "MigrationFailed"
+)
+
+// ObjectWithComponentStatus is a client.Object that exposes the
+// ComponentStatus shared by all the custom resources of the operator
+type ObjectWithComponentStatus interface {
+	client.Object
+	GetComponentStatus() *saasv1alpha1.ComponentStatus
+}
+
+// ReconcileStatus computes the status of the custom resource from the live state of
+// its owned resources and the result of the last reconcile, and updates it
+// in the API if it has changed
+func (r *Reconciler) ReconcileStatus(ctx context.Context, owner ObjectWithComponentStatus,
+	crs ControlledResources, reconcileErr error) error {
+
+	status := owner.GetComponentStatus()
+	old := status.DeepCopy()
+
+	workloads, progressing, err := r.workloadsStatus(ctx, crs)
+	if err != nil {
+		return err
+	}
+	status.Workloads = workloads
+	status.ObservedGeneration = owner.GetGeneration()
+
+	// The owner has the defaults applied in memory at this point. The effective spec is
+	// only reported for the resources that persist their defaults, as it would otherwise
+	// double the size of every stored custom resource
+	status.EffectiveSpec = nil
+	if persistsDefaults(owner) {
+		status.EffectiveSpec, err = saasv1alpha1.EffectiveSpec(owner)
+		if err != nil {
+			return err
+		}
+	}
+
+	status.PendingChanges = nil
+	if IsDryRun(owner) {
+		diffs, err := r.DiffOwnedResources(ctx, owner, crs)
+		if err != nil {
+			return err
+		}
+		status.PendingChanges = PendingChanges(diffs)
+	}
+
+	report, err := r.reportSecrets(ctx, crs)
+	if err != nil {
+		return err
+	}
+	r.recordSecretsNotReady(owner, report)
+	secretsMessage := report.String()
+	if held := crs.heldWorkloads(report); len(held) > 0 && r.waitForSecrets(owner) {
+		secretsMessage = fmt.Sprintf("%s; rollout held for: %s", secretsMessage, strings.Join(held, ", "))
+	}
+	if len(report.unsynced) == 0 {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.SecretsSyncedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonSecretsFound,
+			Message: "All Secrets have been synced",
+		})
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.SecretsSyncedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonSecretsNotFound,
+			Message: fmt.Sprintf("Secrets not found: %s", strings.Join(report.unsynced, ", ")),
+		})
+	}
+	if report.ready() {
+		if cond := meta.FindStatusCondition(status.Conditions, saasv1alpha1.SecretsReadyCondition); cond != nil &&
+			cond.Status == metav1.ConditionFalse {
+			r.GetRecorder().Event(owner, corev1.EventTypeNormal, ReasonSecretsReady, secretsMessage)
+		}
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.SecretsReadyCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonSecretsReady,
+			Message: secretsMessage,
+		})
+	} else {
+		if cond := meta.FindStatusCondition(status.Conditions, saasv1alpha1.SecretsReadyCondition); cond == nil ||
+			cond.Status != metav1.ConditionFalse || cond.Message != secretsMessage {
+			r.GetRecorder().Event(owner, corev1.EventTypeWarning, ReasonSecretsNotReady, secretsMessage)
+		}
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.SecretsReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonSecretsNotReady,
+			Message: secretsMessage,
+		})
+	}
+
+	migration, err := r.reportMigration(ctx, crs)
+	if err != nil {
+		return err
+	}
+	r.reconcileMigrationStatus(owner, status, migration)
+	if migration.state == migrationRunning {
+		progressing = append(progressing, migration.job.GetName())
+	}
+
+	if reconcileErr != nil {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.DegradedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonReconcileError,
+			Message: reconcileErr.Error(),
+		})
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.DegradedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonReconcileSuccess,
+			Message: "Owned resources successfully reconciled",
+		})
+	}
+
+	if paused := GetPausedResources(owner); paused.IsPaused() {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.PausedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonPausedByAnnotation,
+			Message: fmt.Sprintf("Reconciliation paused for: %s", paused),
+		})
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.PausedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonNotPaused,
+			Message: "All owned resources are being reconciled",
+		})
+	}
+
+	notReady := []string{}
+	for _, w := range workloads {
+		if w.ReadyReplicas < w.DesiredReplicas {
+			notReady = append(notReady, w.Name)
+		}
+	}
+
+	if len(progressing) > 0 {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ProgressingCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonRolloutInProgress,
+			Message: fmt.Sprintf("Rollout in progress: %s", strings.Join(progressing, ", ")),
+		})
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ProgressingCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonRolloutComplete,
+			Message: "All workloads are running the latest version",
+		})
+	}
+
+	switch {
+	case reconcileErr != nil:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonReconcileError,
+			Message: reconcileErr.Error(),
+		})
+	case !report.ready():
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonSecretsNotReady,
+			Message: secretsMessage,
+		})
+	case migration.state == migrationFailed:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonMigrationFailed,
+			Message: migration.String(),
+		})
+	case len(notReady) > 0:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonWorkloadsNotReady,
+			Message: fmt.Sprintf("Workloads not ready: %s", strings.Join(notReady, ", ")),
+		})
+	default:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.ReadyCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonWorkloadsReady,
+			Message: "All workloads are ready",
+		})
+	}
+
+	// Only write to the API when something has changed to avoid
+	// triggering unnecessary reconciles
+	if old.EffectiveSpec, err = normalizeRawExtension(old.EffectiveSpec); err != nil {
+		return err
+	}
+	if reflect.DeepEqual(old, status) {
+		return nil
+	}
+	return r.GetClient().Status().Update(ctx, owner)
+}
+
+// reconcileMigrationStatus sets the Migrated condition and the last successful migration
+// in the status from the state of the migration Job, and records an event whenever the
+// migration succeeds or fails. Both are removed when the migration is not enabled.
+func (r *Reconciler) reconcileMigrationStatus(owner client.Object, status *saasv1alpha1.ComponentStatus,
+	migration migrationReport) {
+
+	cond := meta.FindStatusCondition(status.Conditions, saasv1alpha1.MigratedCondition)
+
+	switch migration.state {
+	case migrationNotEnabled:
+		meta.RemoveStatusCondition(&status.Conditions, saasv1alpha1.MigratedCondition)
+		status.Migration = nil
+	case migrationSucceeded:
+		if cond == nil || cond.Status != metav1.ConditionTrue {
+			r.GetRecorder().Event(owner, corev1.EventTypeNormal, ReasonMigrationSucceeded, migration.String())
+		}
+		status.Migration = migration.status()
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.MigratedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonMigrationSucceeded,
+			Message: migration.String(),
+		})
+	case migrationFailed:
+		if cond == nil || cond.Reason != ReasonMigrationFailed || cond.Message != migration.String() {
+			r.GetRecorder().Event(owner, corev1.EventTypeWarning, ReasonMigrationFailed, migration.String())
+		}
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.MigratedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonMigrationFailed,
+			Message: migration.String(),
+		})
+	default:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    saasv1alpha1.MigratedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonMigrationInProgress,
+			Message: migration.String(),
+		})
+	}
+}
+
+// normalizeRawExtension re-encodes the given RawExtension so it can be compared with the
+// ones generated by the operator, as the API might encode the same JSON differently
+func normalizeRawExtension(in *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if in == nil || in.Raw == nil {
+		return in, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(in.Raw, &v); err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// workloadsStatus returns the status of the Deployments and enabled StatefulSets
+// in the ControlledResources, as read from the API, and the names of the workloads
+// that are still rolling out their latest spec
+func (r *Reconciler) workloadsStatus(ctx context.Context, crs ControlledResources) ([]saasv1alpha1.WorkloadStatus, []string, error) {
+	workloads := []saasv1alpha1.WorkloadStatus{}
+	progressing := []string{}
+
+	recommendations, err := r.recommendations(ctx, crs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, d := range crs.Deployments {
+		desired := d.Template().(*appsv1.Deployment)
+		dep := &appsv1.Deployment{}
+		exists, err := r.getIfExists(ctx, desired, dep)
+		if err != nil {
+			return nil, nil, err
+		}
+		ws := saasv1alpha1.WorkloadStatus{Kind: "Deployment", Name: desired.GetName()}
+		ws.Recommendations = recommendations[ws.Kind+"/"+ws.Name]
+		if desired.Spec.Replicas != nil {
+			ws.DesiredReplicas = *desired.Spec.Replicas
+		}
+		if exists {
+			if dep.Spec.Replicas != nil {
+				ws.DesiredReplicas = *dep.Spec.Replicas
+			}
+			ws.ReadyReplicas = dep.Status.ReadyReplicas
+			ws.UpdatedReplicas = dep.Status.UpdatedReplicas
+		}
+		if !exists || dep.Status.ObservedGeneration < dep.GetGeneration() ||
+			ws.UpdatedReplicas < ws.DesiredReplicas || dep.Status.Replicas > dep.Status.UpdatedReplicas {
+			progressing = append(progressing, ws.Name)
+		}
+		workloads = append(workloads, ws)
+	}
+
+	for _, s := range crs.StatefulSets {
+		if !s.Enabled {
+			continue
+		}
+		desired := s.Template().(*appsv1.StatefulSet)
+		ss := &appsv1.StatefulSet{}
+		exists, err := r.getIfExists(ctx, desired, ss)
+		if err != nil {
+			return nil, nil, err
+		}
+		ws := saasv1alpha1.WorkloadStatus{Kind: "StatefulSet", Name: desired.GetName()}
+		ws.Recommendations = recommendations[ws.Kind+"/"+ws.Name]
+		if desired.Spec.Replicas != nil {
+			ws.DesiredReplicas = *desired.Spec.Replicas
+		}
+		if exists {
+			if ss.Spec.Replicas != nil {
+				ws.DesiredReplicas = *ss.Spec.Replicas
+			}
+			ws.ReadyReplicas = ss.Status.ReadyReplicas
+			ws.UpdatedReplicas = ss.Status.UpdatedReplicas
+		}
+		if !exists || ss.Status.ObservedGeneration < ss.GetGeneration() ||
+			ws.UpdatedReplicas < ws.DesiredReplicas || ss.Status.CurrentRevision != ss.Status.UpdateRevision {
+			progressing = append(progressing, ws.Name)
+		}
+		workloads = append(workloads, ws)
+	}
+
+	return workloads, progressing, nil
+}
+
+// recommendations returns the resources recommended by the enabled VerticalPodAutoscalers
+// in the ControlledResources, as read from the API, indexed by the "<kind>/<name>" of the
+// workload they target
+func (r *Reconciler) recommendations(ctx context.Context, crs ControlledResources) (map[string][]saasv1alpha1.ContainerRecommendation, error) {
+	recommendations := map[string][]saasv1alpha1.ContainerRecommendation{}
+
+	for _, v := range crs.VerticalPodAutoscalers {
+		if !v.Enabled {
+			continue
+		}
+		desired := v.Template().(*vpav1.VerticalPodAutoscaler)
+		vpa := &vpav1.VerticalPodAutoscaler{}
+		exists, err := r.getIfExists(ctx, desired, vpa)
+		if err != nil {
+			return nil, err
+		}
+		if !exists || vpa.Status.Recommendation == nil || desired.Spec.TargetRef == nil {
+			continue
+		}
+		list := []saasv1alpha1.ContainerRecommendation{}
+		for _, cr := range vpa.Status.Recommendation.ContainerRecommendations {
+			list = append(list, saasv1alpha1.ContainerRecommendation{
+				ContainerName: cr.ContainerName,
+				Target:        cr.Target,
+				LowerBound:    cr.LowerBound,
+				UpperBound:    cr.UpperBound,
+			})
+		}
+		recommendations[desired.Spec.TargetRef.Kind+"/"+desired.Spec.TargetRef.Name] = list
+	}
+
+	return recommendations, nil
+}
+
+// getIfExists retrieves into 'into' the live object with the same
+// name and namespace as 'desired'. It returns false if it does not exist.
+func (r *Reconciler) getIfExists(ctx context.Context, desired client.Object, into client.Object) (bool, error) {
+	key := types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}
+	if err := r.GetClient().Get(ctx, key, into); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// persistsDefaults returns true if the custom resource has the PersistDefaultsAnnotation
+func persistsDefaults(instance client.Object) bool {
+	return strings.TrimSpace(instance.GetAnnotations()[saasv1alpha1.PersistDefaultsAnnotation]) == "true"
+}
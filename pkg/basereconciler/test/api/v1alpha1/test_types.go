@@ -49,10 +49,24 @@ type TestSpec struct {
 	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
 	// +optional
 	Marin3r *saasv1alpha1.Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// +optional
+	PDB *saasv1alpha1.PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Migration is the image of the migration Job, which the Deployment
+	// also runs once the migration succeeds
+	// +optional
+	Migration *string `json:"migration,omitempty"`
+	// Replicas is the number of replicas of the Deployment
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Autoscaled marks the replicas of the Deployment as managed by an autoscaler
+	// +optional
+	Autoscaled bool `json:"autoscaled,omitempty"`
 }
 
 // TestStatus defines the observed state of Test
-type TestStatus struct{}
+type TestStatus struct {
+	saasv1alpha1.ComponentStatus `json:",inline"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -66,6 +80,11 @@ type Test struct {
 	Status TestStatus `json:"status,omitempty"`
 }
 
+// GetComponentStatus returns a pointer to the Test ComponentStatus
+func (t *Test) GetComponentStatus() *saasv1alpha1.ComponentStatus {
+	return &t.Status.ComponentStatus
+}
+
 // +kubebuilder:object:root=true
 
 // TestList contains a list of Test
@@ -31,7 +31,7 @@ func (in *Test) DeepCopyInto(out *Test) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Test.
@@ -99,6 +99,21 @@ func (in *TestSpec) DeepCopyInto(out *TestSpec) {
 		*out = new(apiv1alpha1.Marin3rSidecarSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PDB != nil {
+		in, out := &in.PDB, &out.PDB
+		*out = new(apiv1alpha1.PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSpec.
@@ -114,6 +129,7 @@ func (in *TestSpec) DeepCopy() *TestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestStatus) DeepCopyInto(out *TestStatus) {
 	*out = *in
+	in.ComponentStatus.DeepCopyInto(&out.ComponentStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestStatus.
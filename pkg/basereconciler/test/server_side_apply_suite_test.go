@@ -0,0 +1,296 @@
+package test
+
+import (
+	"context"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/basereconciler/test/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Test controller with server-side apply", func() {
+	var namespace string
+	var instance *v1alpha1.Test
+
+	BeforeEach(func() {
+		// Create a namespace for each block
+		namespace = "test-ns-" + nameGenerator.Generate()
+
+		// Add any setup steps that needs to be executed before each test
+		testNamespace := &corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+		}
+
+		err := k8sClient.Create(context.Background(), testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		n := &corev1.Namespace{}
+		Eventually(func() error {
+			return k8sClient.Get(context.Background(), types.NamespacedName{Name: namespace}, n)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+
+		By("creating a Test resource reconciled with server-side apply")
+		instance = &v1alpha1.Test{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "instance",
+				Namespace:   namespace,
+				Annotations: map[string]string{ServerSideApplyAnnotation: "true"},
+			},
+			Spec: v1alpha1.TestSpec{},
+		}
+		err = k8sClient.Create(context.Background(), instance)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(func() error {
+			return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+	})
+
+	It("creates the required resources owned by the operator's field manager", func() {
+
+		dep := &appsv1.Deployment{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+		Expect(hasFieldManager(dep.GetManagedFields(), basereconciler.FieldManager)).To(BeTrue())
+
+		svc := &corev1.Service{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+		Expect(hasFieldManager(svc.GetManagedFields(), basereconciler.FieldManager)).To(BeTrue())
+
+		sd := &secretsmanagerv1alpha1.SecretDefinition{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "secret", Namespace: namespace},
+				sd,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+		Expect(hasFieldManager(sd.GetManagedFields(), basereconciler.FieldManager)).To(BeTrue())
+	})
+
+	It("updates service annotations", func() {
+		svc := &corev1.Service{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+
+		patch := client.MergeFrom(instance.DeepCopy())
+		instance.Spec.ServiceAnnotations = map[string]string{"key": "value"}
+		err := k8sClient.Patch(context.Background(), instance, patch)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() bool {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return svc.GetAnnotations()["key"] == "value"
+		}, timeout, poll).Should(BeTrue())
+	})
+
+	It("reverts changes to managed fields and keeps fields owned by other managers", func() {
+		dep := &appsv1.Deployment{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+
+		patch := client.MergeFrom(dep.DeepCopy())
+		dep.Spec.Replicas = pointer.Int32Ptr(3)
+		dep.SetAnnotations(map[string]string{"other-controller": "value"})
+		err := k8sClient.Patch(context.Background(), dep, patch, client.FieldOwner("other-controller"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() bool {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return *dep.Spec.Replicas == 1
+		}, timeout, poll).Should(BeTrue())
+
+		Consistently(func() string {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return dep.GetAnnotations()["other-controller"]
+		}, 3*poll, poll).Should(Equal("value"))
+	})
+
+	It("periodically reverts changes to owned resources that are not watched", func() {
+		svc := &corev1.Service{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+
+		patch := client.MergeFrom(svc.DeepCopy())
+		svc.Spec.Selector = map[string]string{"selector": "other"}
+		err := k8sClient.Patch(context.Background(), svc, patch, client.FieldOwner("other-controller"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() string {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return svc.Spec.Selector["selector"]
+		}, timeout, poll).Should(Equal("deployment"))
+	})
+
+	It("reports the pending changes computed with a dry-run apply", func() {
+		svc := &corev1.Service{}
+		Eventually(func() error {
+			return k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+		}, timeout, poll).ShouldNot(HaveOccurred())
+		patch := client.MergeFrom(instance.DeepCopy())
+		instance.SetAnnotations(map[string]string{
+			ServerSideApplyAnnotation:     "true",
+			saasv1alpha1.DryRunAnnotation: "true",
+		})
+		instance.Spec.ServiceAnnotations = map[string]string{"key": "value"}
+		err := k8sClient.Patch(context.Background(), instance, patch)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() bool {
+			err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+			Expect(err).ToNot(HaveOccurred())
+			for _, change := range instance.Status.PendingChanges {
+				if change.Kind == "Service" && change.Name == "service" && change.Action == basereconciler.ActionUpdate {
+					return true
+				}
+			}
+			return false
+		}, timeout, poll).Should(BeTrue())
+
+		Consistently(func() map[string]string {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "service", Namespace: namespace},
+				svc,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return svc.GetAnnotations()
+		}, 3*poll, poll).ShouldNot(HaveKey("key"))
+	})
+
+	It("keeps the replicas of a Deployment that switches to autoscaling", func() {
+		patch := client.MergeFrom(instance.DeepCopy())
+		instance.Spec.Replicas = pointer.Int32Ptr(3)
+		err := k8sClient.Patch(context.Background(), instance, patch)
+		Expect(err).ToNot(HaveOccurred())
+
+		dep := &appsv1.Deployment{}
+		Eventually(func() int32 {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+			if err != nil {
+				return 0
+			}
+			return *dep.Spec.Replicas
+		}, timeout, poll).Should(Equal(int32(3)))
+
+		By("enabling the autoscaler of the Deployment")
+		err = k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+		Expect(err).ToNot(HaveOccurred())
+		patch = client.MergeFrom(instance.DeepCopy())
+		instance.Spec.Autoscaled = true
+		err = k8sClient.Patch(context.Background(), instance, patch)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() bool {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return hasFieldManager(dep.GetManagedFields(), basereconciler.ReplicasHandoverFieldManager)
+		}, timeout, poll).Should(BeTrue())
+
+		Consistently(func() int32 {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return *dep.Spec.Replicas
+		}, 3*poll, poll).Should(Equal(int32(3)))
+
+		By("scaling the Deployment from the autoscaler")
+		patch = client.MergeFrom(dep.DeepCopy())
+		dep.Spec.Replicas = pointer.Int32Ptr(5)
+		err = k8sClient.Patch(context.Background(), dep, patch, client.FieldOwner("autoscaler"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Consistently(func() int32 {
+			err := k8sClient.Get(
+				context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace},
+				dep,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return *dep.Spec.Replicas
+		}, 3*poll, poll).Should(Equal(int32(5)))
+	})
+})
+
+func hasFieldManager(entries []metav1.ManagedFieldsEntry, manager string) bool {
+	for _, entry := range entries {
+		if entry.Manager == manager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
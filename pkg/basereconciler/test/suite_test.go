@@ -17,6 +17,7 @@ limitations under the License.
 package test
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 	"time"
@@ -35,8 +36,12 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
 	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
@@ -86,6 +91,15 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	err = secretsmanagerv1alpha1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
+	err = externalsecretsv1beta1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = kedav1alpha1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = vpav1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = batchv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: scheme.Scheme,
@@ -95,6 +109,21 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).ToNot(HaveOccurred())
 
+	err = basereconciler.IndexSecretDefinitions(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexDeploymentSecrets(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexStatefulSetSecrets(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexDeploymentConfigMaps(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = basereconciler.IndexStatefulSetConfigMaps(context.Background(), mgr)
+	Expect(err).ToNot(HaveOccurred())
+
 	k8sClient = mgr.GetClient()
 	Expect(k8sClient).ToNot(BeNil())
 
@@ -111,6 +140,14 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
+	err = (&Reconciler{
+		Reconciler: basereconciler.NewFromManager(mgr, mgr.GetEventRecorderFor("Test"), false,
+			basereconciler.WithServerSideApply(true),
+			basereconciler.WithResyncPeriod(poll)),
+		Log: ctrl.Log.WithName("controllers").WithName("TestServerSideApply"),
+	}).SetupWithManager(mgr)
+	Expect(err).ToNot(HaveOccurred())
+
 }, 60)
 
 var _ = AfterSuite(func() {
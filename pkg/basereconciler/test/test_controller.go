@@ -24,8 +24,11 @@ import (
 	"github.com/3scale/saas-operator/pkg/basereconciler"
 	"github.com/3scale/saas-operator/pkg/basereconciler/test/api/v1alpha1"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/marin3r"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/migration"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,6 +40,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+// ServerSideApplyAnnotation selects the server-side apply reconciler
+// for a Test resource when set to "true"
+const ServerSideApplyAnnotation string = "example.com/server-side-apply"
+
 // Reconciler reconciles a Test object
 // +kubebuilder:object:generate=false
 type Reconciler struct {
@@ -51,21 +58,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	instance := &v1alpha1.Test{}
 	key := types.NamespacedName{Name: req.Name, Namespace: req.Namespace}
+	// Each Test resource is handled by the reconciler of the
+	// engine selected through its annotations
+	if err := r.GetClient().Get(ctx, key, instance); err == nil &&
+		(instance.GetAnnotations()[ServerSideApplyAnnotation] == "true") != r.IsServerSideApply() {
+		return ctrl.Result{}, nil
+	}
+
 	result, err := r.GetInstance(ctx, key, instance, "finalizer.example.com", log)
 	if result != nil || err != nil {
 		return *result, err
 	}
 
-	triggers, err := r.TriggersFromSecretDefs(ctx, secretDefinition(req.Namespace))
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	err = r.ReconcileOwnedResources(ctx, instance, basereconciler.ControlledResources{
+	crs := basereconciler.ControlledResources{
 		Deployments: []basereconciler.Deployment{{
-			Template:        deployment(req.Namespace, instance.Spec.Marin3r),
-			RolloutTriggers: triggers,
-			HasHPA:          false,
+			Template:       deployment(req.Namespace, instance.Spec.Marin3r, instance.Spec.Migration, instance.Spec.Replicas),
+			TriggerSources: []basereconciler.RolloutTriggerSource{basereconciler.ConfigMapTriggerSource("config")},
+			HasHPA:         instance.Spec.Autoscaled,
+		}},
+		Jobs: []basereconciler.Job{{
+			Template:  migrationJob(req.Namespace, instance.Spec.Migration),
+			Enabled:   instance.Spec.Migration != nil,
+			Migration: true,
 		}},
 		SecretDefinitions: []basereconciler.SecretDefinition{{
 			Template: secretDefinition(req.Namespace),
@@ -76,8 +90,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			Enabled:  true,
 		}},
 		PodDisruptionBudgets: []basereconciler.PodDisruptionBudget{{
-			Template: nil,
-			Enabled:  false,
+			Template: podDisruptionBudget(req.Namespace, instance.Spec.PDB),
+			Enabled:  instance.Spec.PDB != nil && !instance.Spec.PDB.IsDeactivated(),
 		}},
 		HorizontalPodAutoscalers: []basereconciler.HorizontalPodAutoscaler{{
 			Template: nil,
@@ -91,27 +105,52 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			Template: nil,
 			Enabled:  false,
 		}},
-	})
+	}
 
+	err = r.ReconcileOwnedResources(ctx, instance, crs)
 	if err != nil {
 		log.Error(err, "unable to reconcile owned resources")
+		if statusErr := r.ReconcileStatus(ctx, instance, crs, err); statusErr != nil {
+			log.Error(statusErr, "unable to update status")
+		}
+		return r.ManageError(ctx, instance, err)
+	}
+
+	err = r.ReconcileStatus(ctx, instance, crs, nil)
+	if err != nil {
+		log.Error(err, "unable to update status")
 		return r.ManageError(ctx, instance, err)
 	}
 
-	return r.ManageSuccess(ctx, instance)
+	return r.ManageSuccessWithRequeue(ctx, instance, r.RequeueAfter(crs))
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	name := "test"
+	if r.IsServerSideApply() {
+		name = "test-server-side-apply"
+	}
 	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
 		For(&v1alpha1.Test{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&k8sbatchv1.Job{}).
 		Watches(&source.Channel{Source: r.GetStatusChangeChannel()}, &handler.EnqueueRequestForObject{}).
 		Watches(&source.Kind{Type: &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret"}}},
 			r.SecretEventHandler(&v1alpha1.TestList{}, r.Log)).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{Kind: "ConfigMap"}}},
+			r.ConfigMapEventHandler(&v1alpha1.TestList{}, r.Log)).
 		Complete(r)
 }
 
-func deployment(namespace string, marin3rSpec *saasv1alpha1.Marin3rSidecarSpec) basereconciler.GeneratorFunction {
+func deployment(namespace string, marin3rSpec *saasv1alpha1.Marin3rSidecarSpec, image *string, replicas *int32) basereconciler.GeneratorFunction {
+	if image == nil {
+		image = pointer.StringPtr("example.com:latest")
+	}
+	if replicas == nil {
+		replicas = pointer.Int32Ptr(1)
+	}
 	return func() client.Object {
 		dep := &appsv1.Deployment{
 			TypeMeta: metav1.TypeMeta{
@@ -123,7 +162,7 @@ func deployment(namespace string, marin3rSpec *saasv1alpha1.Marin3rSidecarSpec)
 				Namespace: namespace,
 			},
 			Spec: appsv1.DeploymentSpec{
-				Replicas: pointer.Int32Ptr(1),
+				Replicas: replicas,
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"selector": "deployment"},
 				},
@@ -135,8 +174,17 @@ func deployment(namespace string, marin3rSpec *saasv1alpha1.Marin3rSidecarSpec)
 						Containers: []corev1.Container{
 							{
 								Name:      "container",
-								Image:     "example.com:latest",
+								Image:     *image,
 								Resources: corev1.ResourceRequirements{},
+								Env: []corev1.EnvVar{{
+									Name: "KEY",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+											Key:                  "KEY",
+										},
+									},
+								}},
 							},
 						},
 					},
@@ -152,6 +200,18 @@ func deployment(namespace string, marin3rSpec *saasv1alpha1.Marin3rSidecarSpec)
 	}
 }
 
+func migrationJob(namespace string, image *string) basereconciler.GeneratorFunction {
+	if image == nil {
+		image = pointer.StringPtr("example.com:latest")
+	}
+	return migration.New(
+		types.NamespacedName{Name: "migration", Namespace: namespace},
+		map[string]string{},
+		saasv1alpha1.MigrationSpec{},
+		corev1.PodSpec{Containers: []corev1.Container{{Name: "migration", Image: *image}}},
+	)
+}
+
 func service(namespace string, annotations map[string]string) basereconciler.GeneratorFunction {
 	return func() client.Object {
 		return &corev1.Service{
@@ -176,6 +236,18 @@ func service(namespace string, annotations map[string]string) basereconciler.Gen
 	}
 }
 
+func podDisruptionBudget(namespace string, cfg *saasv1alpha1.PodDisruptionBudgetSpec) basereconciler.GeneratorFunction {
+	if cfg == nil {
+		cfg = &saasv1alpha1.PodDisruptionBudgetSpec{}
+	}
+	return pdb.New(
+		types.NamespacedName{Name: "pdb", Namespace: namespace},
+		map[string]string{},
+		map[string]string{"selector": "deployment"},
+		*cfg,
+	)
+}
+
 func secretDefinition(namespace string) basereconciler.GeneratorFunction {
 
 	return func() client.Object {
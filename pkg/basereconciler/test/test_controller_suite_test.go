@@ -2,20 +2,28 @@ package test
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
 	"github.com/3scale/saas-operator/pkg/basereconciler"
 	"github.com/3scale/saas-operator/pkg/basereconciler/test/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	k8sbatchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -159,6 +167,282 @@ var _ = Describe("Test controller", func() {
 			}, timeout, poll).ShouldNot(BeTrue())
 		})
 
+		It("Triggers a Deployment rollout on ConfigMap contents change", func() {
+
+			dep := &appsv1.Deployment{}
+			Eventually(func() error {
+				return k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "deployment", Namespace: namespace},
+					dep,
+				)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+			// Annotations should be empty when the ConfigMap does not exist
+			value, ok := dep.Spec.Template.ObjectMeta.Annotations["saas.3scale.net/config.configmap-hash"]
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal(""))
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace},
+				Data:       map[string]string{"KEY": "value"},
+			}
+			err := k8sClient.Create(context.Background(), cm)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() string {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "deployment", Namespace: namespace},
+					dep,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				// Value of the annotation should be the hash of the ConfigMap contents
+				return dep.Spec.Template.ObjectMeta.Annotations["saas.3scale.net/config.configmap-hash"]
+			}, timeout, poll).Should(Equal(basereconciler.Hash(cm.Data)))
+
+			patch := client.MergeFrom(cm.DeepCopy())
+			cm.Data = map[string]string{"KEY": "new-value"}
+			err = k8sClient.Patch(context.Background(), cm, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() string {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "deployment", Namespace: namespace},
+					dep,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				// Value of the annotation should be the hash of the ConfigMap new contents
+				return dep.Spec.Template.ObjectMeta.Annotations["saas.3scale.net/config.configmap-hash"]
+			}, timeout, poll).Should(Equal(basereconciler.Hash(cm.Data)))
+		})
+
+		It("reports the status of the owned resources", func() {
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				return instance.Status.ObservedGeneration == instance.GetGeneration() &&
+					meta.IsStatusConditionFalse(instance.Status.Conditions, saasv1alpha1.SecretsSyncedCondition)
+			}, timeout, poll).Should(BeTrue())
+
+			// There is no deployment controller in the test environment, so
+			// the Deployment never gets ready
+			Expect(meta.IsStatusConditionFalse(instance.Status.Conditions, saasv1alpha1.ReadyCondition)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(instance.Status.Conditions, saasv1alpha1.DegradedCondition)).To(BeTrue())
+			Expect(instance.Status.Workloads).To(Equal([]saasv1alpha1.WorkloadStatus{{
+				Kind: "Deployment", Name: "deployment", DesiredReplicas: 1, ReadyReplicas: 0, UpdatedReplicas: 0,
+			}}))
+			Expect(instance.Status.EffectiveSpec).To(BeNil())
+			Expect(meta.FindStatusCondition(instance.Status.Conditions, saasv1alpha1.SecretsReadyCondition).Message).
+				To(ContainSubstring("SecretDefinition secret: Secret secret not found"))
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       map[string][]byte{"KEY": []byte("value")},
+			}
+			err := k8sClient.Create(context.Background(), secret)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				return meta.IsStatusConditionTrue(instance.Status.Conditions, saasv1alpha1.SecretsSyncedCondition)
+			}, timeout, poll).Should(BeTrue())
+			Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, saasv1alpha1.SecretsReadyCondition)).To(BeTrue())
+		})
+
+		It("reports the effective spec of the resources that persist their defaults", func() {
+
+			patch := client.MergeFrom(instance.DeepCopy())
+			instance.SetAnnotations(map[string]string{saasv1alpha1.PersistDefaultsAnnotation: "true"})
+			err := k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				return instance.Status.EffectiveSpec != nil
+			}, timeout, poll).Should(BeTrue())
+
+			patch = client.MergeFrom(instance.DeepCopy())
+			instance.SetAnnotations(map[string]string{saasv1alpha1.PersistDefaultsAnnotation: "false"})
+			err = k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				return instance.Status.EffectiveSpec == nil
+			}, timeout, poll).Should(BeTrue())
+		})
+
+		It("deletes owned resources that get disabled", func() {
+
+			patch := client.MergeFrom(instance.DeepCopy())
+			instance.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: util.IntStrPtr(intstr.FromInt(1))}
+			err := k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			pdb := &policyv1beta1.PodDisruptionBudget{}
+			Eventually(func() error {
+				return k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "pdb", Namespace: namespace},
+					pdb,
+				)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+			patch = client.MergeFrom(instance.DeepCopy())
+			instance.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
+			err = k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "pdb", Namespace: namespace},
+					pdb,
+				)
+				return errors.IsNotFound(err)
+			}, timeout, poll).Should(BeTrue())
+
+			// Other owned resources are kept
+			Expect(k8sClient.Get(context.Background(),
+				types.NamespacedName{Name: "deployment", Namespace: namespace}, &appsv1.Deployment{})).ToNot(HaveOccurred())
+		})
+
+		It("stops enforcing paused resources", func() {
+
+			dep := &appsv1.Deployment{}
+			Eventually(func() error {
+				return k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "deployment", Namespace: namespace},
+					dep,
+				)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+			patch := client.MergeFrom(instance.DeepCopy())
+			instance.SetAnnotations(map[string]string{saasv1alpha1.PausedAnnotation: "Deployment/deployment"})
+			err := k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				return meta.IsStatusConditionTrue(instance.Status.Conditions, saasv1alpha1.PausedCondition)
+			}, timeout, poll).Should(BeTrue())
+
+			depPatch := client.MergeFrom(dep.DeepCopy())
+			dep.Spec.Replicas = pointer.Int32Ptr(3)
+			err = k8sClient.Patch(context.Background(), dep, depPatch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Consistently(func() int32 {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "deployment", Namespace: namespace},
+					dep,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return *dep.Spec.Replicas
+			}, 3*poll, poll).Should(Equal(int32(3)))
+
+			// Remove the annotation to resume reconciliation
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+			patch = client.MergeFrom(instance.DeepCopy())
+			instance.SetAnnotations(map[string]string{})
+			err = k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() int32 {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "deployment", Namespace: namespace},
+					dep,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return *dep.Spec.Replicas
+			}, timeout, poll).Should(Equal(int32(1)))
+		})
+
+		It("reports pending changes without applying them in dry-run mode", func() {
+
+			svc := &v1.Service{}
+			Eventually(func() error {
+				return k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "service", Namespace: namespace},
+					svc,
+				)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+			patch := client.MergeFrom(instance.DeepCopy())
+			instance.SetAnnotations(map[string]string{saasv1alpha1.DryRunAnnotation: "true"})
+			instance.Spec.ServiceAnnotations = map[string]string{"key": "value"}
+			err := k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				for _, change := range instance.Status.PendingChanges {
+					if change.Kind == "Service" && change.Name == "service" && change.Action == basereconciler.ActionUpdate {
+						return true
+					}
+				}
+				return false
+			}, timeout, poll).Should(BeTrue())
+
+			Consistently(func() map[string]string {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "service", Namespace: namespace},
+					svc,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return svc.GetAnnotations()
+			}, 3*poll, poll).ShouldNot(HaveKey("key"))
+
+			// Remove the annotation to apply the changes
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+			patch = client.MergeFrom(instance.DeepCopy())
+			instance.SetAnnotations(map[string]string{})
+			err = k8sClient.Patch(context.Background(), instance, patch)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() map[string]string {
+				err := k8sClient.Get(
+					context.Background(),
+					types.NamespacedName{Name: "service", Namespace: namespace},
+					svc,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return svc.GetAnnotations()
+			}, timeout, poll).Should(HaveKeyWithValue("key", "value"))
+
+			Eventually(func() []saasv1alpha1.PendingChange {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				Expect(err).ToNot(HaveOccurred())
+				return instance.Status.PendingChanges
+			}, timeout, poll).Should(BeEmpty())
+		})
+
 		It("Deletes all owned resources when custom resource is deleted", func() {
 			// Wait for all resources to be created
 			Eventually(func() bool {
@@ -323,4 +607,108 @@ var _ = Describe("Test controller", func() {
 		})
 	})
 
+	for _, ssa := range []bool{false, true} {
+		serverSideApply := ssa
+
+		Context(fmt.Sprintf("Migration Jobs (server-side apply: %t)", serverSideApply), func() {
+
+			// jobs returns the migration Jobs in the namespace
+			jobs := func() []k8sbatchv1.Job {
+				list := &k8sbatchv1.JobList{}
+				err := k8sClient.List(context.Background(), list, client.InNamespace(namespace))
+				Expect(err).ToNot(HaveOccurred())
+				return list.Items
+			}
+
+			// finish sets the condition of the Job that finishes it
+			finish := func(job k8sbatchv1.Job, condition k8sbatchv1.JobConditionType) {
+				now := metav1.Now()
+				job.Status.StartTime = &now
+				if condition == k8sbatchv1.JobComplete {
+					job.Status.CompletionTime = &now
+				}
+				job.Status.Conditions = []k8sbatchv1.JobCondition{{
+					Type: condition, Status: corev1.ConditionTrue,
+					LastProbeTime: now, LastTransitionTime: now,
+				}}
+				err := k8sClient.Status().Update(context.Background(), &job)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// deploymentImage returns the image of the Deployment, or an error if it does not exist
+			deploymentImage := func() (string, error) {
+				dep := &appsv1.Deployment{}
+				err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "deployment", Namespace: namespace}, dep)
+				if err != nil {
+					return "", err
+				}
+				return dep.Spec.Template.Spec.Containers[0].Image, nil
+			}
+
+			BeforeEach(func() {
+				By("creating a Test resource with a migration Job")
+				instance = &v1alpha1.Test{
+					ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: namespace,
+						Annotations: map[string]string{ServerSideApplyAnnotation: fmt.Sprint(serverSideApply)}},
+					Spec: v1alpha1.TestSpec{Migration: pointer.StringPtr("example.com:v1")},
+				}
+				err := k8sClient.Create(context.Background(), instance)
+				Expect(err).ToNot(HaveOccurred())
+				Eventually(func() error {
+					return k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance)
+				}, timeout, poll).ShouldNot(HaveOccurred())
+			})
+
+			It("holds the rollout until the migration Job succeeds", func() {
+
+				Eventually(func() int {
+					return len(jobs())
+				}, timeout, poll).Should(Equal(1))
+
+				Consistently(func() bool {
+					_, err := deploymentImage()
+					return errors.IsNotFound(err)
+				}, 2*time.Second, poll).Should(BeTrue())
+
+				finish(jobs()[0], k8sbatchv1.JobComplete)
+
+				Eventually(deploymentImage, timeout, poll).Should(Equal("example.com:v1"))
+			})
+
+			It("holds the rollout while the migration Job has failed", func() {
+
+				Eventually(func() int {
+					return len(jobs())
+				}, timeout, poll).Should(Equal(1))
+				first := jobs()[0]
+				finish(first, k8sbatchv1.JobComplete)
+				Eventually(deploymentImage, timeout, poll).Should(Equal("example.com:v1"))
+
+				Eventually(func() error {
+					if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "instance", Namespace: namespace}, instance); err != nil {
+						return err
+					}
+					instance.Spec.Migration = pointer.StringPtr("example.com:v2")
+					return k8sClient.Update(context.Background(), instance)
+				}, timeout, poll).ShouldNot(HaveOccurred())
+
+				var second k8sbatchv1.Job
+				Eventually(func() bool {
+					for _, job := range jobs() {
+						if job.GetName() != first.GetName() {
+							second = job
+							return true
+						}
+					}
+					return false
+				}, timeout, poll).Should(BeTrue())
+				Expect(second.Spec.Template.Spec.Containers[0].Image).To(Equal("example.com:v2"))
+
+				finish(second, k8sbatchv1.JobFailed)
+
+				Consistently(deploymentImage, 2*time.Second, poll).Should(Equal("example.com:v1"))
+			})
+		})
+	}
+
 })
@@ -5,6 +5,7 @@ import (
 
 	"github.com/3scale/saas-operator/pkg/basereconciler"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/marin3r"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
 	"github.com/3scale/saas-operator/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
@@ -42,7 +43,8 @@ func (gen *EnvGenerator) Deployment() basereconciler.GeneratorFunction {
 				},
 				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Labels: gen.LabelsWithSelector(),
+						Labels:      gen.LabelsWithSelector(),
+						Annotations: monitoring.PodAnnotations(gen.Monitoring, gen.metricsEndpoints()...),
 					},
 					Spec: corev1.PodSpec{
 						ImagePullSecrets: func() []corev1.LocalObjectReference {
@@ -69,8 +71,11 @@ func (gen *EnvGenerator) Deployment() basereconciler.GeneratorFunction {
 								TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 							},
 						},
-						Affinity:    pod.Affinity(gen.Selector().MatchLabels, gen.Spec.NodeAffinity),
-						Tolerations: gen.Spec.Tolerations,
+						Affinity: pod.Affinity(gen.Selector().MatchLabels, gen.Spec.NodeAffinity,
+							gen.Spec.PodAffinity, gen.Spec.PodAntiAffinity),
+						TopologySpreadConstraints: pod.TopologySpreadConstraints(gen.Selector().MatchLabels, gen.Spec.TopologySpreadConstraints),
+						Tolerations:               gen.Spec.Tolerations,
+						PriorityClassName:         gen.Spec.PriorityClassName,
 					},
 				},
 			},
@@ -7,9 +7,15 @@ import (
 	"github.com/3scale/saas-operator/pkg/generators/apicast/config"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/grafanadashboard"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/hpa"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/marin3r"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/podmonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/prometheusrule"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/servicemonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/vpa"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -25,18 +31,33 @@ type Generator struct {
 	Production           EnvGenerator
 	LoadBalancerSpec     saasv1alpha1.LoadBalancerSpec
 	GrafanaDashboardSpec saasv1alpha1.GrafanaDashboardSpec
+	PrometheusRulesSpec  saasv1alpha1.PrometheusRulesSpec
 }
 
 // ApicastDashboard returns a basereconciler.GeneratorFunction
 func (gen *Generator) ApicastDashboard() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/apicast.json.tpl")
+	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/apicast.json.tpl",
+		grafanadashboard.NewData(gen.BaseOptions))
 }
 
 // ApicastServicesDashboard returns a basereconciler.GeneratorFunction
 func (gen *Generator) ApicastServicesDashboard() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component + "-services", Namespace: gen.Namespace}
-	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/apicast-services.json.tpl")
+	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/apicast-services.json.tpl",
+		grafanadashboard.NewData(gen.BaseOptions))
+}
+
+// CustomDashboards returns a basereconciler.GeneratorFunction for each of
+// the dashboards provided in ConfigMaps
+func (gen *Generator) CustomDashboards() []basereconciler.GeneratorFunction {
+	return grafanadashboard.NewFromConfigMaps(gen.Component, gen.Namespace, gen.GetLabels(), gen.GrafanaDashboardSpec)
+}
+
+// PrometheusRules returns a basereconciler.GeneratorFunction
+func (gen *Generator) PrometheusRules() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return prometheusrule.New(key, gen.GetLabels(), gen.PrometheusRulesSpec, "rules/apicast.yaml.tpl")
 }
 
 // NewGenerator returns a new Options struct
@@ -62,8 +83,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) Gen
 					"threescale_component_element": "gateway",
 				},
 			},
-			Spec:    spec.Staging,
-			Options: config.NewEnvOptions(spec.Staging, "staging"),
+			Spec:       spec.Staging,
+			Options:    config.NewEnvOptions(spec.Staging, "staging"),
+			Monitoring: *spec.Monitoring,
 		},
 		Production: EnvGenerator{
 			BaseOptions: generators.BaseOptions{
@@ -76,10 +98,12 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) Gen
 					"threescale_component_element": "gateway",
 				},
 			},
-			Spec:    spec.Production,
-			Options: config.NewEnvOptions(spec.Production, "production"),
+			Spec:       spec.Production,
+			Options:    config.NewEnvOptions(spec.Production, "production"),
+			Monitoring: *spec.Monitoring,
 		},
 		GrafanaDashboardSpec: *spec.GrafanaDashboard,
+		PrometheusRulesSpec:  *spec.PrometheusRules,
 	}
 }
 
@@ -87,8 +111,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) Gen
 // Apicast environment
 type EnvGenerator struct {
 	generators.BaseOptions
-	Spec    saasv1alpha1.ApicastEnvironmentSpec
-	Options config.EnvOptions
+	Spec       saasv1alpha1.ApicastEnvironmentSpec
+	Options    config.EnvOptions
+	Monitoring saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -97,6 +122,14 @@ func (gen *EnvGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.Spec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *EnvGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.Spec.VPA)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *EnvGenerator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -106,8 +139,24 @@ func (gen *EnvGenerator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *EnvGenerator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-		podmonitor.PodMetricsEndpoint("/stats/prometheus", "envoy-metrics", 60),
-	)
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *EnvGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *EnvGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *EnvGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9421, 30),
+		monitoring.MetricsEndpoint("/stats/prometheus", "envoy-metrics", marin3r.PortNumber(*gen.Spec.Marin3r, "envoy-metrics"), 60),
+	}
 }
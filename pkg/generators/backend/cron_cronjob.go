@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	k8sbatchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CronJob returns a basereconciler.GeneratorFunction funtion that will return a CronJob
+// resource that runs the given periodic backend task when called
+func (gen *CronGenerator) CronJob(job saasv1alpha1.BackendCronJobSpec) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+
+		podSpec := gen.podSpec(job.Args...)
+		podSpec.RestartPolicy = corev1.RestartPolicyOnFailure
+
+		cj := &batchv1.CronJob{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "CronJob",
+				APIVersion: batchv1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", gen.GetComponent(), job.Name),
+				Namespace: gen.Namespace,
+				Labels:    gen.GetLabels(),
+			},
+			Spec: batchv1beta1.CronJobSpec{
+				Schedule:                   job.Schedule,
+				StartingDeadlineSeconds:    job.StartingDeadlineSeconds,
+				ConcurrencyPolicy:          *job.ConcurrencyPolicy,
+				Suspend:                    pointer.BoolPtr(false),
+				SuccessfulJobsHistoryLimit: job.SuccessfulJobsHistoryLimit,
+				FailedJobsHistoryLimit:     job.FailedJobsHistoryLimit,
+				JobTemplate: batchv1beta1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: gen.GetLabels(),
+					},
+					Spec: k8sbatchv1.JobSpec{
+						ActiveDeadlineSeconds: job.ActiveDeadlineSeconds,
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: gen.LabelsWithSelector(),
+							},
+							Spec: podSpec,
+						},
+					},
+				},
+			},
+		}
+		return cj
+	}
+}
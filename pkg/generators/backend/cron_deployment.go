@@ -43,31 +43,40 @@ func (gen *CronGenerator) Deployment() basereconciler.GeneratorFunction {
 					ObjectMeta: metav1.ObjectMeta{
 						Labels: gen.LabelsWithSelector(),
 					},
-					Spec: corev1.PodSpec{
-						ImagePullSecrets: func() []corev1.LocalObjectReference {
-							if gen.Image.PullSecretName != nil {
-								return []corev1.LocalObjectReference{{Name: *gen.Image.PullSecretName}}
-							}
-							return nil
-						}(),
-						Containers: []corev1.Container{
-							{
-								Name:                     gen.GetComponent(),
-								Image:                    fmt.Sprintf("%s:%s", *gen.Image.Name, *gen.Image.Tag),
-								Args:                     []string{"backend-cron"},
-								Env:                      pod.BuildEnvironment(gen.Options),
-								Resources:                corev1.ResourceRequirements(*gen.CronSpec.Resources),
-								ImagePullPolicy:          *gen.Image.PullPolicy,
-								TerminationMessagePath:   corev1.TerminationMessagePathDefault,
-								TerminationMessagePolicy: corev1.TerminationMessageReadFile,
-							},
-						},
-						Affinity:    pod.Affinity(gen.Selector().MatchLabels, gen.CronSpec.NodeAffinity),
-						Tolerations: gen.CronSpec.Tolerations,
-					},
+					Spec: gen.podSpec("backend-cron"),
 				},
 			},
 		}
 		return dep
 	}
 }
+
+// podSpec returns the spec of the Pods that run the backend cron
+// container with the given arguments
+func (gen *CronGenerator) podSpec(args ...string) corev1.PodSpec {
+	return corev1.PodSpec{
+		ImagePullSecrets: func() []corev1.LocalObjectReference {
+			if gen.Image.PullSecretName != nil {
+				return []corev1.LocalObjectReference{{Name: *gen.Image.PullSecretName}}
+			}
+			return nil
+		}(),
+		Containers: []corev1.Container{
+			{
+				Name:                     gen.GetComponent(),
+				Image:                    fmt.Sprintf("%s:%s", *gen.Image.Name, *gen.Image.Tag),
+				Args:                     args,
+				Env:                      pod.BuildEnvironment(gen.Options),
+				Resources:                corev1.ResourceRequirements(*gen.CronSpec.Resources),
+				ImagePullPolicy:          *gen.Image.PullPolicy,
+				TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+				TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			},
+		},
+		Affinity: pod.Affinity(gen.Selector().MatchLabels, gen.CronSpec.NodeAffinity,
+			gen.CronSpec.PodAffinity, gen.CronSpec.PodAntiAffinity),
+		TopologySpreadConstraints: pod.TopologySpreadConstraints(gen.Selector().MatchLabels, gen.CronSpec.TopologySpreadConstraints),
+		Tolerations:               gen.CronSpec.Tolerations,
+		PriorityClassName:         gen.CronSpec.PriorityClassName,
+	}
+}
@@ -9,10 +9,17 @@ import (
 	"github.com/3scale/saas-operator/pkg/generators/backend/config"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/grafanadashboard"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/hpa"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/marin3r"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/podmonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/prometheusrule"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/scaledobject"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/servicemonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/vpa"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -29,6 +36,7 @@ type Generator struct {
 	Worker               WorkerGenerator
 	Cron                 CronGenerator
 	GrafanaDashboardSpec saasv1alpha1.GrafanaDashboardSpec
+	PrometheusRulesSpec  saasv1alpha1.PrometheusRulesSpec
 	Config               saasv1alpha1.BackendConfig
 }
 
@@ -58,6 +66,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) Gen
 			ListenerSpec: spec.Listener,
 			Image:        *spec.Image,
 			Options:      config.NewListenerOptions(spec),
+			Monitoring:   *spec.Monitoring,
 		},
 		Worker: WorkerGenerator{
 			BaseOptions: generators.BaseOptions{
@@ -71,8 +80,10 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) Gen
 				},
 			},
 			WorkerSpec: *spec.Worker,
+			QueuesDSN:  spec.Config.RedisQueuesDSN,
 			Image:      *spec.Image,
 			Options:    config.NewWorkerOptions(spec),
+			Monitoring: *spec.Monitoring,
 		},
 		Cron: CronGenerator{
 			BaseOptions: generators.BaseOptions{
@@ -90,6 +101,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) Gen
 			Options:  config.NewCronOptions(spec),
 		},
 		GrafanaDashboardSpec: *spec.GrafanaDashboard,
+		PrometheusRulesSpec:  *spec.PrometheusRules,
 		Config:               spec.Config,
 	}
 }
@@ -97,7 +109,20 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) Gen
 // GrafanaDashboard returns a basereconciler.GeneratorFunction
 func (gen *Generator) GrafanaDashboard() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/backend.json.tpl")
+	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/backend.json.tpl",
+		grafanadashboard.NewData(gen.BaseOptions))
+}
+
+// CustomDashboards returns a basereconciler.GeneratorFunction for each of
+// the dashboards provided in ConfigMaps
+func (gen *Generator) CustomDashboards() []basereconciler.GeneratorFunction {
+	return grafanadashboard.NewFromConfigMaps(gen.Component, gen.Namespace, gen.GetLabels(), gen.GrafanaDashboardSpec)
+}
+
+// PrometheusRules returns a basereconciler.GeneratorFunction
+func (gen *Generator) PrometheusRules() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return prometheusrule.New(key, gen.GetLabels(), gen.PrometheusRulesSpec, "rules/backend.yaml.tpl")
 }
 
 // SystemEventsHookSecretDefinition returns a basereconciler.GeneratorFunction
@@ -123,6 +148,7 @@ type ListenerGenerator struct {
 	Image        saasv1alpha1.ImageSpec
 	ListenerSpec saasv1alpha1.ListenerSpec
 	Options      config.ListenerOptions
+	Monitoring   saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -131,6 +157,14 @@ func (gen *ListenerGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.ListenerSpec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *ListenerGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.ListenerSpec.VPA)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *ListenerGenerator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -140,10 +174,26 @@ func (gen *ListenerGenerator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *ListenerGenerator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-		podmonitor.PodMetricsEndpoint("/stats/prometheus", "envoy-metrics", 60),
-	)
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *ListenerGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *ListenerGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *ListenerGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9394, 30),
+		monitoring.MetricsEndpoint("/stats/prometheus", "envoy-metrics", marin3r.PortNumber(*gen.ListenerSpec.Marin3r, "envoy-metrics"), 60),
+	}
 }
 
 // WorkerGenerator has methods to generate resources for a
@@ -152,7 +202,9 @@ type WorkerGenerator struct {
 	generators.BaseOptions
 	Image      saasv1alpha1.ImageSpec
 	WorkerSpec saasv1alpha1.WorkerSpec
+	QueuesDSN  string
 	Options    config.WorkerOptions
+	Monitoring saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -161,6 +213,24 @@ func (gen *WorkerGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.WorkerSpec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *WorkerGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.WorkerSpec.VPA)()
+	}
+}
+
+// ScaledObject returns a basereconciler.GeneratorFunction
+func (gen *WorkerGenerator) ScaledObject() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		cfg := *gen.WorkerSpec.ScaledObject
+		return scaledobject.New(key, gen.GetLabels(), cfg,
+			scaledobject.RedisListTriggers(gen.QueuesDSN, "resque:queue:", cfg.Queues, *cfg.QueueLength, cfg.AuthenticationRef)...)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *WorkerGenerator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -170,9 +240,25 @@ func (gen *WorkerGenerator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *WorkerGenerator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-	)
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *WorkerGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *WorkerGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *WorkerGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9421, 30),
+	}
 }
 
 // CronGenerator has methods to generate resources for a
@@ -183,3 +269,11 @@ type CronGenerator struct {
 	CronSpec saasv1alpha1.CronSpec
 	Options  config.CronOptions
 }
+
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *CronGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.CronSpec.VPA)()
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
 	"github.com/3scale/saas-operator/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
@@ -41,7 +42,8 @@ func (gen *WorkerGenerator) Deployment() basereconciler.GeneratorFunction {
 				},
 				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Labels: gen.LabelsWithSelector(),
+						Labels:      gen.LabelsWithSelector(),
+						Annotations: monitoring.PodAnnotations(gen.Monitoring, gen.metricsEndpoints()...),
 					},
 					Spec: corev1.PodSpec{
 						ImagePullSecrets: func() []corev1.LocalObjectReference {
@@ -67,8 +69,11 @@ func (gen *WorkerGenerator) Deployment() basereconciler.GeneratorFunction {
 								TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 							},
 						},
-						Affinity:    pod.Affinity(gen.Selector().MatchLabels, gen.WorkerSpec.NodeAffinity),
-						Tolerations: gen.WorkerSpec.Tolerations,
+						Affinity: pod.Affinity(gen.Selector().MatchLabels, gen.WorkerSpec.NodeAffinity,
+							gen.WorkerSpec.PodAffinity, gen.WorkerSpec.PodAntiAffinity),
+						TopologySpreadConstraints: pod.TopologySpreadConstraints(gen.Selector().MatchLabels, gen.WorkerSpec.TopologySpreadConstraints),
+						Tolerations:               gen.WorkerSpec.Tolerations,
+						PriorityClassName:         gen.WorkerSpec.PriorityClassName,
 					},
 				},
 			},
@@ -1,29 +1,45 @@
 package grafanadashboard
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
 	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
 	"github.com/3scale/saas-operator/pkg/assets"
 	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/generators"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// Data is the context the templates of the dashboards are rendered with
+type Data struct {
+	// Namespace of the custom resource
+	Namespace string
+	// InstanceName is the name of the custom resource
+	InstanceName string
+	// Component is the name of the component, like "backend"
+	Component string
+}
+
+// NewData returns the Data for the dashboards of a component
+func NewData(component generators.BaseOptions) Data {
+	return Data{
+		Namespace:    component.GetNamespace(),
+		InstanceName: component.GetInstanceName(),
+		Component:    component.GetComponent(),
+	}
+}
+
 // New returns a basereconciler.GeneratorFunction function that will return a GrafanaDashboard
 // resource when called
 func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.GrafanaDashboardSpec,
-	template string) basereconciler.GeneratorFunction {
+	template string, data Data) basereconciler.GeneratorFunction {
 
 	return func() client.Object {
-		data := &struct {
-			Namespace string
-		}{
-			key.Namespace,
-		}
-
 		return &grafanav1alpha1.GrafanaDashboard{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "GrafanaDashboard",
@@ -32,16 +48,135 @@ func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.Gr
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      key.Name,
 				Namespace: key.Namespace,
-				Labels: func() map[string]string {
-					labels := labels
-					labels[*cfg.SelectorKey] = *cfg.SelectorValue
-					return labels
-				}(),
+				Labels:    selectorLabels(labels, cfg),
 			},
 			Spec: grafanav1alpha1.GrafanaDashboardSpec{
-				Name: fmt.Sprintf("%s/%s.json", key.Namespace, key.Name),
-				Json: assets.TemplateAsset(template, data),
+				Name:             fmt.Sprintf("%s/%s.json", key.Namespace, key.Name),
+				Json:             customize(assets.TemplateAsset(template, data), cfg.Datasource, cfg.Variables),
+				CustomFolderName: folder(cfg),
 			},
 		}
 	}
 }
+
+// NewFromConfigMaps returns a basereconciler.GeneratorFunction for each of the dashboards provided
+// in ConfigMaps. The GrafanaDashboard resources are named "<component>-custom-<name>", so they never
+// collide with the ones of the bundled dashboards.
+func NewFromConfigMaps(component, namespace string, labels map[string]string,
+	cfg saasv1alpha1.GrafanaDashboardSpec) []basereconciler.GeneratorFunction {
+
+	fns := make([]basereconciler.GeneratorFunction, 0, len(cfg.FromConfigMaps))
+	for _, dashboard := range cfg.FromConfigMaps {
+		dashboard := dashboard
+		name := fmt.Sprintf("%s-custom-%s", component, dashboard.Name)
+
+		fns = append(fns, func() client.Object {
+			return &grafanav1alpha1.GrafanaDashboard{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "GrafanaDashboard",
+					APIVersion: grafanav1alpha1.SchemeGroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    selectorLabels(labels, cfg),
+				},
+				Spec: grafanav1alpha1.GrafanaDashboardSpec{
+					Name:             fmt.Sprintf("%s/%s.json", namespace, name),
+					ConfigMapRef:     dashboard.ConfigMapRef.DeepCopy(),
+					CustomFolderName: folder(cfg),
+				},
+			}
+		})
+	}
+	return fns
+}
+
+// selectorLabels returns a copy of the labels with the one used
+// by grafana-operator for dashboard discovery
+func selectorLabels(labels map[string]string, cfg saasv1alpha1.GrafanaDashboardSpec) map[string]string {
+	l := map[string]string{}
+	for k, v := range labels {
+		l[k] = v
+	}
+	l[*cfg.SelectorKey] = *cfg.SelectorValue
+	return l
+}
+
+func folder(cfg saasv1alpha1.GrafanaDashboardSpec) string {
+	if cfg.Folder == nil {
+		return ""
+	}
+	return *cfg.Folder
+}
+
+// customize selects the given datasource by default and adds the extra variables to the JSON
+// model of the dashboard. Variables with the same name as an existing one replace it. The JSON
+// is returned untouched if there is nothing to customize.
+func customize(dashboard string, datasource *string, variables map[string]string) string {
+	if datasource == nil && len(variables) == 0 {
+		return dashboard
+	}
+
+	model := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(dashboard), &model); err != nil {
+		panic(err)
+	}
+	templating, ok := model["templating"].(map[string]interface{})
+	if !ok {
+		templating = map[string]interface{}{}
+		model["templating"] = templating
+	}
+	list, _ := templating["list"].([]interface{})
+
+	if datasource != nil {
+		for _, v := range list {
+			if variable, ok := v.(map[string]interface{}); ok && variable["type"] == "datasource" {
+				variable["current"] = map[string]interface{}{"text": *datasource, "value": *datasource}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		variable := customVariable(name, variables[name])
+		replaced := false
+		for idx, v := range list {
+			if existing, ok := v.(map[string]interface{}); ok && existing["name"] == name {
+				list[idx] = variable
+				replaced = true
+			}
+		}
+		if !replaced {
+			list = append(list, variable)
+		}
+	}
+	templating["list"] = list
+
+	out, err := json.MarshalIndent(model, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+// customVariable returns a Grafana template variable with a single value
+func customVariable(name, value string) map[string]interface{} {
+	option := map[string]interface{}{"selected": true, "text": value, "value": value}
+	return map[string]interface{}{
+		"name":       name,
+		"label":      name,
+		"type":       "custom",
+		"query":      value,
+		"current":    map[string]interface{}{"text": value, "value": value},
+		"options":    []interface{}{option},
+		"hide":       0,
+		"multi":      false,
+		"includeAll": false,
+	}
+}
@@ -0,0 +1,125 @@
+package grafanadashboard
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the dashboards")
+
+func Test_customize(t *testing.T) {
+	dashboard := `{"templating": {"list": [
+		{"name": "datasource", "type": "datasource", "query": "prometheus", "current": null},
+		{"name": "env", "type": "custom", "query": "production,staging"}
+	]}}`
+
+	tests := []struct {
+		name       string
+		datasource *string
+		variables  map[string]string
+		want       []map[string]interface{}
+	}{
+		{
+			name: "Keeps the dashboard when there is nothing to customize",
+			want: []map[string]interface{}{
+				{"name": "datasource", "type": "datasource", "query": "prometheus", "current": nil},
+				{"name": "env", "type": "custom", "query": "production,staging"},
+			},
+		},
+		{
+			name:       "Selects the datasource and adds or replaces variables",
+			datasource: pointer.StringPtr("thanos"),
+			variables:  map[string]string{"cluster": "eu-west-1", "env": "staging"},
+			want: []map[string]interface{}{
+				{"name": "datasource", "type": "datasource", "query": "prometheus",
+					"current": map[string]interface{}{"text": "thanos", "value": "thanos"}},
+				customVariable("env", "staging"),
+				customVariable("cluster", "eu-west-1"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := struct {
+				Templating struct {
+					List []map[string]interface{} `json:"list"`
+				} `json:"templating"`
+			}{}
+			if err := json.Unmarshal([]byte(customize(dashboard, tt.datasource, tt.variables)), &model); err != nil {
+				t.Fatalf("customize() returned invalid JSON: %v", err)
+			}
+			// round trip the expected variables so numbers are compared as float64
+			want := []map[string]interface{}{}
+			raw, _ := json.Marshal(tt.want)
+			json.Unmarshal(raw, &want)
+			if !reflect.DeepEqual(model.Templating.List, want) {
+				t.Errorf("customize() = %v, want %v", model.Templating.List, want)
+			}
+		})
+	}
+}
+
+func TestNewFromConfigMaps(t *testing.T) {
+	cfg := saasv1alpha1.GrafanaDashboardSpec{
+		SelectorKey:   pointer.StringPtr("monitoring-key"),
+		SelectorValue: pointer.StringPtr("middleware"),
+		Folder:        pointer.StringPtr("3scale"),
+		FromConfigMaps: []saasv1alpha1.ConfigMapDashboardSpec{{
+			Name: "queues",
+			ConfigMapRef: corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "dashboards"},
+				Key:                  "queues.json",
+			},
+		}},
+	}
+
+	fns := NewFromConfigMaps("backend", "ns", map[string]string{"app": "backend"}, cfg)
+	if len(fns) != 1 {
+		t.Fatalf("NewFromConfigMaps() returned %d generators, want 1", len(fns))
+	}
+	gd := fns[0]().(*grafanav1alpha1.GrafanaDashboard)
+	if gd.GetName() != "backend-custom-queues" || gd.Spec.Name != "ns/backend-custom-queues.json" {
+		t.Errorf("NewFromConfigMaps() got names = %s, %s", gd.GetName(), gd.Spec.Name)
+	}
+	if want := map[string]string{"app": "backend", "monitoring-key": "middleware"}; !reflect.DeepEqual(gd.GetLabels(), want) {
+		t.Errorf("NewFromConfigMaps() got labels = %v, want %v", gd.GetLabels(), want)
+	}
+	if !reflect.DeepEqual(gd.Spec.ConfigMapRef, &cfg.FromConfigMaps[0].ConfigMapRef) || gd.Spec.CustomFolderName != "3scale" {
+		t.Errorf("NewFromConfigMaps() got spec = %v", gd.Spec)
+	}
+}
+
+func TestNew(t *testing.T) {
+	cfg := saasv1alpha1.GrafanaDashboardSpec{
+		SelectorKey:   pointer.StringPtr("monitoring-key"),
+		SelectorValue: pointer.StringPtr("middleware"),
+	}
+	data := Data{Namespace: "ns", InstanceName: "example", Component: "autossl"}
+
+	gd := New(types.NamespacedName{Name: "autossl", Namespace: "ns"}, map[string]string{"app": "autossl"},
+		cfg, "dashboards/autossl.json.tpl", data)().(*grafanav1alpha1.GrafanaDashboard)
+
+	golden := filepath.Join("testdata", "autossl.json")
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(gd.Spec.Json), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gd.Spec.Json != string(want) {
+		t.Errorf("New() got a dashboard that does not match %s, run the test with -update to refresh it", golden)
+	}
+}
@@ -35,18 +35,8 @@ func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.Ho
 				},
 				MinReplicas: cfg.MinReplicas,
 				MaxReplicas: *cfg.MaxReplicas,
-				Metrics: []autoscalingv2beta2.MetricSpec{
-					{
-						Type: autoscalingv2beta2.ResourceMetricSourceType,
-						Resource: &autoscalingv2beta2.ResourceMetricSource{
-							Name: corev1.ResourceName(*cfg.ResourceName),
-							Target: autoscalingv2beta2.MetricTarget{
-								Type:               autoscalingv2beta2.UtilizationMetricType,
-								AverageUtilization: cfg.ResourceUtilization,
-							},
-						},
-					},
-				},
+				Metrics:     Metrics(cfg),
+				Behavior:    cfg.Behavior,
 			},
 			Status: autoscalingv2beta2.HorizontalPodAutoscalerStatus{
 				Conditions: []autoscalingv2beta2.HorizontalPodAutoscalerCondition{},
@@ -54,3 +44,25 @@ func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.Ho
 		}
 	}
 }
+
+// Metrics returns the list of metrics of the HorizontalPodAutoscaler: the resource
+// metric, if configured, followed by the additional metrics of the spec
+func Metrics(cfg saasv1alpha1.HorizontalPodAutoscalerSpec) []autoscalingv2beta2.MetricSpec {
+	metrics := []autoscalingv2beta2.MetricSpec{}
+	if cfg.ResourceName != nil {
+		metrics = append(metrics, autoscalingv2beta2.MetricSpec{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricSource{
+				Name: corev1.ResourceName(*cfg.ResourceName),
+				Target: autoscalingv2beta2.MetricTarget{
+					Type:               autoscalingv2beta2.UtilizationMetricType,
+					AverageUtilization: cfg.ResourceUtilization,
+				},
+			},
+		})
+	}
+	for _, m := range cfg.Metrics {
+		metrics = append(metrics, *m.DeepCopy())
+	}
+	return metrics
+}
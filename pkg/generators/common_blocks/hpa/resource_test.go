@@ -0,0 +1,64 @@
+package hpa
+
+import (
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+)
+
+func TestMetrics(t *testing.T) {
+	requests := autoscalingv2beta2.MetricSpec{
+		Type: autoscalingv2beta2.PodsMetricSourceType,
+		Pods: &autoscalingv2beta2.PodsMetricSource{
+			Metric: autoscalingv2beta2.MetricIdentifier{Name: "apicast_requests_per_second"},
+			Target: autoscalingv2beta2.MetricTarget{
+				Type:         autoscalingv2beta2.AverageValueMetricType,
+				AverageValue: resource.NewQuantity(100, resource.DecimalSI),
+			},
+		},
+	}
+	cpu := autoscalingv2beta2.MetricSpec{
+		Type: autoscalingv2beta2.ResourceMetricSourceType,
+		Resource: &autoscalingv2beta2.ResourceMetricSource{
+			Name: corev1.ResourceCPU,
+			Target: autoscalingv2beta2.MetricTarget{
+				Type:               autoscalingv2beta2.UtilizationMetricType,
+				AverageUtilization: pointer.Int32Ptr(90),
+			},
+		},
+	}
+	tests := []struct {
+		name string
+		cfg  saasv1alpha1.HorizontalPodAutoscalerSpec
+		want []autoscalingv2beta2.MetricSpec
+	}{
+		{
+			name: "Resource metric only",
+			cfg:  saasv1alpha1.HorizontalPodAutoscalerSpec{ResourceName: pointer.StringPtr("cpu"), ResourceUtilization: pointer.Int32Ptr(90)},
+			want: []autoscalingv2beta2.MetricSpec{cpu},
+		},
+		{
+			name: "Custom metrics only",
+			cfg:  saasv1alpha1.HorizontalPodAutoscalerSpec{Metrics: []autoscalingv2beta2.MetricSpec{requests}},
+			want: []autoscalingv2beta2.MetricSpec{requests},
+		},
+		{
+			name: "Resource metric followed by the custom metrics",
+			cfg: saasv1alpha1.HorizontalPodAutoscalerSpec{ResourceName: pointer.StringPtr("cpu"), ResourceUtilization: pointer.Int32Ptr(90),
+				Metrics: []autoscalingv2beta2.MetricSpec{requests}},
+			want: []autoscalingv2beta2.MetricSpec{cpu, requests},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Metrics(tt.cfg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Metrics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
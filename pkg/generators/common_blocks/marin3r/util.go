@@ -93,3 +93,14 @@ func mergeMaps(base map[string]string, merges ...map[string]string) map[string]s
 	}
 	return base
 }
+
+// PortNumber returns the number of the sidecar port with the given
+// name, or 0 if the sidecar does not expose it
+func PortNumber(spec saasv1alpha1.Marin3rSidecarSpec, name string) int32 {
+	for _, port := range spec.Ports {
+		if port.Name == name {
+			return port.Port
+		}
+	}
+	return 0
+}
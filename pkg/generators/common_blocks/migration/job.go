@@ -0,0 +1,64 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns a basereconciler.GeneratorFunction function that will return the Job that
+// migrates the database of the component with the Pods described by 'podSpec' when called.
+// Jobs are immutable, so the name of the Job is 'key.Name' followed by a hash of the whole
+// Pod spec and of the migration settings. A new Job runs whenever any of them changes, like
+// when the component is upgraded.
+func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.MigrationSpec,
+	podSpec corev1.PodSpec) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+
+		podSpec.RestartPolicy = corev1.RestartPolicyNever
+
+		return &batchv1.Job{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Job",
+				APIVersion: batchv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", key.Name, hash(podSpec, cfg)),
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: batchv1.JobSpec{
+				BackoffLimit:          cfg.BackoffLimit,
+				ActiveDeadlineSeconds: cfg.ActiveDeadlineSeconds,
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: labels,
+					},
+					Spec: podSpec,
+				},
+			},
+		}
+	}
+}
+
+// hash returns a hash of the Pod spec and of the migration settings. They are
+// marshalled to JSON first, as the addresses of their pointers would otherwise
+// be part of the hash.
+func hash(podSpec corev1.PodSpec, cfg saasv1alpha1.MigrationSpec) string {
+	data, err := json.Marshal(struct {
+		PodSpec   corev1.PodSpec
+		Migration saasv1alpha1.MigrationSpec
+	}{podSpec, cfg})
+	if err != nil {
+		panic(err)
+	}
+	return basereconciler.Hash(string(data))
+}
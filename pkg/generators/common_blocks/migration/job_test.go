@@ -0,0 +1,49 @@
+package migration
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+func TestNew(t *testing.T) {
+	key := types.NamespacedName{Name: "test-migrate", Namespace: "ns"}
+	cfg := saasv1alpha1.MigrationSpec{BackoffLimit: pointer.Int32Ptr(2), ActiveDeadlineSeconds: pointer.Int64Ptr(600)}
+	podSpec := func(image string, env ...corev1.EnvVar) corev1.PodSpec {
+		return corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "migrate", Image: image, Args: []string{"bundle", "exec", "rake", "db:migrate"}, Env: env},
+		}}
+	}
+	name := func(cfg saasv1alpha1.MigrationSpec, spec corev1.PodSpec) string {
+		return New(key, map[string]string{"app": "test"}, cfg, spec)().GetName()
+	}
+
+	job := New(key, map[string]string{"app": "test"}, cfg, podSpec("image:v1"))().(*batchv1.Job)
+	if job.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Errorf("New() got restartPolicy = %v, want Never", job.Spec.Template.Spec.RestartPolicy)
+	}
+	if *job.Spec.BackoffLimit != 2 || *job.Spec.ActiveDeadlineSeconds != 600 {
+		t.Errorf("New() got backoffLimit = %v and activeDeadlineSeconds = %v",
+			*job.Spec.BackoffLimit, *job.Spec.ActiveDeadlineSeconds)
+	}
+
+	same := saasv1alpha1.MigrationSpec{BackoffLimit: pointer.Int32Ptr(2), ActiveDeadlineSeconds: pointer.Int64Ptr(600)}
+	if name(cfg, podSpec("image:v1")) != name(same, podSpec("image:v1")) {
+		t.Errorf("New() got a new name for the same migration settings")
+	}
+	if name(cfg, podSpec("image:v1")) == name(cfg, podSpec("image:v1", corev1.EnvVar{Name: "VAR", Value: "value"})) {
+		t.Errorf("New() got the same name for a change in the environment")
+	}
+	if name(cfg, podSpec("image:v1")) == name(cfg, podSpec("image:v2")) {
+		t.Errorf("New() got the same name for a new image")
+	}
+	other := cfg
+	other.BackoffLimit = pointer.Int32Ptr(5)
+	if name(cfg, podSpec("image:v1")) == name(other, podSpec("image:v1")) {
+		t.Errorf("New() got the same name for new migration settings")
+	}
+}
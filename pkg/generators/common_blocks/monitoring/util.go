@@ -0,0 +1,78 @@
+package monitoring
+
+import (
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// Endpoint describes a metrics endpoint exposed by the Pods of a workload
+type Endpoint struct {
+	// Path of the metrics endpoint
+	Path string
+	// Name of the container port that serves the metrics
+	Port string
+	// Number of the container port that serves the metrics. Endpoints
+	// with an unknown port number are only scraped through PodMonitors.
+	Number int32
+	// Default scrape interval, in seconds
+	Interval int32
+}
+
+// MetricsEndpoint returns an Endpoint
+func MetricsEndpoint(path, port string, number, interval int32) Endpoint {
+	return Endpoint{Path: path, Port: port, Number: number, Interval: interval}
+}
+
+// ScrapeInterval returns the scrape interval of the endpoint, unless
+// it has been overridden in the MonitoringSpec
+func (e Endpoint) ScrapeInterval(cfg saasv1alpha1.MonitoringSpec) string {
+	if cfg.Interval != nil {
+		return *cfg.Interval
+	}
+	return fmt.Sprintf("%ds", e.Interval)
+}
+
+// Relabelings returns the relabelings of the MonitoringSpec as
+// prometheus-operator RelabelConfigs
+func Relabelings(relabelings []saasv1alpha1.RelabelConfig) []*monitoringv1.RelabelConfig {
+	if len(relabelings) == 0 {
+		return nil
+	}
+	out := make([]*monitoringv1.RelabelConfig, 0, len(relabelings))
+	for _, r := range relabelings {
+		out = append(out, &monitoringv1.RelabelConfig{
+			SourceLabels: r.SourceLabels,
+			Separator:    r.Separator,
+			TargetLabel:  r.TargetLabel,
+			Regex:        r.Regex,
+			Modulus:      r.Modulus,
+			Replacement:  r.Replacement,
+			Action:       r.Action,
+		})
+	}
+	return out
+}
+
+// PodAnnotations returns the "prometheus.io" scrape annotations for the Pods of a
+// workload when the component is monitored in the annotations mode, nil otherwise.
+// Prometheus only reads one set of annotations per Pod, so only the first endpoint
+// with a known port number is annotated.
+func PodAnnotations(cfg saasv1alpha1.MonitoringSpec, endpoints ...Endpoint) map[string]string {
+	if !cfg.IsMode(saasv1alpha1.AnnotationsMode) {
+		return nil
+	}
+	for _, e := range endpoints {
+		if e.Number == 0 {
+			continue
+		}
+		return map[string]string{
+			"prometheus.io/scrape": "true",
+			"prometheus.io/path":   e.Path,
+			"prometheus.io/port":   fmt.Sprintf("%d", e.Number),
+			"prometheus.io/scheme": "http",
+		}
+	}
+	return nil
+}
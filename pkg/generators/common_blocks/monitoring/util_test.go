@@ -0,0 +1,49 @@
+package monitoring
+
+import (
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+)
+
+func TestPodAnnotations(t *testing.T) {
+	mode := func(m saasv1alpha1.MonitoringMode) saasv1alpha1.MonitoringSpec {
+		return saasv1alpha1.MonitoringSpec{Mode: &m}
+	}
+	tests := []struct {
+		name      string
+		cfg       saasv1alpha1.MonitoringSpec
+		endpoints []Endpoint
+		want      map[string]string
+	}{
+		{
+			name:      "No annotations in other modes",
+			cfg:       mode(saasv1alpha1.PodMonitorMode),
+			endpoints: []Endpoint{MetricsEndpoint("/metrics", "metrics", 9394, 30)},
+			want:      nil,
+		},
+		{
+			name: "Annotates the first endpoint with a known port number",
+			cfg:  mode(saasv1alpha1.AnnotationsMode),
+			endpoints: []Endpoint{
+				MetricsEndpoint("/stats/prometheus", "envoy-metrics", 0, 60),
+				MetricsEndpoint("/metrics", "metrics", 9394, 30),
+				MetricsEndpoint("/yabeda-metrics", "metrics", 9394, 30),
+			},
+			want: map[string]string{
+				"prometheus.io/scrape": "true",
+				"prometheus.io/path":   "/metrics",
+				"prometheus.io/port":   "9394",
+				"prometheus.io/scheme": "http",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PodAnnotations(tt.cfg, tt.endpoints...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PodAnnotations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -40,6 +40,20 @@ func (sv *SecretValue) ToEnvVar(key string) corev1.EnvVar {
 		}
 	}
 
+	if sv.Value.FromSecret != nil {
+		return corev1.EnvVar{
+			Name: envvar,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key: sv.Value.FromSecret.Key,
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: sv.Value.FromSecret.Name,
+					},
+				},
+			},
+		}
+	}
+
 	return corev1.EnvVar{
 		Name: envvar,
 		ValueFrom: &corev1.EnvVarSource{
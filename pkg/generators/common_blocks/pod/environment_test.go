@@ -77,6 +77,23 @@ func TestSecretValue_ToEnvVar(t *testing.T) {
 			args:   args{key: "key:my-secret"},
 			want:   corev1.EnvVar{Name: "key", Value: "override"},
 		},
+		{
+			name: "Returns EnvVar from a Kubernetes Secret",
+			fields: fields{Value: saasv1alpha1.SecretReference{
+				FromSecret: &saasv1alpha1.KubernetesSecretReference{Name: "other-secret", Key: "other-key"}}},
+			args: args{key: "key:my-secret"},
+			want: corev1.EnvVar{
+				Name: "key",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key: "other-key",
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "other-secret",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
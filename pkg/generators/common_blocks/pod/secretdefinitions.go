@@ -10,7 +10,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// GenerateSecretDefinitionFn generates a SecretDefinition
+// GenerateSecretDefinitionFn generates a SecretDefinition. Values that come from
+// an existing Kubernetes Secret ('fromSecret') are not part of the SecretDefinition.
 func GenerateSecretDefinitionFn(name, namespace string, labels map[string]string,
 	opts interface{}) basereconciler.GeneratorFunction {
 
@@ -38,6 +39,25 @@ func keysMap(name string, opts interface{}) map[string]secretsmanagerv1alpha1.Da
 
 	m := map[string]secretsmanagerv1alpha1.DataSource{}
 
+	for keyName, secretValue := range secretValues(name, opts) {
+		if secretValue.Value.Override != nil || secretValue.Value.FromSecret != nil {
+			continue
+		}
+		m[keyName] = secretsmanagerv1alpha1.DataSource{
+			Path: secretValue.Value.FromVault.Path,
+			Key:  secretValue.Value.FromVault.Key,
+		}
+	}
+
+	return m
+}
+
+// secretValues returns the SecretValues of the options struct that belong to the
+// SecretDefinition with the given name, by the name of their env var
+func secretValues(name string, opts interface{}) map[string]SecretValue {
+
+	m := map[string]SecretValue{}
+
 	t := reflect.TypeOf(opts)
 
 	for i := 0; i < t.NumField(); i++ {
@@ -71,14 +91,7 @@ func keysMap(name string, opts interface{}) map[string]secretsmanagerv1alpha1.Da
 			panic(fmt.Errorf("wrong type '%s' for field %s/%s", valueType, t.Name(), field.Name))
 		}
 
-		secretValue := value.Elem().Elem().Interface().(SecretValue)
-		if secretValue.Value.Override != nil {
-			continue
-		}
-		m[keyName] = secretsmanagerv1alpha1.DataSource{
-			Path: secretValue.Value.FromVault.Path,
-			Key:  secretValue.Value.FromVault.Key,
-		}
+		m[keyName] = value.Elem().Elem().Interface().(SecretValue)
 	}
 
 	return m
@@ -103,6 +103,44 @@ func TestGenerateSecretDefinitionFn(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Generates a SecretDefinition without the values of other Kubernetes Secrets",
+			args: args{
+				name:      "my-secret",
+				namespace: "test",
+				labels:    map[string]string{},
+				opts: struct {
+					Option1 EnvVarValue `env:"OPTION1" secret:"my-secret"`
+					Option2 EnvVarValue `env:"OPTION2" secret:"my-secret"`
+					Option3 EnvVarValue `env:"OPTION3" secret:"my-secret"`
+				}{
+					Option1: &SecretValue{Value: saasv1alpha1.SecretReference{
+						FromVault: &saasv1alpha1.VaultSecretReference{Key: "key1", Path: "path1"}}},
+					Option2: &SecretValue{Value: saasv1alpha1.SecretReference{
+						FromSecret: &saasv1alpha1.KubernetesSecretReference{Name: "secret-b", Key: "key2"}}},
+					Option3: &SecretValue{Value: saasv1alpha1.SecretReference{
+						FromSecret: &saasv1alpha1.KubernetesSecretReference{Name: "secret-a", Key: "key3"}}},
+				},
+			},
+			want: &secretsmanagerv1alpha1.SecretDefinition{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "SecretDefinition",
+					APIVersion: secretsmanagerv1alpha1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "test",
+					Labels:    map[string]string{},
+				},
+				Spec: secretsmanagerv1alpha1.SecretDefinitionSpec{
+					Name: "my-secret",
+					Type: "opaque",
+					KeysMap: map[string]secretsmanagerv1alpha1.DataSource{
+						"OPTION1": {Key: "key1", Path: "path1"},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -175,6 +213,25 @@ func Test_keysMap(t *testing.T) {
 			},
 			wantPanic: false,
 		},
+		{
+			name: "Generates a DataSources map, with Kubernetes Secret references",
+			args: args{
+				name: "my-secret",
+				opts: struct {
+					Option1 EnvVarValue `env:"OPTION1" secret:"my-secret"`
+					Option2 EnvVarValue `env:"OPTION2" secret:"my-secret"`
+				}{
+					Option1: &SecretValue{Value: saasv1alpha1.SecretReference{
+						FromVault: &saasv1alpha1.VaultSecretReference{Key: "key1", Path: "path1"}}},
+					Option2: &SecretValue{Value: saasv1alpha1.SecretReference{
+						FromSecret: &saasv1alpha1.KubernetesSecretReference{Name: "other", Key: "key2"}}},
+				},
+			},
+			want: map[string]secretsmanagerv1alpha1.DataSource{
+				"OPTION1": {Key: "key1", Path: "path1"},
+			},
+			wantPanic: false,
+		},
 		{
 			name: "Panics if value is not a SecretValue",
 			args: args{
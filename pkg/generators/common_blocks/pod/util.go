@@ -60,28 +60,63 @@ func TCPProbe(port intstr.IntOrString, cfg saasv1alpha1.ProbeSpec) *corev1.Probe
 	}
 }
 
-// Affinity returns a corev1.Affinity struct
-func Affinity(podAntiAffinitySelector map[string]string, nodeAffinity *corev1.NodeAffinity) *corev1.Affinity {
-	return &corev1.Affinity{
-		NodeAffinity: nodeAffinity,
-		PodAntiAffinity: &corev1.PodAntiAffinity{
-			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+// Affinity returns a corev1.Affinity struct, or nil if there
+// are no scheduling rules for the Pod
+func Affinity(podAntiAffinitySelector map[string]string, nodeAffinity *corev1.NodeAffinity,
+	podAffinity *corev1.PodAffinity, antiAffinity *saasv1alpha1.PodAntiAffinitySpec) *corev1.Affinity {
+
+	affinity := &corev1.Affinity{
+		NodeAffinity:    nodeAffinity,
+		PodAffinity:     podAffinity,
+		PodAntiAffinity: PodAntiAffinity(podAntiAffinitySelector, antiAffinity),
+	}
+	if affinity.NodeAffinity == nil && affinity.PodAffinity == nil && affinity.PodAntiAffinity == nil {
+		return nil
+	}
+	return affinity
+}
+
+// PodAntiAffinity returns the corev1.PodAntiAffinity for the given PodAntiAffinitySpec. The
+// "preferred" anti-affinity tries to spread the Pods across nodes first and then across zones.
+func PodAntiAffinity(selector map[string]string, cfg *saasv1alpha1.PodAntiAffinitySpec) *corev1.PodAntiAffinity {
+	switch {
+	case cfg.IsType(saasv1alpha1.NoPodAntiAffinity):
+		return nil
+
+	case cfg.IsType(saasv1alpha1.RequiredPodAntiAffinity):
+		key := corev1.LabelHostname
+		if cfg.TopologyKey != nil {
+			key = *cfg.TopologyKey
+		}
+		return &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
 				{
-					Weight: 100,
-					PodAffinityTerm: corev1.PodAffinityTerm{
-						TopologyKey: corev1.LabelHostname,
-						LabelSelector: &metav1.LabelSelector{
-							MatchLabels: podAntiAffinitySelector,
-						},
+					TopologyKey: key,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: selector,
 					},
 				},
-				{
-					Weight: 99,
-					PodAffinityTerm: corev1.PodAffinityTerm{
-						TopologyKey: corev1.LabelTopologyZone,
-						LabelSelector: &metav1.LabelSelector{
-							MatchLabels: podAntiAffinitySelector,
-						},
+			},
+		}
+	}
+
+	return &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+			{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					TopologyKey: corev1.LabelHostname,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: selector,
+					},
+				},
+			},
+			{
+				Weight: 99,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					TopologyKey: corev1.LabelTopologyZone,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: selector,
 					},
 				},
 			},
@@ -89,6 +124,23 @@ func Affinity(podAntiAffinitySelector map[string]string, nodeAffinity *corev1.No
 	}
 }
 
+// TopologySpreadConstraints returns a copy of the given constraints, using the
+// Pod selector as the label selector of the ones that don't set it
+func TopologySpreadConstraints(selector map[string]string, constraints []corev1.TopologySpreadConstraint) []corev1.TopologySpreadConstraint {
+	if len(constraints) == 0 {
+		return nil
+	}
+	list := make([]corev1.TopologySpreadConstraint, 0, len(constraints))
+	for _, c := range constraints {
+		constraint := *c.DeepCopy()
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		list = append(list, constraint)
+	}
+	return list
+}
+
 // ContainerPortTCP returns a TCP corev1.ContainerPort
 func ContainerPortTCP(name string, port int32) corev1.ContainerPort {
 	return corev1.ContainerPort{
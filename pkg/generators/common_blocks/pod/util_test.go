@@ -0,0 +1,97 @@
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestPodAntiAffinity(t *testing.T) {
+	selector := map[string]string{"deployment": "test"}
+	aaType := func(t saasv1alpha1.PodAntiAffinityType) *saasv1alpha1.PodAntiAffinityType { return &t }
+	tests := []struct {
+		name string
+		cfg  *saasv1alpha1.PodAntiAffinitySpec
+		want *corev1.PodAntiAffinity
+	}{
+		{
+			name: "Defaults to preferred across nodes and zones",
+			cfg:  nil,
+			want: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					{Weight: 100, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelHostname,
+						LabelSelector: &metav1.LabelSelector{MatchLabels: selector}}},
+					{Weight: 99, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelTopologyZone,
+						LabelSelector: &metav1.LabelSelector{MatchLabels: selector}}},
+				},
+			},
+		},
+		{
+			name: "Required with custom topology key",
+			cfg: &saasv1alpha1.PodAntiAffinitySpec{
+				Type:        aaType(saasv1alpha1.RequiredPodAntiAffinity),
+				TopologyKey: pointer.StringPtr(corev1.LabelTopologyZone),
+			},
+			want: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					{TopologyKey: corev1.LabelTopologyZone, LabelSelector: &metav1.LabelSelector{MatchLabels: selector}},
+				},
+			},
+		},
+		{
+			name: "Required defaults to the hostname topology key",
+			cfg:  &saasv1alpha1.PodAntiAffinitySpec{Type: aaType(saasv1alpha1.RequiredPodAntiAffinity)},
+			want: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					{TopologyKey: corev1.LabelHostname, LabelSelector: &metav1.LabelSelector{MatchLabels: selector}},
+				},
+			},
+		},
+		{
+			name: "No anti-affinity",
+			cfg:  &saasv1alpha1.PodAntiAffinitySpec{Type: aaType(saasv1alpha1.NoPodAntiAffinity)},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PodAntiAffinity(selector, tt.cfg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PodAntiAffinity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAffinity(t *testing.T) {
+	none := saasv1alpha1.NoPodAntiAffinity
+	if got := Affinity(nil, nil, nil, &saasv1alpha1.PodAntiAffinitySpec{Type: &none}); got != nil {
+		t.Errorf("Affinity() = %v, want nil", got)
+	}
+}
+
+func TestTopologySpreadConstraints(t *testing.T) {
+	selector := map[string]string{"deployment": "test"}
+	custom := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}}
+	constraints := []corev1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule},
+		{MaxSkew: 2, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: custom},
+	}
+	want := []corev1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector: &metav1.LabelSelector{MatchLabels: selector}},
+		{MaxSkew: 2, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: custom},
+	}
+	if got := TopologySpreadConstraints(selector, constraints); !reflect.DeepEqual(got, want) {
+		t.Errorf("TopologySpreadConstraints() = %v, want %v", got, want)
+	}
+	if constraints[0].LabelSelector != nil {
+		t.Errorf("TopologySpreadConstraints() modified its input")
+	}
+	if got := TopologySpreadConstraints(selector, nil); got != nil {
+		t.Errorf("TopologySpreadConstraints() = %v, want nil", got)
+	}
+}
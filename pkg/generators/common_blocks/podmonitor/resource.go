@@ -1,9 +1,9 @@
 package podmonitor
 
 import (
-	"fmt"
-
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
 	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -13,7 +13,7 @@ import (
 // New returns a basereconciler.GeneratorFunction function that will return a PodMonitor
 // resource when called
 func New(key types.NamespacedName, labels map[string]string, selector map[string]string,
-	endpoints ...monitoringv1.PodMetricsEndpoint) basereconciler.GeneratorFunction {
+	cfg saasv1alpha1.MonitoringSpec, endpoints ...monitoring.Endpoint) basereconciler.GeneratorFunction {
 
 	return func() client.Object {
 
@@ -28,7 +28,13 @@ func New(key types.NamespacedName, labels map[string]string, selector map[string
 				Labels:    labels,
 			},
 			Spec: monitoringv1.PodMonitorSpec{
-				PodMetricsEndpoints: endpoints,
+				PodMetricsEndpoints: func() []monitoringv1.PodMetricsEndpoint {
+					list := make([]monitoringv1.PodMetricsEndpoint, 0, len(endpoints))
+					for _, e := range endpoints {
+						list = append(list, PodMetricsEndpoint(e, cfg))
+					}
+					return list
+				}(),
 				Selector: metav1.LabelSelector{
 					MatchLabels: selector,
 				},
@@ -38,11 +44,13 @@ func New(key types.NamespacedName, labels map[string]string, selector map[string
 }
 
 // PodMetricsEndpoint returns a monitoringv1.PodMetricsEndpoint
-func PodMetricsEndpoint(path, port string, interval int32) monitoringv1.PodMetricsEndpoint {
+func PodMetricsEndpoint(e monitoring.Endpoint, cfg saasv1alpha1.MonitoringSpec) monitoringv1.PodMetricsEndpoint {
 	return monitoringv1.PodMetricsEndpoint{
-		Interval: fmt.Sprintf("%ds", interval),
-		Path:     path,
-		Port:     port,
-		Scheme:   "http",
+		Interval:             e.ScrapeInterval(cfg),
+		Path:                 e.Path,
+		Port:                 e.Port,
+		Scheme:               "http",
+		RelabelConfigs:       monitoring.Relabelings(cfg.Relabelings),
+		MetricRelabelConfigs: monitoring.Relabelings(cfg.MetricRelabelings),
 	}
 }
@@ -0,0 +1,104 @@
+package prometheusrule
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/assets"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// New returns a basereconciler.GeneratorFunction function that will return a PrometheusRule
+// resource when called. The default rules of the component are rendered from the template
+// and then customized with the overrides and the labels in the PrometheusRulesSpec.
+func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.PrometheusRulesSpec,
+	template string) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+		data := &templateData{
+			Namespace: key.Namespace,
+			rules:     cfg.Rules,
+		}
+
+		spec := monitoringv1.PrometheusRuleSpec{}
+		if err := yaml.Unmarshal([]byte(assets.TemplateAsset(template, data)), &spec); err != nil {
+			panic(err)
+		}
+
+		return &monitoringv1.PrometheusRule{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PrometheusRule",
+				APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{
+				Groups: customize(spec.Groups, cfg),
+			},
+		}
+	}
+}
+
+// templateData is the data passed to the templates of the rules
+type templateData struct {
+	Namespace string
+	rules     map[string]saasv1alpha1.PrometheusAlertSpec
+}
+
+// Threshold returns the threshold of the alert, or the default one
+// if it has not been overridden in the PrometheusRulesSpec
+func (d *templateData) Threshold(alert, def string) string {
+	if rule, ok := d.rules[alert]; ok && rule.Threshold != nil {
+		return *rule.Threshold
+	}
+	return def
+}
+
+// customize removes the disabled alerts from the rule groups and applies
+// the overrides of the duration and the labels of the remaining ones
+func customize(groups []monitoringv1.RuleGroup, cfg saasv1alpha1.PrometheusRulesSpec) []monitoringv1.RuleGroup {
+	out := make([]monitoringv1.RuleGroup, 0, len(groups))
+
+	for _, group := range groups {
+		rules := make([]monitoringv1.Rule, 0, len(group.Rules))
+		for _, rule := range group.Rules {
+			if rule.Alert == "" {
+				rules = append(rules, rule)
+				continue
+			}
+			override := cfg.Rules[rule.Alert]
+			if override.Disabled != nil && *override.Disabled {
+				continue
+			}
+			if override.For != nil {
+				rule.For = *override.For
+			}
+			rule.Labels = mergeLabels(rule.Labels, cfg.Labels, override.Labels)
+			rules = append(rules, rule)
+		}
+		group.Rules = rules
+		out = append(out, group)
+	}
+
+	return out
+}
+
+// mergeLabels merges the given sets of labels, with later ones taking precedence
+func mergeLabels(sets ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, set := range sets {
+		for k, v := range set {
+			merged[k] = v
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
@@ -0,0 +1,80 @@
+package prometheusrule
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+)
+
+func TestNew(t *testing.T) {
+	key := types.NamespacedName{Name: "zync", Namespace: "ns"}
+	cfg := saasv1alpha1.PrometheusRulesSpec{
+		Labels: map[string]string{"team": "api", "severity": "info"},
+		Rules: map[string]saasv1alpha1.PrometheusAlertSpec{
+			"ZyncQueJobsBacklog": {
+				Threshold: pointer.StringPtr("1000"),
+				For:       pointer.StringPtr("30m"),
+				Labels:    map[string]string{"severity": "critical"},
+			},
+			"ZyncHigh5xxRate": {Disabled: pointer.BoolPtr(true)},
+		},
+	}
+
+	pr := New(key, map[string]string{"app": "test"}, cfg, "rules/zync.yaml.tpl")().(*monitoringv1.PrometheusRule)
+
+	rules := map[string]monitoringv1.Rule{}
+	for _, group := range pr.Spec.Groups {
+		for _, rule := range group.Rules {
+			rules[rule.Alert] = rule
+		}
+	}
+
+	if _, ok := rules["ZyncHigh5xxRate"]; ok {
+		t.Errorf("New() got disabled alert 'ZyncHigh5xxRate'")
+	}
+	backlog, ok := rules["ZyncQueJobsBacklog"]
+	if !ok {
+		t.Fatalf("New() missing alert 'ZyncQueJobsBacklog'")
+	}
+	if !strings.Contains(backlog.Expr.String(), `namespace="ns"`) || !strings.HasSuffix(strings.TrimSpace(backlog.Expr.String()), "> 1000") {
+		t.Errorf("New() got expr = %v, want the namespace and the overridden threshold", backlog.Expr.String())
+	}
+	if backlog.For != "30m" {
+		t.Errorf("New() got for = %v, want 30m", backlog.For)
+	}
+	if want := map[string]string{"team": "api", "severity": "critical"}; !reflect.DeepEqual(backlog.Labels, want) {
+		t.Errorf("New() got labels = %v, want %v", backlog.Labels, want)
+	}
+	if failing := rules["ZyncQueJobsFailing"]; failing.Labels["severity"] != "info" || failing.For != "15m" {
+		t.Errorf("New() got labels = %v and for = %v, want the component labels and the default duration",
+			failing.Labels, failing.For)
+	}
+}
+
+func Test_customize(t *testing.T) {
+	groups := []monitoringv1.RuleGroup{{
+		Name: "group",
+		Rules: []monitoringv1.Rule{
+			{Record: "recorded", Expr: intstr.FromString("up")},
+			{Alert: "Alert", Expr: intstr.FromString("up == 0"), Labels: map[string]string{"severity": "warning"}},
+		},
+	}}
+
+	got := customize(groups, saasv1alpha1.PrometheusRulesSpec{
+		Labels: map[string]string{"team": "api"},
+		Rules:  map[string]saasv1alpha1.PrometheusAlertSpec{"Alert": {Disabled: pointer.BoolPtr(true)}},
+	})
+	want := []monitoringv1.RuleGroup{{
+		Name:  "group",
+		Rules: []monitoringv1.Rule{{Record: "recorded", Expr: intstr.FromString("up")}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("customize() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,143 @@
+package scaledobject
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns a basereconciler.GeneratorFunction function that will return a ScaledObject
+// resource when called. The ScaledObject scales the Deployment with the same name using
+// the given queue triggers followed by the additional triggers of the spec.
+func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.ScaledObjectSpec,
+	triggers ...kedav1alpha1.ScaleTriggers) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+
+		return &kedav1alpha1.ScaledObject{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ScaledObject",
+				APIVersion: kedav1alpha1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: kedav1alpha1.ScaledObjectSpec{
+				ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       key.Name,
+				},
+				PollingInterval: cfg.PollingInterval,
+				CooldownPeriod:  cfg.CooldownPeriod,
+				MinReplicaCount: cfg.MinReplicas,
+				MaxReplicaCount: cfg.MaxReplicas,
+				Advanced: func() *kedav1alpha1.AdvancedConfig {
+					if cfg.Behavior == nil {
+						return nil
+					}
+					return &kedav1alpha1.AdvancedConfig{
+						HorizontalPodAutoscalerConfig: &kedav1alpha1.HorizontalPodAutoscalerConfig{
+							Behavior: cfg.Behavior,
+						},
+					}
+				}(),
+				Triggers: append(append([]kedav1alpha1.ScaleTriggers{}, triggers...), Triggers(cfg.Triggers)...),
+			},
+		}
+	}
+}
+
+// Triggers returns the KEDA triggers for the given list of ScaleTriggerSpec
+func Triggers(specs []saasv1alpha1.ScaleTriggerSpec) []kedav1alpha1.ScaleTriggers {
+	triggers := make([]kedav1alpha1.ScaleTriggers, 0, len(specs))
+	for _, spec := range specs {
+		trigger := kedav1alpha1.ScaleTriggers{
+			Type:     spec.Type,
+			Metadata: spec.Metadata,
+		}
+		if spec.AuthenticationRef != nil {
+			trigger.AuthenticationRef = &kedav1alpha1.ScaledObjectAuthRef{Name: *spec.AuthenticationRef}
+		}
+		triggers = append(triggers, trigger)
+	}
+	return triggers
+}
+
+// RedisListTriggers returns a "redis" trigger for each of the given queues, using
+// 'prefix' to build the key of the Redis list that holds the jobs of each queue.
+// The DSN can either be a "host[:port]" address or a redis URL, and the port defaults
+// to 6379. Credentials in the DSN are not passed to KEDA, as the triggers are stored
+// in clear text, so the triggers reference the given TriggerAuthentication instead.
+func RedisListTriggers(dsn, prefix string, queues []string, length int32, authenticationRef *string) []kedav1alpha1.ScaleTriggers {
+	metadata := map[string]string{"address": dsn}
+	if strings.Contains(dsn, "://") {
+		if u, err := url.Parse(dsn); err == nil {
+			metadata["address"] = u.Host
+			if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+				metadata["databaseIndex"] = db
+			}
+			if u.Scheme == "rediss" {
+				metadata["enableTLS"] = "true"
+			}
+		}
+	}
+	if _, _, err := net.SplitHostPort(metadata["address"]); err != nil {
+		metadata["address"] = net.JoinHostPort(metadata["address"], "6379")
+	}
+
+	triggers := make([]kedav1alpha1.ScaleTriggers, 0, len(queues))
+	for _, queue := range queues {
+		md := map[string]string{
+			"listName":   prefix + queue,
+			"listLength": fmt.Sprintf("%d", length),
+		}
+		for k, v := range metadata {
+			md[k] = v
+		}
+		trigger := kedav1alpha1.ScaleTriggers{Type: "redis", Metadata: md}
+		if authenticationRef != nil {
+			trigger.AuthenticationRef = &kedav1alpha1.ScaledObjectAuthRef{Name: *authenticationRef}
+		}
+		triggers = append(triggers, trigger)
+	}
+	return triggers
+}
+
+// QueJobsTrigger returns a "postgresql" trigger on the number of que jobs that are
+// ready to run in the given queues, or in all of them if no queue is given. The
+// connection string is read from the 'env' environment variable of the workload, and
+// any other parameter from the given TriggerAuthentication.
+func QueJobsTrigger(env string, queues []string, length int32, authenticationRef *string) kedav1alpha1.ScaleTriggers {
+	query := "SELECT COUNT(*) FROM que_jobs WHERE run_at <= now()"
+	if len(queues) > 0 {
+		quoted := make([]string, 0, len(queues))
+		for _, q := range queues {
+			quoted = append(quoted, "'"+strings.ReplaceAll(q, "'", "''")+"'")
+		}
+		query += fmt.Sprintf(" AND queue IN (%s)", strings.Join(quoted, ", "))
+	}
+	trigger := kedav1alpha1.ScaleTriggers{
+		Type: "postgresql",
+		Metadata: map[string]string{
+			"connectionFromEnv": env,
+			"query":             query,
+			"targetQueryValue":  fmt.Sprintf("%d", length),
+		},
+	}
+	if authenticationRef != nil {
+		trigger.AuthenticationRef = &kedav1alpha1.ScaledObjectAuthRef{Name: *authenticationRef}
+	}
+	return trigger
+}
@@ -0,0 +1,102 @@
+package servicemonitor
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/service"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns a basereconciler.GeneratorFunction function that will return a ServiceMonitor
+// resource when called. The ServiceMonitor selects the Services with the given selector, which
+// should only match the metrics Service returned by the Service function. Endpoints with an
+// unknown port number are not exposed in the metrics Service, so they are skipped.
+func New(key types.NamespacedName, labels map[string]string, selector map[string]string,
+	cfg saasv1alpha1.MonitoringSpec, endpoints ...monitoring.Endpoint) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+
+		return &monitoringv1.ServiceMonitor{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ServiceMonitor",
+				APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: func() []monitoringv1.Endpoint {
+					list := []monitoringv1.Endpoint{}
+					for _, e := range endpoints {
+						if e.Number != 0 {
+							list = append(list, Endpoint(e, cfg))
+						}
+					}
+					return list
+				}(),
+				Selector: metav1.LabelSelector{
+					MatchLabels: selector,
+				},
+			},
+		}
+	}
+}
+
+// Endpoint returns a monitoringv1.Endpoint
+func Endpoint(e monitoring.Endpoint, cfg saasv1alpha1.MonitoringSpec) monitoringv1.Endpoint {
+	return monitoringv1.Endpoint{
+		Interval:             e.ScrapeInterval(cfg),
+		Path:                 e.Path,
+		Port:                 e.Port,
+		Scheme:               "http",
+		RelabelConfigs:       monitoring.Relabelings(cfg.Relabelings),
+		MetricRelabelConfigs: monitoring.Relabelings(cfg.MetricRelabelings),
+	}
+}
+
+// Service returns a basereconciler.GeneratorFunction function that will return the headless
+// Service that exposes the metrics ports of the Pods with the given selector when called
+func Service(key types.NamespacedName, labels map[string]string, selector map[string]string,
+	endpoints ...monitoring.Endpoint) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+
+		return &corev1.Service{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Service",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: corev1.ServiceSpec{
+				Type:            corev1.ServiceTypeClusterIP,
+				ClusterIP:       corev1.ClusterIPNone,
+				SessionAffinity: corev1.ServiceAffinityNone,
+				Ports: func() []corev1.ServicePort {
+					ports := service.Ports()
+					seen := map[string]bool{}
+					for _, e := range endpoints {
+						if e.Number == 0 || seen[e.Port] {
+							continue
+						}
+						seen[e.Port] = true
+						ports = append(ports, service.TCPPort(e.Port, e.Number, intstr.FromString(e.Port)))
+					}
+					return ports
+				}(),
+				Selector: selector,
+			},
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package servicemonitor
+
+import (
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+)
+
+var endpoints = []monitoring.Endpoint{
+	monitoring.MetricsEndpoint("/metrics", "metrics", 9394, 30),
+	monitoring.MetricsEndpoint("/yabeda-metrics", "metrics", 9394, 30),
+	monitoring.MetricsEndpoint("/stats/prometheus", "envoy-metrics", 0, 60),
+}
+
+func TestNew(t *testing.T) {
+	cfg := saasv1alpha1.MonitoringSpec{
+		Interval:          pointer.StringPtr("15s"),
+		MetricRelabelings: []saasv1alpha1.RelabelConfig{{SourceLabels: []string{"__name__"}, Regex: "go_.*", Action: "drop"}},
+	}
+	sm := New(types.NamespacedName{Name: "test", Namespace: "ns"}, map[string]string{"app": "test"},
+		map[string]string{"deployment": "test"}, cfg, endpoints...)().(*monitoringv1.ServiceMonitor)
+
+	relabelings := []*monitoringv1.RelabelConfig{{SourceLabels: []string{"__name__"}, Regex: "go_.*", Action: "drop"}}
+	want := []monitoringv1.Endpoint{
+		{Path: "/metrics", Port: "metrics", Scheme: "http", Interval: "15s", MetricRelabelConfigs: relabelings},
+		{Path: "/yabeda-metrics", Port: "metrics", Scheme: "http", Interval: "15s", MetricRelabelConfigs: relabelings},
+	}
+	if !reflect.DeepEqual(sm.Spec.Endpoints, want) {
+		t.Errorf("New() got endpoints = %v, want %v", sm.Spec.Endpoints, want)
+	}
+	if !reflect.DeepEqual(sm.Spec.Selector.MatchLabels, map[string]string{"deployment": "test"}) {
+		t.Errorf("New() got selector = %v", sm.Spec.Selector.MatchLabels)
+	}
+}
+
+func TestService(t *testing.T) {
+	svc := Service(types.NamespacedName{Name: "test-metrics", Namespace: "ns"}, map[string]string{"deployment": "test"},
+		map[string]string{"deployment": "test"}, endpoints...)().(*corev1.Service)
+
+	want := []corev1.ServicePort{
+		{Name: "metrics", Port: 9394, TargetPort: intstr.FromString("metrics"), Protocol: corev1.ProtocolTCP},
+	}
+	if !reflect.DeepEqual(svc.Spec.Ports, want) {
+		t.Errorf("Service() got ports = %v, want %v", svc.Spec.Ports, want)
+	}
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Errorf("Service() got clusterIP = %v, want a headless Service", svc.Spec.ClusterIP)
+	}
+}
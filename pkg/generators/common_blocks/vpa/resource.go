@@ -0,0 +1,63 @@
+package vpa
+
+import (
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AllContainers is the name of the container policy that
+// applies to all the containers of the Pods
+const AllContainers string = "*"
+
+// New returns a basereconciler.GeneratorFunction function that will return a VerticalPodAutoscaler
+// resource when called. The VerticalPodAutoscaler targets the workload of the given kind
+// (Deployment/StatefulSet) with the same name.
+func New(key types.NamespacedName, labels map[string]string, kind string,
+	cfg saasv1alpha1.VerticalPodAutoscalerSpec) basereconciler.GeneratorFunction {
+
+	return func() client.Object {
+
+		return &vpav1.VerticalPodAutoscaler{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VerticalPodAutoscaler",
+				APIVersion: vpav1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: vpav1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       kind,
+					Name:       key.Name,
+				},
+				UpdatePolicy: &vpav1.PodUpdatePolicy{
+					UpdateMode: (*vpav1.UpdateMode)(cfg.UpdateMode),
+				},
+				ResourcePolicy: &vpav1.PodResourcePolicy{
+					ContainerPolicies: []vpav1.ContainerResourcePolicy{{
+						ContainerName: AllContainers,
+						MinAllowed:    cfg.MinAllowed,
+						MaxAllowed:    cfg.MaxAllowed,
+						ControlledResources: func() *[]corev1.ResourceName {
+							if cfg.ControlledResources == nil {
+								return nil
+							}
+							resources := append([]corev1.ResourceName(nil), cfg.ControlledResources...)
+							return &resources
+						}(),
+					}},
+				},
+			},
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package vpa
+
+import (
+	"reflect"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNew(t *testing.T) {
+	mode := saasv1alpha1.VPAUpdateModeInitial
+	cfg := saasv1alpha1.VerticalPodAutoscalerSpec{
+		UpdateMode:          &mode,
+		MaxAllowed:          corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		ControlledResources: []corev1.ResourceName{corev1.ResourceMemory},
+	}
+	vpa := New(types.NamespacedName{Name: "system-sphinx", Namespace: "ns"}, map[string]string{"app": "test"},
+		"StatefulSet", cfg)().(*vpav1.VerticalPodAutoscaler)
+
+	if ref := vpa.Spec.TargetRef; ref.Kind != "StatefulSet" || ref.Name != "system-sphinx" || ref.APIVersion != "apps/v1" {
+		t.Errorf("New() got targetRef = %v", ref)
+	}
+	if got := *vpa.Spec.UpdatePolicy.UpdateMode; got != vpav1.UpdateModeInitial {
+		t.Errorf("New() got updateMode = %v, want %v", got, vpav1.UpdateModeInitial)
+	}
+	want := []vpav1.ContainerResourcePolicy{{
+		ContainerName:       AllContainers,
+		MaxAllowed:          corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		ControlledResources: &[]corev1.ResourceName{corev1.ResourceMemory},
+	}}
+	if !reflect.DeepEqual(vpa.Spec.ResourcePolicy.ContainerPolicies, want) {
+		t.Errorf("New() got containerPolicies = %v, want %v", vpa.Spec.ResourcePolicy.ContainerPolicies, want)
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"github.com/3scale/saas-operator/pkg/generators"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/grafanadashboard"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/hpa"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/podmonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/servicemonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/vpa"
 	"github.com/3scale/saas-operator/pkg/generators/corsproxy/config"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -47,6 +51,14 @@ func (gen *Generator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.Spec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *Generator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.Spec.VPA)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *Generator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -56,13 +68,38 @@ func (gen *Generator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *Generator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30))
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, *gen.Spec.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *Generator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, *gen.Spec.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *Generator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *Generator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9145, 30),
+	}
 }
 
 // GrafanaDashboard returns a basereconciler.GeneratorFunction
 func (gen *Generator) GrafanaDashboard() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return grafanadashboard.New(key, gen.GetLabels(), *gen.Spec.GrafanaDashboard, "dashboards/cors-proxy.json.tpl")
+	return grafanadashboard.New(key, gen.GetLabels(), *gen.Spec.GrafanaDashboard, "dashboards/cors-proxy.json.tpl",
+		grafanadashboard.NewData(gen.BaseOptions))
+}
+
+// CustomDashboards returns a basereconciler.GeneratorFunction for each of
+// the dashboards provided in ConfigMaps
+func (gen *Generator) CustomDashboards() []basereconciler.GeneratorFunction {
+	return grafanadashboard.NewFromConfigMaps(gen.Component, gen.Namespace, gen.GetLabels(), *gen.Spec.GrafanaDashboard)
 }
 
 // SecretDefinition returns a basereconciler.GeneratorFunction
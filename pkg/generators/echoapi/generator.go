@@ -5,10 +5,15 @@ import (
 	"github.com/3scale/saas-operator/pkg/basereconciler"
 	"github.com/3scale/saas-operator/pkg/generators"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/hpa"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/marin3r"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/podmonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/servicemonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/vpa"
 
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -43,6 +48,14 @@ func (gen *Generator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.Spec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *Generator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.Spec.VPA)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *Generator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -52,5 +65,23 @@ func (gen *Generator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *Generator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, podmonitor.PodMetricsEndpoint("/stats/prometheus", "envoy-metrics", 60))
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, *gen.Spec.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *Generator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, *gen.Spec.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *Generator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *Generator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/stats/prometheus", "envoy-metrics", marin3r.PortNumber(*gen.Spec.Marin3r, "envoy-metrics"), 60),
+	}
 }
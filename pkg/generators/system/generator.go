@@ -8,12 +8,18 @@ import (
 	"github.com/3scale/saas-operator/pkg/generators"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/grafanadashboard"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/hpa"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/podmonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/prometheusrule"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/scaledobject"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/servicemonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/vpa"
 	"github.com/3scale/saas-operator/pkg/generators/system/config"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -31,7 +37,9 @@ type Generator struct {
 	App                  AppGenerator
 	Sidekiq              SidekiqGenerator
 	Sphinx               SphinxGenerator
+	Migration            *saasv1alpha1.SystemMigrationSpec
 	GrafanaDashboardSpec saasv1alpha1.GrafanaDashboardSpec
+	PrometheusRulesSpec  saasv1alpha1.PrometheusRulesSpec
 	ConfigFilesSpec      saasv1alpha1.ConfigFilesSpec
 	Options              config.Options
 }
@@ -39,7 +47,20 @@ type Generator struct {
 // GrafanaDashboard returns a basereconciler.GeneratorFunction
 func (gen *Generator) GrafanaDashboard() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/system.json.tpl")
+	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/system.json.tpl",
+		grafanadashboard.NewData(gen.BaseOptions))
+}
+
+// CustomDashboards returns a basereconciler.GeneratorFunction for each of
+// the dashboards provided in ConfigMaps
+func (gen *Generator) CustomDashboards() []basereconciler.GeneratorFunction {
+	return grafanadashboard.NewFromConfigMaps(gen.Component, gen.Namespace, gen.GetLabels(), gen.GrafanaDashboardSpec)
+}
+
+// PrometheusRules returns a basereconciler.GeneratorFunction
+func (gen *Generator) PrometheusRules() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return prometheusrule.New(key, gen.GetLabels(), gen.PrometheusRulesSpec, "rules/system.yaml.tpl")
 }
 
 // DatabaseSecretDefinition returns a basereconciler.GeneratorFunction
@@ -117,6 +138,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) Gene
 			},
 			Spec:               *spec.App,
 			Options:            config.NewOptions(spec),
+			Monitoring:         *spec.Monitoring,
 			ImageSpec:          *spec.Image,
 			ConfigFilesEnabled: spec.Config.ConfigFiles.Enabled(),
 		},
@@ -132,7 +154,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) Gene
 				},
 			},
 			Spec:               *spec.Sidekiq,
+			QueuesDSN:          spec.Config.Redis.QueuesDSN,
 			Options:            config.NewOptions(spec),
+			Monitoring:         *spec.Monitoring,
 			ImageSpec:          *spec.Image,
 			ConfigFilesEnabled: spec.Config.ConfigFiles.Enabled(),
 		},
@@ -155,7 +179,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) Gene
 			DatabaseStorageSize:  *spec.Sphinx.Config.Thinking.DatabaseStorageSize,
 			DatabaseStorageClass: spec.Sphinx.Config.Thinking.DatabaseStorageClass,
 		},
+		Migration:            spec.Migration,
 		GrafanaDashboardSpec: *spec.GrafanaDashboard,
+		PrometheusRulesSpec:  *spec.PrometheusRules,
 		ConfigFilesSpec:      *spec.Config.ConfigFiles,
 		Options:              config.NewOptions(spec),
 	}
@@ -168,6 +194,7 @@ type AppGenerator struct {
 	Options            config.Options
 	ImageSpec          saasv1alpha1.ImageSpec
 	ConfigFilesEnabled bool
+	Monitoring         saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -175,6 +202,14 @@ func (gen *AppGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(gen.Key(), gen.GetLabels(), *gen.Spec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *AppGenerator) VPA() basereconciler.GeneratorFunction {
+	key := gen.Key()
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.Spec.VPA)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *AppGenerator) PDB() basereconciler.GeneratorFunction {
 	return pdb.New(gen.Key(), gen.GetLabels(), gen.Selector().MatchLabels, *gen.Spec.PDB)
@@ -182,19 +217,36 @@ func (gen *AppGenerator) PDB() basereconciler.GeneratorFunction {
 
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *AppGenerator) PodMonitor() basereconciler.GeneratorFunction {
-	return podmonitor.New(gen.Key(), gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-		podmonitor.PodMetricsEndpoint("/yabeda-metrics", "metrics", 30),
-	)
+	return podmonitor.New(gen.Key(), gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *AppGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	return servicemonitor.New(gen.Key(), gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *AppGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *AppGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9394, 30),
+		monitoring.MetricsEndpoint("/yabeda-metrics", "metrics", 9394, 30),
+	}
 }
 
 // SidekiqGenerator has methods to generate resources for system-sidekiq
 type SidekiqGenerator struct {
 	generators.BaseOptions
 	Spec               saasv1alpha1.SystemSidekiqSpec
+	QueuesDSN          string
 	Options            config.Options
 	ImageSpec          saasv1alpha1.ImageSpec
 	ConfigFilesEnabled bool
+	Monitoring         saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -203,6 +255,24 @@ func (gen *SidekiqGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.Spec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *SidekiqGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.Spec.VPA)()
+	}
+}
+
+// ScaledObject returns a basereconciler.GeneratorFunction
+func (gen *SidekiqGenerator) ScaledObject() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		cfg := *gen.Spec.ScaledObject
+		return scaledobject.New(key, gen.GetLabels(), cfg,
+			scaledobject.RedisListTriggers(gen.QueuesDSN, "queue:", cfg.Queues, *cfg.QueueLength, cfg.AuthenticationRef)...)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *SidekiqGenerator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -212,9 +282,25 @@ func (gen *SidekiqGenerator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *SidekiqGenerator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-	)
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *SidekiqGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *SidekiqGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *SidekiqGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9394, 30),
+	}
 }
 
 // SphinxGenerator has methods to generate resources for system-sphinx
@@ -228,3 +314,11 @@ type SphinxGenerator struct {
 	DatabaseStorageSize  resource.Quantity
 	DatabaseStorageClass *string
 }
+
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *SphinxGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "StatefulSet", *gen.Spec.VPA)()
+	}
+}
@@ -0,0 +1,75 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/migration"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MigrationJob returns a basereconciler.GeneratorFunction function that will return the Job
+// that migrates, and optionally seeds, the database with the image of system-app when called
+func (gen *Generator) MigrationJob() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: fmt.Sprintf("%s-migrate", gen.GetComponent()), Namespace: gen.Namespace}
+
+	return func() client.Object {
+
+		args := []string{"bundle", "exec", "rake", "db:migrate"}
+		if *gen.Migration.Seed {
+			args = append(args, "db:seed")
+		}
+
+		podSpec := corev1.PodSpec{
+			ImagePullSecrets: func() []corev1.LocalObjectReference {
+				if gen.App.ImageSpec.PullSecretName != nil {
+					return []corev1.LocalObjectReference{{Name: *gen.App.ImageSpec.PullSecretName}}
+				}
+				return nil
+			}(),
+			Containers: []corev1.Container{
+				{
+					Name:                     key.Name,
+					Image:                    fmt.Sprintf("%s:%s", *gen.App.ImageSpec.Name, *gen.App.ImageSpec.Tag),
+					Args:                     args,
+					Env:                      pod.BuildEnvironment(gen.Options),
+					Resources:                corev1.ResourceRequirements(*gen.App.Spec.Resources),
+					ImagePullPolicy:          *gen.App.ImageSpec.PullPolicy,
+					TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+					TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+				},
+			},
+			Affinity: func() *corev1.Affinity {
+				if gen.App.Spec.NodeAffinity != nil {
+					return &corev1.Affinity{NodeAffinity: gen.App.Spec.NodeAffinity}
+				}
+				return nil
+			}(),
+			Tolerations:       gen.App.Spec.Tolerations,
+			PriorityClassName: gen.App.Spec.PriorityClassName,
+		}
+
+		if gen.App.ConfigFilesEnabled {
+			podSpec.Volumes = append(podSpec.Volumes,
+				corev1.Volume{
+					Name: "system-config",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: systemConfigSecret,
+						},
+					},
+				})
+			podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts,
+				corev1.VolumeMount{
+					Name:      "system-config",
+					ReadOnly:  true,
+					MountPath: "/opt/system-extra-configs",
+				})
+		}
+
+		return migration.New(key, gen.GetLabels(), gen.Migration.MigrationSpec, podSpec)()
+	}
+}
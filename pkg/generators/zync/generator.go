@@ -8,11 +8,17 @@ import (
 	"github.com/3scale/saas-operator/pkg/generators"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/grafanadashboard"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/hpa"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/monitoring"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pdb"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
 	"github.com/3scale/saas-operator/pkg/generators/common_blocks/podmonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/prometheusrule"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/scaledobject"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/servicemonitor"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/vpa"
 	"github.com/3scale/saas-operator/pkg/generators/zync/config"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -26,7 +32,9 @@ type Generator struct {
 	generators.BaseOptions
 	API                  APIGenerator
 	Que                  QueGenerator
+	Migration            *saasv1alpha1.MigrationSpec
 	GrafanaDashboardSpec saasv1alpha1.GrafanaDashboardSpec
+	PrometheusRulesSpec  saasv1alpha1.PrometheusRulesSpec
 	Config               saasv1alpha1.ZyncConfig
 }
 
@@ -53,9 +61,10 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Genera
 					"threescale_component_element": api,
 				},
 			},
-			APISpec: *spec.API,
-			Image:   *spec.Image,
-			Options: config.NewAPIOptions(spec),
+			APISpec:    *spec.API,
+			Image:      *spec.Image,
+			Options:    config.NewAPIOptions(spec),
+			Monitoring: *spec.Monitoring,
 		},
 		Que: QueGenerator{
 			BaseOptions: generators.BaseOptions{
@@ -68,11 +77,14 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Genera
 					"threescale_component_element": que,
 				},
 			},
-			QueSpec: *spec.Que,
-			Image:   *spec.Image,
-			Options: config.NewQueOptions(spec),
+			QueSpec:    *spec.Que,
+			Image:      *spec.Image,
+			Options:    config.NewQueOptions(spec),
+			Monitoring: *spec.Monitoring,
 		},
+		Migration:            spec.Migration,
 		GrafanaDashboardSpec: *spec.GrafanaDashboard,
+		PrometheusRulesSpec:  *spec.PrometheusRules,
 		Config:               spec.Config,
 	}
 }
@@ -80,7 +92,20 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Genera
 // GrafanaDashboard returns a basereconciler.GeneratorFunction
 func (gen *Generator) GrafanaDashboard() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/zync.json.tpl")
+	return grafanadashboard.New(key, gen.GetLabels(), gen.GrafanaDashboardSpec, "dashboards/zync.json.tpl",
+		grafanadashboard.NewData(gen.BaseOptions))
+}
+
+// CustomDashboards returns a basereconciler.GeneratorFunction for each of
+// the dashboards provided in ConfigMaps
+func (gen *Generator) CustomDashboards() []basereconciler.GeneratorFunction {
+	return grafanadashboard.NewFromConfigMaps(gen.Component, gen.Namespace, gen.GetLabels(), gen.GrafanaDashboardSpec)
+}
+
+// PrometheusRules returns a basereconciler.GeneratorFunction
+func (gen *Generator) PrometheusRules() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return prometheusrule.New(key, gen.GetLabels(), gen.PrometheusRulesSpec, "rules/zync.yaml.tpl")
 }
 
 // ZyncSecretDefinition returns a basereconciler.GeneratorFunction
@@ -92,9 +117,10 @@ func (gen *Generator) ZyncSecretDefinition() basereconciler.GeneratorFunction {
 // Zync environment
 type APIGenerator struct {
 	generators.BaseOptions
-	Image   saasv1alpha1.ImageSpec
-	APISpec saasv1alpha1.APISpec
-	Options config.APIOptions
+	Image      saasv1alpha1.ImageSpec
+	APISpec    saasv1alpha1.APISpec
+	Options    config.APIOptions
+	Monitoring saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -103,6 +129,14 @@ func (gen *APIGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.APISpec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *APIGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.APISpec.VPA)()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *APIGenerator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -112,18 +146,35 @@ func (gen *APIGenerator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *APIGenerator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-	)
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *APIGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *APIGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *APIGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9393, 30),
+	}
 }
 
 // QueGenerator has methods to generate resources for a
 // Que environment
 type QueGenerator struct {
 	generators.BaseOptions
-	Image   saasv1alpha1.ImageSpec
-	QueSpec saasv1alpha1.QueSpec
-	Options config.QueOptions
+	Image      saasv1alpha1.ImageSpec
+	QueSpec    saasv1alpha1.QueSpec
+	Options    config.QueOptions
+	Monitoring saasv1alpha1.MonitoringSpec
 }
 
 // HPA returns a basereconciler.GeneratorFunction
@@ -132,6 +183,24 @@ func (gen *QueGenerator) HPA() basereconciler.GeneratorFunction {
 	return hpa.New(key, gen.GetLabels(), *gen.QueSpec.HPA)
 }
 
+// VPA returns a basereconciler.GeneratorFunction
+func (gen *QueGenerator) VPA() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		return vpa.New(key, gen.GetLabels(), "Deployment", *gen.QueSpec.VPA)()
+	}
+}
+
+// ScaledObject returns a basereconciler.GeneratorFunction
+func (gen *QueGenerator) ScaledObject() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return func() client.Object {
+		cfg := *gen.QueSpec.ScaledObject
+		return scaledobject.New(key, gen.GetLabels(), cfg,
+			scaledobject.QueJobsTrigger("DATABASE_URL", cfg.Queues, *cfg.QueueLength, cfg.AuthenticationRef))()
+	}
+}
+
 // PDB returns a basereconciler.GeneratorFunction
 func (gen *QueGenerator) PDB() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
@@ -141,7 +210,23 @@ func (gen *QueGenerator) PDB() basereconciler.GeneratorFunction {
 // PodMonitor returns a basereconciler.GeneratorFunction
 func (gen *QueGenerator) PodMonitor() basereconciler.GeneratorFunction {
 	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
-	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels,
-		podmonitor.PodMetricsEndpoint("/metrics", "metrics", 30),
-	)
+	return podmonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// ServiceMonitor returns a basereconciler.GeneratorFunction
+func (gen *QueGenerator) ServiceMonitor() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component, Namespace: gen.Namespace}
+	return servicemonitor.New(key, gen.GetLabels(), gen.Selector().MatchLabels, gen.Monitoring, gen.metricsEndpoints()...)
+}
+
+// MetricsService returns a basereconciler.GeneratorFunction
+func (gen *QueGenerator) MetricsService() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: gen.Component + "-metrics", Namespace: gen.Namespace}
+	return servicemonitor.Service(key, gen.LabelsWithSelector(), gen.Selector().MatchLabels, gen.metricsEndpoints()...)
+}
+
+func (gen *QueGenerator) metricsEndpoints() []monitoring.Endpoint {
+	return []monitoring.Endpoint{
+		monitoring.MetricsEndpoint("/metrics", "metrics", 9394, 30),
+	}
 }
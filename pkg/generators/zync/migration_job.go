@@ -0,0 +1,56 @@
+package zync
+
+import (
+	"fmt"
+
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/migration"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MigrationJob returns a basereconciler.GeneratorFunction function that will return
+// the Job that migrates the database with the image of zync when called
+func (gen *Generator) MigrationJob() basereconciler.GeneratorFunction {
+	key := types.NamespacedName{Name: fmt.Sprintf("%s-migrate", gen.GetComponent()), Namespace: gen.Namespace}
+
+	return func() client.Object {
+
+		podSpec := corev1.PodSpec{
+			ImagePullSecrets: func() []corev1.LocalObjectReference {
+				if gen.API.Image.PullSecretName != nil {
+					return []corev1.LocalObjectReference{{Name: *gen.API.Image.PullSecretName}}
+				}
+				return nil
+			}(),
+			Containers: []corev1.Container{
+				{
+					Name:  key.Name,
+					Image: fmt.Sprintf("%s:%s", *gen.API.Image.Name, *gen.API.Image.Tag),
+					Command: []string{
+						"/usr/bin/bash",
+						"-c",
+						"bundle exec rake db:migrate",
+					},
+					Env:                      pod.BuildEnvironment(gen.API.Options),
+					Resources:                corev1.ResourceRequirements(*gen.API.APISpec.Resources),
+					ImagePullPolicy:          *gen.API.Image.PullPolicy,
+					TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+					TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+				},
+			},
+			Affinity: func() *corev1.Affinity {
+				if gen.API.APISpec.NodeAffinity != nil {
+					return &corev1.Affinity{NodeAffinity: gen.API.APISpec.NodeAffinity}
+				}
+				return nil
+			}(),
+			Tolerations:       gen.API.APISpec.Tolerations,
+			PriorityClassName: gen.API.APISpec.PriorityClassName,
+		}
+
+		return migration.New(key, gen.GetLabels(), *gen.Migration, podSpec)()
+	}
+}
@@ -0,0 +1,145 @@
+// Package migrate implements the 'migrate-storage' subcommand, which rewrites the
+// custom resources of the operator in the storage version of the API
+package migrate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// crdGVK is the GroupVersionKind of the CustomResourceDefinitions
+	crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+	// resources are the plural names of the custom resources
+	// managed by the operator, by kind
+	resources = []struct{ kind, plural string }{
+		{"Apicast", "apicasts"},
+		{"AutoSSL", "autossls"},
+		{"Backend", "backends"},
+		{"CORSProxy", "corsproxies"},
+		{"EchoAPI", "echoapis"},
+		{"MappingService", "mappingservices"},
+		{"System", "systems"},
+		{"Zync", "zyncs"},
+	}
+)
+
+// Run executes the 'migrate-storage' subcommand with the given arguments against
+// the cluster of the current kubeconfig. The progress is written to 'out'.
+func Run(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("migrate-storage", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: saas-operator migrate-storage\n\n")
+		fmt.Fprintf(fs.Output(), "Rewrites all the custom resources of the operator in the storage version of the API\n")
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+	cl, err := client.New(cfg, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	return Migrate(context.Background(), cl, out)
+}
+
+// Migrate rewrites the custom resources of every kind managed by the operator, in
+// all namespaces, so the API server stores them in the current storage version of
+// their CRD. Once all of them have been rewritten, the other versions are removed
+// from the stored versions in the status of the CRD, so they can be safely dropped
+// from the CRD in later releases.
+func Migrate(ctx context.Context, cl client.Client, out io.Writer) error {
+	for _, res := range resources {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(crdGVK)
+		key := types.NamespacedName{Name: res.plural + "." + saasv1alpha1.GroupVersion.Group}
+		if err := cl.Get(ctx, key, crd); err != nil {
+			return err
+		}
+
+		version, err := storageVersion(crd)
+		if err != nil {
+			return err
+		}
+		gvk := schema.GroupVersionKind{Group: saasv1alpha1.GroupVersion.Group, Version: version, Kind: res.kind}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(res.kind + "List"))
+		if err := cl.List(ctx, list); err != nil {
+			return err
+		}
+		for idx := range list.Items {
+			if err := rewrite(ctx, cl, &list.Items[idx]); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%s %s/%s migrated to %s\n", res.kind,
+				list.Items[idx].GetNamespace(), list.Items[idx].GetName(), version)
+		}
+
+		if err := unstructured.SetNestedStringSlice(crd.Object, []string{version}, "status", "storedVersions"); err != nil {
+			return err
+		}
+		if err := cl.Status().Update(ctx, crd); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "CustomResourceDefinition %s stored versions set to [%s]\n", crd.GetName(), version)
+	}
+
+	return nil
+}
+
+// rewrite updates the object without changes, which makes the API server
+// store it again using the current storage version. Objects deleted in
+// the meantime are ignored.
+func rewrite(ctx context.Context, cl client.Client, obj *unstructured.Unstructured) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &unstructured.Unstructured{}
+		latest.SetGroupVersionKind(obj.GroupVersionKind())
+		key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}
+		if err := cl.Get(ctx, key, latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return cl.Update(ctx, latest)
+	})
+}
+
+// storageVersion returns the name of the version of the CRD
+// that is used to store the custom resources
+func storageVersion(crd *unstructured.Unstructured) (string, error) {
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return "", err
+	}
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _ := version["storage"].(bool); storage {
+			name, _ := version["name"].(string)
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("CustomResourceDefinition %s has no storage version", crd.GetName())
+}
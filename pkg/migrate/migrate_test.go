@@ -0,0 +1,84 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	saasv1beta1 "github.com/3scale/saas-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testCRD(plural string, storedVersions ...interface{}) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": plural + ".saas.3scale.net"},
+		"spec": map[string]interface{}{
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1alpha1", "served": true, "storage": false},
+				map[string]interface{}{"name": "v1beta1", "served": true, "storage": true},
+			},
+		},
+		"status": map[string]interface{}{"storedVersions": storedVersions},
+	}}
+	crd.SetGroupVersionKind(crdGVK)
+	return crd
+}
+
+func TestMigrate(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := saasv1beta1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	objects := []runtime.Object{
+		&saasv1beta1.Backend{ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "ns1"}},
+		&saasv1beta1.Backend{ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "ns2"}},
+		&saasv1beta1.Zync{ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "ns1"}},
+	}
+	for _, r := range resources {
+		objects = append(objects, testCRD(r.plural, "v1alpha1", "v1beta1"))
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objects...).Build()
+
+	out := &bytes.Buffer{}
+	if err := Migrate(context.Background(), cl, out); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"Backend ns1/example migrated to v1beta1",
+		"Backend ns2/example migrated to v1beta1",
+		"Zync ns1/example migrated to v1beta1",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Migrate() output = %q, want it to contain %q", out.String(), want)
+		}
+	}
+
+	// Rewritten objects get a new resourceVersion
+	backend := &saasv1beta1.Backend{}
+	if err := cl.Get(context.Background(), types.NamespacedName{Name: "example", Namespace: "ns1"}, backend); err != nil {
+		t.Fatal(err)
+	}
+	if backend.GetResourceVersion() == "999" {
+		t.Errorf("Migrate() did not rewrite Backend ns1/example")
+	}
+
+	for _, r := range resources {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(crdGVK)
+		if err := cl.Get(context.Background(), client.ObjectKey{Name: r.plural + ".saas.3scale.net"}, crd); err != nil {
+			t.Fatal(err)
+		}
+		got, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+		if len(got) != 1 || got[0] != "v1beta1" {
+			t.Errorf("Migrate() storedVersions of %s = %v, want [v1beta1]", crd.GetName(), got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	"github.com/3scale/saas-operator/pkg/generators/common_blocks/grafanadashboard"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OperatorDashboardTemplate is the asset with the Grafana dashboard of the operator itself
+const OperatorDashboardTemplate string = "dashboards/saas-operator.json.tpl"
+
+// RunDashboard executes the 'dashboard' subcommand with the given arguments. The GrafanaDashboard
+// with the metrics of the operator deployed in the namespace passed with the '-n' flag is written
+// to 'out', so it can be applied along with the rest of the manifests of the operator.
+func RunDashboard(args []string, out io.Writer) error {
+	var namespace, selectorKey, selectorValue, datasource, folder string
+
+	fs := flag.NewFlagSet("dashboard", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.StringVar(&namespace, "n", "", "The namespace where the operator is deployed.")
+	fs.StringVar(&selectorKey, "selector-key", "monitoring-key", "Label key used by grafana-operator for dashboard discovery.")
+	fs.StringVar(&selectorValue, "selector-value", "middleware", "Label value used by grafana-operator for dashboard discovery.")
+	fs.StringVar(&datasource, "datasource", "", "Name of the Prometheus datasource selected by default in the dashboard.")
+	fs.StringVar(&folder, "folder", "", "Grafana folder where the dashboard is created.")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: saas-operator dashboard -n <namespace>\n\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if namespace == "" {
+		fs.Usage()
+		return fmt.Errorf("the namespace is required")
+	}
+
+	cfg := saasv1alpha1.GrafanaDashboardSpec{SelectorKey: &selectorKey, SelectorValue: &selectorValue}
+	if datasource != "" {
+		cfg.Datasource = &datasource
+	}
+	if folder != "" {
+		cfg.Folder = &folder
+	}
+
+	dashboard := grafanadashboard.New(
+		types.NamespacedName{Name: "saas-operator", Namespace: namespace},
+		map[string]string{"app": "saas-operator"},
+		cfg,
+		OperatorDashboardTemplate,
+		grafanadashboard.Data{Namespace: namespace, InstanceName: "saas-operator", Component: "saas-operator"},
+	)
+	return Write(out, []client.Object{dashboard()})
+}
@@ -0,0 +1,101 @@
+package render
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/3scale/saas-operator/controllers"
+	"github.com/3scale/saas-operator/pkg/basereconciler"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RunDiff executes the 'diff' subcommand with the given arguments. Custom resources are
+// read from the files passed with the '-f' flag ('-' reads from stdin) and compared against
+// the cluster of the current kubeconfig. The changes that the operator would apply to each
+// owned resource are written to 'out'. No resource is modified in the cluster.
+func RunDiff(args []string, stdin io.Reader, out io.Writer) error {
+	var files stringList
+	var namespace string
+	var serverSideApply bool
+
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Var(&files, "f", "File with the custom resources to diff ('-' reads from stdin). Can be repeated.")
+	fs.StringVar(&namespace, "n", "default", "Namespace of the custom resources that do not specify one.")
+	fs.BoolVar(&serverSideApply, "server-side-apply", false,
+		"Compute the diff with a dry-run server-side apply, as the operator does when reconciling with server-side apply.")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: saas-operator diff -f <file> [-f <file>...] [-n <namespace>]\n\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return fmt.Errorf("at least one file is required")
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+	cl, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return err
+	}
+	autoscalingV2, err := basereconciler.IsAutoscalingV2Supported(cfg)
+	if err != nil {
+		return err
+	}
+	r := basereconciler.NewFromClient(cl, scheme, cfg, &record.FakeRecorder{},
+		basereconciler.WithServerSideApply(serverSideApply),
+		basereconciler.WithAutoscalingV2(autoscalingV2))
+
+	ctx := context.Background()
+	for _, file := range files {
+		instances, err := decodeFile(file, stdin)
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range instances {
+			if instance.GetNamespace() == "" {
+				instance.SetNamespace(namespace)
+			}
+			// Use the UID of the live instance, if any, so the resources
+			// that would be pruned can be found through their owner references
+			live := instance.DeepCopyObject().(client.Object)
+			key := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
+			if err := cl.Get(ctx, key, live); err != nil {
+				if !errors.IsNotFound(err) {
+					return err
+				}
+			} else {
+				instance.SetUID(live.GetUID())
+			}
+
+			diffs, err := controllers.Diff(ctx, &r, instance)
+			if err != nil {
+				return fmt.Errorf("unable to diff %s: %w", instance.GetName(), err)
+			}
+			kind := instance.GetObjectKind().GroupVersionKind().Kind
+			if len(diffs) == 0 {
+				fmt.Fprintf(out, "# %s %s/%s: no changes\n", kind, instance.GetNamespace(), instance.GetName())
+				continue
+			}
+			fmt.Fprintf(out, "# %s %s/%s\n", kind, instance.GetNamespace(), instance.GetName())
+			for _, diff := range diffs {
+				fmt.Fprint(out, diff.String())
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Package render implements the 'render', 'diff' and 'dashboard' subcommands of the
+// operator, which print the resources that the operator would generate for a set of custom
+// resources, the changes that applying them would cause in a cluster and the Grafana
+// dashboard of the operator itself.
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	saasv1alpha1 "github.com/3scale/saas-operator/api/v1alpha1"
+	saasv1beta1 "github.com/3scale/saas-operator/api/v1beta1"
+	"github.com/3scale/saas-operator/controllers"
+	batchv1 "github.com/3scale/saas-operator/pkg/apis/batch/v1"
+	externalsecretsv1beta1 "github.com/3scale/saas-operator/pkg/apis/external-secrets/v1beta1"
+	grafanav1alpha1 "github.com/3scale/saas-operator/pkg/apis/grafana/v1alpha1"
+	kedav1alpha1 "github.com/3scale/saas-operator/pkg/apis/keda/v1alpha1"
+	secretsmanagerv1alpha1 "github.com/3scale/saas-operator/pkg/apis/secrets-manager/v1alpha1"
+	vpav1 "github.com/3scale/saas-operator/pkg/apis/vpa/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/yaml"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(saasv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(saasv1beta1.AddToScheme(scheme))
+	utilruntime.Must(monitoringv1.AddToScheme(scheme))
+	utilruntime.Must(grafanav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(secretsmanagerv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(externalsecretsv1beta1.AddToScheme(scheme))
+	utilruntime.Must(kedav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(vpav1.AddToScheme(scheme))
+	utilruntime.Must(batchv1.AddToScheme(scheme))
+}
+
+// Run executes the 'render' subcommand with the given arguments. Custom resources
+// are read from the files passed with the '-f' flag ('-' reads from stdin) and the
+// generated resources are written to 'out' as a multi-document YAML stream.
+func Run(args []string, stdin io.Reader, out io.Writer) error {
+	var files stringList
+
+	fs := flag.NewFlagSet("render", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Var(&files, "f", "File with the custom resources to render ('-' reads from stdin). Can be repeated.")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: saas-operator render -f <file> [-f <file>...]\n\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return fmt.Errorf("at least one file is required")
+	}
+
+	for _, file := range files {
+		instances, err := decodeFile(file, stdin)
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range instances {
+			objects, err := renderInstance(instance)
+			if err != nil {
+				return fmt.Errorf("unable to render %s: %w", instance.GetName(), err)
+			}
+			if err := Write(out, objects); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// renderInstance renders the given custom resource, returning an error
+// instead of panicking if the spec is not valid for the generators
+func renderInstance(instance client.Object) (objects []client.Object, err error) {
+	if v, ok := instance.(webhook.Validator); ok {
+		if err := v.ValidateCreate(); err != nil {
+			return nil, err
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid spec: %v", r)
+		}
+	}()
+	return controllers.Render(instance)
+}
+
+// decodeFile decodes the custom resources in the given file, or in stdin
+// if the file is "-". The file is closed before returning.
+func decodeFile(file string, stdin io.Reader) (instances []client.Object, err error) {
+	r := stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if cerr := f.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}()
+		r = f
+	}
+
+	instances, err = Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %w", file, err)
+	}
+	return instances, nil
+}
+
+// Decode reads a multi-document YAML stream and returns the
+// custom resources of the operator found in it
+func Decode(r io.Reader) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	objects := []client.Object{}
+
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		// Custom resources of other versions of the API are converted
+		// to the hub version, which is the one the controllers use
+		if c, ok := obj.(conversion.Convertible); ok {
+			hub, err := scheme.New(saasv1alpha1.GroupVersion.WithKind(gvk.Kind))
+			if err != nil {
+				return nil, err
+			}
+			if err := c.ConvertTo(hub.(conversion.Hub)); err != nil {
+				return nil, err
+			}
+			hub.GetObjectKind().SetGroupVersionKind(saasv1alpha1.GroupVersion.WithKind(gvk.Kind))
+			obj = hub
+		}
+		o, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unsupported object %T", obj)
+		}
+		objects = append(objects, o)
+	}
+
+	return objects, nil
+}
+
+// Write prints the given objects to 'out' as a multi-document YAML stream
+func Write(out io.Writer, objects []client.Object) error {
+	for _, o := range objects {
+		gvk, err := apiutil.GVKForObject(o, scheme)
+		if err != nil {
+			return err
+		}
+		o.GetObjectKind().SetGroupVersionKind(gvk)
+
+		data, err := yaml.Marshal(o)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stringList is a flag.Value that accumulates the values
+// of a flag that can be passed several times
+type stringList []string
+
+func (s *stringList) String() string {
+	return fmt.Sprintf("%v", *s)
+}
+
+func (s *stringList) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
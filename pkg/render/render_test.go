@@ -0,0 +1,150 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantKinds []string
+		wantErr   bool
+	}{
+		{
+			name: "Renders the resources of an EchoAPI",
+			input: `
+apiVersion: saas.3scale.net/v1alpha1
+kind: EchoAPI
+metadata:
+  name: example
+  namespace: default
+spec: {}
+`,
+			wantKinds: []string{"kind: Deployment", "kind: Service", "kind: PodDisruptionBudget",
+				"kind: HorizontalPodAutoscaler", "kind: PodMonitor"},
+			wantErr: false,
+		},
+		{
+			name: "Skips empty documents",
+			input: `
+---
+apiVersion: saas.3scale.net/v1alpha1
+kind: EchoAPI
+metadata:
+  name: example
+  namespace: default
+spec: {}
+---
+`,
+			wantKinds: []string{"kind: Deployment"},
+			wantErr:   false,
+		},
+		{
+			name: "Renders custom resources of other API versions",
+			input: `
+apiVersion: saas.3scale.net/v1beta1
+kind: EchoAPI
+metadata:
+  name: example
+  namespace: default
+spec:
+  endpoint:
+    hosts:
+      - name: echo-api.example.com
+`,
+			wantKinds: []string{"kind: Deployment", "kind: Service", "echo-api.example.com"},
+			wantErr:   false,
+		},
+		{
+			name: "Renders a ServiceMonitor and a metrics Service in the serviceMonitor mode",
+			input: `
+apiVersion: saas.3scale.net/v1alpha1
+kind: EchoAPI
+metadata:
+  name: example
+  namespace: default
+spec:
+  marin3r:
+    ports:
+      - name: envoy-metrics
+        port: 9901
+  monitoring:
+    mode: serviceMonitor
+    interval: 15s
+`,
+			wantKinds: []string{"kind: ServiceMonitor", "name: echo-api-metrics", "clusterIP: None",
+				"port: 9901", "interval: 15s"},
+			wantErr: false,
+		},
+		{
+			name: "Renders ExternalSecrets when configured as secrets provider",
+			input: `
+apiVersion: saas.3scale.net/v1alpha1
+kind: CORSProxy
+metadata:
+  name: example
+  namespace: default
+spec:
+  config:
+    systemDatabaseDSN:
+      fromVault:
+        key: DSN
+        path: secret/data/cors-proxy
+  secretsProvider:
+    type: ExternalSecrets
+    secretStoreRef:
+      name: vault
+`,
+			wantKinds: []string{"kind: ExternalSecret", "name: vault", "kind: SecretStore",
+				"key: secret/data/cors-proxy", "property: DSN"},
+			wantErr: false,
+		},
+		{
+			name: "Fails for the ExternalSecrets secrets provider without a SecretStore",
+			input: `
+apiVersion: saas.3scale.net/v1alpha1
+kind: CORSProxy
+metadata:
+  name: example
+  namespace: default
+spec:
+  config:
+    systemDatabaseDSN:
+      fromVault:
+        key: DSN
+        path: secret/data/cors-proxy
+  secretsProvider:
+    type: ExternalSecrets
+`,
+			wantErr: true,
+		},
+		{
+			name: "Fails for kinds not managed by the operator",
+			input: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := Run([]string{"-f", "-"}, strings.NewReader(tt.input), out)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for _, kind := range tt.wantKinds {
+				if !strings.Contains(out.String(), kind) {
+					t.Errorf("Run() output does not contain '%s':\n%s", kind, out.String())
+				}
+			}
+		})
+	}
+}